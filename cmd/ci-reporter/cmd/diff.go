@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/release-utils/helpers"
+)
+
+// stateAbsent is shown for records which are not part of a snapshot.
+const stateAbsent = "-"
+
+type diffOptions struct {
+	since string
+}
+
+var diffOpts = &diffOptions{}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff --since <date>",
+	Short: "Compare stored reports",
+	Long: `CI-Signal reporter that compares the stored report snapshots.
+
+Shows the jobs that transitioned state, newly flaky tests and issues that
+moved on the project board between the snapshot taken at the given date and
+the latest one.`,
+	Example: "reporter diff --since 2026-01-05 --format markdown",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return RunDiff(cfg, diffOpts, time.Now())
+	},
+}
+
+func init() {
+	diffCmd.Flags().StringVar(&diffOpts.since, "since", "168h", "Date (YYYY-MM-DD or RFC3339) or duration like '168h' of the report to compare with")
+	rootCmd.AddCommand(diffCmd)
+}
+
+// parseSince parses a date, RFC3339 timestamp or duration before now.
+func parseSince(since string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, since); err == nil {
+		return t, nil
+	}
+
+	if t, err := time.ParseInLocation(time.DateOnly, since, time.Local); err == nil {
+		return t, nil
+	}
+
+	d, err := time.ParseDuration(since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid value %q, expected a date, RFC3339 timestamp or duration", since)
+	}
+
+	return now.Add(-d), nil
+}
+
+// RunDiff compares the stored reports and prints the changes.
+func RunDiff(cfg *Config, opts *diffOptions, now time.Time) error {
+	format, err := cfg.OutputFormat()
+	if err != nil {
+		return err
+	}
+
+	if format == FormatHTML {
+		return fmt.Errorf("format %q is not supported for diffs", format)
+	}
+
+	since, err := parseSince(opts.since, now)
+	if err != nil {
+		return fmt.Errorf("parsing since: %w", err)
+	}
+
+	snapshots, err := NewHistoryStore(cfg.HistoryDir).Load()
+	if err != nil {
+		return fmt.Errorf("loading report history: %w", err)
+	}
+
+	baseline, latest, err := SelectSnapshots(snapshots, since, cfg.ReleaseVersion)
+	if err != nil {
+		return err
+	}
+
+	out, err := reportOutput(cfg)
+	if err != nil {
+		return err
+	}
+
+	defer closeReportOutput(out)
+
+	if err := PrintReportDiff(out, format, DiffSnapshots(baseline, latest)); err != nil {
+		return fmt.Errorf("printing report diff: %w", err)
+	}
+
+	return nil
+}
+
+// PrintReportDiff writes the diff in the given format.
+func PrintReportDiff(out io.Writer, format string, diff *ReportDiff) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(diff); err != nil {
+			return fmt.Errorf("could not write to output stream: %w", err)
+		}
+
+		return nil
+	case FormatMarkdown:
+		return printReportDiffMarkdown(out, diff)
+	default:
+		return printReportDiffTable(out, diff)
+	}
+}
+
+// diffSections returns the titled parts of the diff.
+func diffSections(diff *ReportDiff) []struct {
+	title       string
+	transitions []*StateTransition
+} {
+	return []struct {
+		title       string
+		transitions []*StateTransition
+	}{
+		{"Job state transitions", diff.JobTransitions},
+		{"Newly flaky", diff.NewlyFlaky},
+		{"Project board moves", diff.IssueMoves},
+	}
+}
+
+func stateOrAbsent(state string) string {
+	if state == "" {
+		return stateAbsent
+	}
+
+	return state
+}
+
+func printReportDiffTable(out io.Writer, diff *ReportDiff) error {
+	if _, err := fmt.Fprintf(out, "\nCHANGES FROM %s TO %s\n",
+		diff.From.Format(time.DateTime), diff.To.Format(time.DateTime),
+	); err != nil {
+		return fmt.Errorf("could not write to output stream: %w", err)
+	}
+
+	for _, section := range diffSections(diff) {
+		if _, err := fmt.Fprintf(out, "\n%s\n\n", strings.ToUpper(section.title)); err != nil {
+			return fmt.Errorf("could not write to output stream: %w", err)
+		}
+
+		table := helpers.NewTableWriter(out)
+		table.Header([]string{"TESTGRID BOARD", "TITLE", "FROM", "TO", "URL"})
+
+		data := [][]string{}
+		for _, t := range section.transitions {
+			data = append(data, []string{t.TestgridBoard, t.Title, stateOrAbsent(t.From), stateOrAbsent(t.To), t.URL})
+		}
+
+		_ = table.Bulk(data)
+		_ = table.Render()
+	}
+
+	return nil
+}
+
+func printReportDiffMarkdown(out io.Writer, diff *ReportDiff) error {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "# CI Signal changes\n\nFrom %s to %s\n",
+		diff.From.Format(time.DateTime), diff.To.Format(time.DateTime),
+	)
+
+	for _, section := range diffSections(diff) {
+		fmt.Fprintf(b, "\n## %s\n\n", section.title)
+
+		if len(section.transitions) == 0 {
+			b.WriteString("No changes.\n")
+
+			continue
+		}
+
+		b.WriteString("| Testgrid Board | Title | From | To |\n|---|---|---|---|\n")
+
+		for _, t := range section.transitions {
+			title := escapeMarkdownCell(t.Title)
+			if t.URL != "" {
+				title = fmt.Sprintf("[%s](%s)", title, t.URL)
+			}
+
+			fmt.Fprintf(b, "| %s | %s | %s | %s |\n",
+				escapeMarkdownCell(t.TestgridBoard), title,
+				escapeMarkdownCell(stateOrAbsent(t.From)), escapeMarkdownCell(stateOrAbsent(t.To)),
+			)
+		}
+	}
+
+	if _, err := io.WriteString(out, b.String()); err != nil {
+		return fmt.Errorf("could not write to output stream: %w", err)
+	}
+
+	return nil
+}
+
+// escapeMarkdownCell escapes the content of a markdown table cell.
+func escapeMarkdownCell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
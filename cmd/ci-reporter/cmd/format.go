@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// FormatTable prints the output as terminal tables.
+	FormatTable = "table"
+	// FormatJSON prints the output as JSON.
+	FormatJSON = "json"
+	// FormatMarkdown prints the output as markdown.
+	FormatMarkdown = "markdown"
+	// FormatHTML prints the output as self-contained HTML document.
+	FormatHTML = "html"
+)
+
+// Formats returns all supported output formats.
+func Formats() []string {
+	return []string{FormatTable, FormatJSON, FormatMarkdown, FormatHTML}
+}
+
+// OutputFormat returns the validated output format. The legacy JSON flag
+// takes precedence over the format.
+func (c *Config) OutputFormat() (string, error) {
+	if c.JSONOutput {
+		return FormatJSON, nil
+	}
+
+	if c.Format == "" {
+		return FormatTable, nil
+	}
+
+	if !slices.Contains(Formats(), c.Format) {
+		return "", fmt.Errorf("unsupported output format %q, expected one of: %s", c.Format, strings.Join(Formats(), ", "))
+	}
+
+	return c.Format, nil
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/release/pkg/testgrid"
+)
+
+const (
+	// historyFileLayout is the date layout of the daily history files.
+	historyFileLayout = "2006-01-02"
+	historyFileExt    = ".jsonl"
+)
+
+// Snapshot is a single persisted ci-reporter run.
+type Snapshot struct {
+	Timestamp      time.Time          `json:"timestamp"`
+	ReleaseVersion string             `json:"release_version,omitempty"`
+	ShortReport    bool               `json:"short_report,omitempty"`
+	Reports        CIReportDataFields `json:"reports"`
+}
+
+// HistoryStore persists snapshots as JSON lines, one file per day.
+type HistoryStore struct {
+	dir string
+}
+
+// NewHistoryStore creates a history store within the directory.
+func NewHistoryStore(dir string) *HistoryStore {
+	return &HistoryStore{dir: dir}
+}
+
+// DefaultHistoryDir returns the default directory of the history store,
+// which is located in the XDG data home.
+func DefaultHistoryDir() string {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return filepath.Join(dir, "ci-reporter", "history")
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), "ci-reporter", "history")
+	}
+
+	return filepath.Join(home, ".local", "share", "ci-reporter", "history")
+}
+
+// Save appends the snapshot to the history.
+func (s *HistoryStore) Save(snapshot *Snapshot) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("creating history directory: %w", err)
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("marshaling snapshot: %w", err)
+	}
+
+	path := filepath.Join(s.dir, snapshot.Timestamp.UTC().Format(historyFileLayout)+historyFileExt)
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening history file: %w", err)
+	}
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+
+		return fmt.Errorf("writing history file: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing history file: %w", err)
+	}
+
+	logrus.Infof("Saved report snapshot to %s", path)
+
+	return nil
+}
+
+// Load returns all snapshots of the history, ordered by their timestamp.
+func (s *HistoryStore) Load() ([]*Snapshot, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []*Snapshot{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading history directory: %w", err)
+	}
+
+	snapshots := []*Snapshot{}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != historyFileExt {
+			continue
+		}
+
+		fileSnapshots, err := loadHistoryFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		snapshots = append(snapshots, fileSnapshots...)
+	}
+
+	slices.SortStableFunc(snapshots, func(a, b *Snapshot) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+
+	return snapshots, nil
+}
+
+func loadHistoryFile(path string) ([]*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening history file: %w", err)
+	}
+	defer f.Close()
+
+	snapshots := []*Snapshot{}
+	scanner := bufio.NewScanner(f)
+	// Snapshots contain the complete report on a single line.
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+
+		snapshot := &Snapshot{}
+		if err := json.Unmarshal(scanner.Bytes(), snapshot); err != nil {
+			return nil, fmt.Errorf("decoding snapshot in %s:%d: %w", path, line, err)
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading history file %s: %w", path, err)
+	}
+
+	return snapshots, nil
+}
+
+// SelectSnapshots returns the snapshots to compare for changes since the
+// given time. The baseline is the last snapshot taken at or before that
+// time, or the first one afterwards if there is none. The latest snapshot is
+// compared against it. Only snapshots of the release version are considered,
+// and the baseline has to be of the same report type as the latest snapshot,
+// because short reports do not record passing jobs.
+func SelectSnapshots(snapshots []*Snapshot, since time.Time, releaseVersion string) (baseline, latest *Snapshot, err error) {
+	for _, s := range snapshots {
+		if s.ReleaseVersion == releaseVersion {
+			latest = s
+		}
+	}
+
+	if latest != nil {
+		for _, s := range snapshots {
+			if s.ReleaseVersion != releaseVersion || s.ShortReport != latest.ShortReport {
+				continue
+			}
+
+			if !s.Timestamp.After(since) || baseline == nil {
+				baseline = s
+			}
+		}
+	}
+
+	if baseline == nil || baseline == latest {
+		reportType := "full"
+		if latest != nil && latest.ShortReport {
+			reportType = "short"
+		}
+
+		return nil, nil, fmt.Errorf(
+			"need at least two %s report snapshots for release version %q to compare, run the reporter first",
+			reportType, releaseVersion,
+		)
+	}
+
+	return baseline, latest, nil
+}
+
+// StateTransition is a change of a job or project board item between two
+// snapshots. An empty state means that the record was not part of the
+// snapshot.
+type StateTransition struct {
+	Reporter      CIReporterName `json:"reporter"`
+	TestgridBoard string         `json:"testgrid_board"`
+	Title         string         `json:"title"`
+	URL           string         `json:"url"`
+	From          string         `json:"from"`
+	To            string         `json:"to"`
+}
+
+// ReportDiff contains the changes between two snapshots.
+type ReportDiff struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	// JobTransitions are the testgrid jobs which changed their status.
+	JobTransitions []*StateTransition `json:"job_transitions"`
+
+	// NewlyFlaky are jobs which became flaky and newly tracked flaky test
+	// issues.
+	NewlyFlaky []*StateTransition `json:"newly_flaky"`
+
+	// IssueMoves are the project board items which changed their status.
+	IssueMoves []*StateTransition `json:"issue_moves"`
+}
+
+// Empty returns true if there are no changes.
+func (d *ReportDiff) Empty() bool {
+	return len(d.JobTransitions) == 0 && len(d.NewlyFlaky) == 0 && len(d.IssueMoves) == 0
+}
+
+// DiffSnapshots compares two snapshots.
+func DiffSnapshots(from, to *Snapshot) *ReportDiff {
+	diff := &ReportDiff{
+		From:           from.Timestamp,
+		To:             to.Timestamp,
+		JobTransitions: []*StateTransition{},
+		NewlyFlaky:     []*StateTransition{},
+		IssueMoves:     []*StateTransition{},
+	}
+
+	for _, t := range diffRecords(TestgridReporterName, from, to) {
+		diff.JobTransitions = append(diff.JobTransitions, t)
+
+		if t.To == string(testgrid.Flaky) {
+			diff.NewlyFlaky = append(diff.NewlyFlaky, t)
+		}
+	}
+
+	for _, t := range diffRecords(GithubReporterName, from, to) {
+		diff.IssueMoves = append(diff.IssueMoves, t)
+
+		if t.From == "" && strings.Contains(strings.ToLower(t.Title), "flak") {
+			diff.NewlyFlaky = append(diff.NewlyFlaky, t)
+		}
+	}
+
+	return diff
+}
+
+// diffRecords returns the status transitions of the records of a reporter.
+func diffRecords(reporter CIReporterName, from, to *Snapshot) []*StateTransition {
+	before := snapshotRecords(from, reporter)
+	after := snapshotRecords(to, reporter)
+	transitions := []*StateTransition{}
+
+	for key, record := range after {
+		status := ""
+		if old, ok := before[key]; ok {
+			status = old.Status
+		}
+
+		if status != record.Status {
+			transitions = append(transitions, newStateTransition(reporter, record, status, record.Status))
+		}
+	}
+
+	for key, record := range before {
+		if _, ok := after[key]; !ok {
+			transitions = append(transitions, newStateTransition(reporter, record, record.Status, ""))
+		}
+	}
+
+	slices.SortFunc(transitions, func(a, b *StateTransition) int {
+		if c := strings.Compare(a.TestgridBoard, b.TestgridBoard); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Title, b.Title)
+	})
+
+	return transitions
+}
+
+func newStateTransition(reporter CIReporterName, record *CIReportRecord, from, to string) *StateTransition {
+	return &StateTransition{
+		Reporter:      reporter,
+		TestgridBoard: record.TestgridBoard,
+		Title:         record.Title,
+		URL:           record.URL,
+		From:          from,
+		To:            to,
+	}
+}
+
+// snapshotRecords returns the records of a reporter by their identity.
+// Testgrid jobs are identified by board and name, project board items by
+// their URL.
+func snapshotRecords(snapshot *Snapshot, reporter CIReporterName) map[string]*CIReportRecord {
+	records := map[string]*CIReportRecord{}
+
+	for _, report := range snapshot.Reports {
+		if report.Info.Name != reporter {
+			continue
+		}
+
+		for _, record := range report.Records {
+			key := record.URL
+			if reporter == TestgridReporterName || key == "" {
+				key = record.TestgridBoard + "/" + record.Title
+			}
+
+			records[key] = record
+		}
+	}
+
+	return records
+}
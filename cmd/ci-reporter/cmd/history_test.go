@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSnapshot(ts time.Time, jobs map[string]string, issues map[string]string) *Snapshot {
+	testgridRecords := []*CIReportRecord{}
+	for job, status := range jobs {
+		testgridRecords = append(testgridRecords, &CIReportRecord{
+			TestgridBoard: "sig-release-master-blocking", Title: job, Status: status,
+		})
+	}
+
+	githubRecords := []*CIReportRecord{}
+	for title, status := range issues {
+		githubRecords = append(githubRecords, &CIReportRecord{
+			Title: title, URL: "https://github.com/kubernetes/kubernetes/issues/" + title, Status: status,
+		})
+	}
+
+	return &Snapshot{
+		Timestamp: ts,
+		Reports: CIReportDataFields{
+			{Info: CIReporterInfo{Name: GithubReporterName}, Records: githubRecords},
+			{Info: CIReporterInfo{Name: TestgridReporterName}, Records: testgridRecords},
+		},
+	}
+}
+
+func TestHistoryStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "history")
+	store := NewHistoryStore(dir)
+
+	snapshots, err := store.Load()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+
+	day1 := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+
+	for _, ts := range []time.Time{day2, day1, day2.Add(time.Hour)} {
+		require.NoError(t, store.Save(newTestSnapshot(ts, map[string]string{"unit": "PASSING"}, nil)))
+	}
+
+	snapshots, err = store.Load()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	require.Equal(t, day1, snapshots[0].Timestamp)
+	require.Equal(t, day2, snapshots[1].Timestamp)
+	require.Equal(t, "unit", snapshots[2].Reports[1].Records[0].Title)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.Equal(t, "2026-01-05.jsonl", files[0].Name())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2026-01-07.jsonl"), []byte("{invalid\n"), 0o600))
+	_, err = store.Load()
+	require.ErrorContains(t, err, "2026-01-07.jsonl:1")
+}
+
+func TestSelectSnapshots(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := []*Snapshot{
+		{Timestamp: base},
+		{Timestamp: base.AddDate(0, 0, 7)},
+		{Timestamp: base.AddDate(0, 0, 8), ReleaseVersion: "1.35"},
+		{Timestamp: base.AddDate(0, 0, 14)},
+	}
+
+	baseline, latest, err := SelectSnapshots(snapshots, base.AddDate(0, 0, 10), "")
+	require.NoError(t, err)
+	require.Same(t, snapshots[1], baseline)
+	require.Same(t, snapshots[3], latest)
+
+	// Falls back to the oldest snapshot
+	baseline, _, err = SelectSnapshots(snapshots, base.AddDate(-1, 0, 0), "")
+	require.NoError(t, err)
+	require.Same(t, snapshots[0], baseline)
+
+	_, _, err = SelectSnapshots(snapshots, base, "1.35")
+	require.Error(t, err)
+
+	_, _, err = SelectSnapshots(nil, base, "")
+	require.Error(t, err)
+}
+
+func TestSelectSnapshotsReportType(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := []*Snapshot{
+		{Timestamp: base},
+		{Timestamp: base.AddDate(0, 0, 7), ShortReport: true},
+		{Timestamp: base.AddDate(0, 0, 14)},
+	}
+
+	// The short snapshot is skipped as baseline for a full one
+	baseline, latest, err := SelectSnapshots(snapshots, base.AddDate(0, 0, 10), "")
+	require.NoError(t, err)
+	require.Same(t, snapshots[0], baseline)
+	require.Same(t, snapshots[2], latest)
+
+	// A single short snapshot has nothing to compare against
+	_, _, err = SelectSnapshots(snapshots[:2], base.AddDate(0, 0, 10), "")
+	require.ErrorContains(t, err, "two short report snapshots")
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	from := newTestSnapshot(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+		map[string]string{"unit": "PASSING", "integration": "FLAKY", "verify": "FAILING", "removed": "PASSING"},
+		map[string]string{"100": "New/Not Yet Started", "101": "In flight"},
+	)
+	to := newTestSnapshot(time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC),
+		map[string]string{"unit": "FLAKY", "integration": "FLAKY", "verify": "PASSING", "added": "FLAKY"},
+		map[string]string{"100": "In flight", "101": "In flight", "[Flaky Test] e2e": "New/Not Yet Started"},
+	)
+
+	diff := DiffSnapshots(from, to)
+	require.False(t, diff.Empty())
+
+	require.Equal(t, []*StateTransition{
+		{Reporter: TestgridReporterName, TestgridBoard: "sig-release-master-blocking", Title: "added", To: "FLAKY"},
+		{Reporter: TestgridReporterName, TestgridBoard: "sig-release-master-blocking", Title: "removed", From: "PASSING"},
+		{Reporter: TestgridReporterName, TestgridBoard: "sig-release-master-blocking", Title: "unit", From: "PASSING", To: "FLAKY"},
+		{Reporter: TestgridReporterName, TestgridBoard: "sig-release-master-blocking", Title: "verify", From: "FAILING", To: "PASSING"},
+	}, diff.JobTransitions)
+
+	require.Len(t, diff.NewlyFlaky, 3)
+	require.Equal(t, "added", diff.NewlyFlaky[0].Title)
+	require.Equal(t, "unit", diff.NewlyFlaky[1].Title)
+	require.Equal(t, "[Flaky Test] e2e", diff.NewlyFlaky[2].Title)
+
+	require.Equal(t, []*StateTransition{
+		{
+			Reporter: GithubReporterName, Title: "100", URL: "https://github.com/kubernetes/kubernetes/issues/100",
+			From: "New/Not Yet Started", To: "In flight",
+		},
+		{
+			Reporter: GithubReporterName, Title: "[Flaky Test] e2e", URL: "https://github.com/kubernetes/kubernetes/issues/[Flaky Test] e2e",
+			To: "New/Not Yet Started",
+		},
+	}, diff.IssueMoves)
+
+	require.True(t, DiffSnapshots(from, from).Empty())
+}
+
+func TestRunDiff(t *testing.T) {
+	dir := t.TempDir()
+	store := NewHistoryStore(dir)
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, store.Save(newTestSnapshot(now.AddDate(0, 0, -7), map[string]string{"unit": "PASSING"}, nil)))
+	require.NoError(t, store.Save(newTestSnapshot(now, map[string]string{"unit": "FAILING | broken"}, nil)))
+
+	for _, tc := range []struct {
+		format   string
+		expected []string
+	}{
+		{
+			format:   FormatTable,
+			expected: []string{"JOB STATE TRANSITIONS", "NEWLY FLAKY", "PROJECT BOARD MOVES", "PASSING"},
+		},
+		{
+			format: FormatMarkdown,
+			expected: []string{
+				"## Job state transitions",
+				"| sig-release-master-blocking | unit | PASSING | FAILING \\| broken |",
+				"## Project board moves\n\nNo changes.",
+			},
+		},
+		{
+			format:   FormatJSON,
+			expected: []string{`"job_transitions": [`, `"to": "FAILING | broken"`},
+		},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			c := &Config{HistoryDir: dir, Format: tc.format, Filepath: filepath.Join(t.TempDir(), "diff")}
+			require.NoError(t, RunDiff(c, &diffOptions{since: "2026-01-06"}, now))
+
+			out, err := os.ReadFile(c.Filepath)
+			require.NoError(t, err)
+
+			for _, expected := range tc.expected {
+				require.Contains(t, string(out), expected)
+			}
+
+			if tc.format == FormatJSON {
+				diff := &ReportDiff{}
+				require.NoError(t, json.Unmarshal(out, diff))
+				require.Len(t, diff.JobTransitions, 1)
+			}
+		})
+	}
+
+	require.Error(t, RunDiff(&Config{HistoryDir: dir, Format: "xml"}, &diffOptions{since: "2026-01-06"}, now))
+	require.Error(t, RunDiff(&Config{HistoryDir: dir, Format: FormatHTML}, &diffOptions{since: "2026-01-06"}, now))
+	require.Error(t, RunDiff(&Config{HistoryDir: dir}, &diffOptions{since: "last week"}, now))
+	require.Error(t, RunDiff(&Config{HistoryDir: t.TempDir()}, &diffOptions{since: "168h"}, now))
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+
+	since, err := parseSince("168h", now)
+	require.NoError(t, err)
+	require.Equal(t, now.AddDate(0, 0, -7), since)
+
+	since, err = parseSince("2026-01-05T10:00:00Z", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC), since)
+
+	since, err = parseSince("2026-01-05", now)
+	require.NoError(t, err)
+	require.Equal(t, "2026-01-05", since.Format(time.DateOnly))
+}
+
+func TestPrintReportDiffEmpty(t *testing.T) {
+	out := &bytes.Buffer{}
+	require.NoError(t, PrintReportDiff(out, FormatMarkdown, &ReportDiff{}))
+	require.Equal(t, 3, bytes.Count(out.Bytes(), []byte("No changes.")))
+}
@@ -0,0 +1,311 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"k8s.io/release/pkg/testgrid"
+)
+
+const (
+	prowBaseURL = "https://prow.k8s.io"
+
+	defaultProwBuilds = 10
+
+	// shortCommitLength is the length of commits shown in the report.
+	shortCommitLength = 10
+)
+
+// defaultProwJobs are the periodic jobs of the sig-release-master-blocking
+// dashboard.
+var defaultProwJobs = []string{
+	"ci-kubernetes-build",
+	"ci-kubernetes-unit",
+	"ci-kubernetes-integration-master",
+	"ci-kubernetes-verify-master",
+	"ci-kubernetes-e2e-gci-gce",
+	"ci-kubernetes-e2e-kind",
+	"ci-kubernetes-node-e2e-containerd",
+	"ci-kubernetes-e2e-ubuntu-gce-containerd",
+}
+
+var prowCmd = &cobra.Command{
+	Use:   "prow",
+	Short: "Prow job history report generator",
+	Long: `CI-Signal reporter that generates only a Prow job history report.
+
+Reads the started.json, finished.json and junit_*.xml artifacts of the latest
+builds of the release blocking jobs and reports the failure rate of every
+failing test, the first failing build and the last green commit. The artifacts
+are read from the public kubernetes-ci-logs bucket or from a local directory
+with the same <job>/<build>/ layout.`,
+	Example: "reporter prow --prow-job ci-kubernetes-unit --prow-builds 20",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return RunReport(cmd.Context(), cfg, &CIReporters{ProwReporter{}})
+	},
+}
+
+// ProwReporterName used to identify the prow reporter.
+var ProwReporterName CIReporterName = "prow"
+
+func init() {
+	rootCmd.PersistentFlags().StringSliceVar(&cfg.ProwJobs, "prow-job", defaultProwJobs, "Prow jobs to analyze the history of")
+	rootCmd.PersistentFlags().IntVar(&cfg.ProwBuilds, "prow-builds", defaultProwBuilds, "Number of the latest finished builds to analyze per Prow job")
+	rootCmd.PersistentFlags().StringVar(&cfg.ProwArtifactsDir, "prow-artifacts-dir", "", "Read the Prow job artifacts from a local directory instead of the kubernetes-ci-logs bucket")
+	rootCmd.AddCommand(prowCmd)
+}
+
+// ProwReporter prow job history CIReporter implementation.
+type ProwReporter struct{}
+
+// GetCIReporterHead implementation from CIReporter.
+func (r ProwReporter) GetCIReporterHead() CIReporterInfo {
+	return CIReporterInfo{Name: ProwReporterName}
+}
+
+// CollectReportData implementation from CIReporter.
+func (r ProwReporter) CollectReportData(ctx context.Context, cfg *Config) ([]*CIReportRecord, error) {
+	var store prowArtifactStore = &localProwArtifactStore{root: cfg.ProwArtifactsDir}
+
+	if cfg.ProwArtifactsDir == "" {
+		gcsStore, closeStore, err := newGCSProwArtifactStore(ctx, prowLogsBucket, prowLogsRoot)
+		if err != nil {
+			return nil, err
+		}
+
+		defer func() {
+			if err := closeStore(); err != nil {
+				logrus.Warnf("Unable to close storage client: %v", err)
+			}
+		}()
+
+		store = gcsStore
+	}
+
+	records := []*CIReportRecord{}
+
+	for _, job := range cfg.ProwJobs {
+		builds, err := readProwBuilds(ctx, store, job, cfg.ProwBuilds)
+		if err != nil {
+			return nil, fmt.Errorf("reading builds of %s: %w", job, err)
+		}
+
+		if len(builds) == 0 {
+			logrus.Warnf("No finished builds found for Prow job %s", job)
+
+			continue
+		}
+
+		records = append(records, NewProwJobStats(job, builds).Records(cfg.ShortReport)...)
+	}
+
+	return records, nil
+}
+
+// ProwTestStats is the history of a single test of a job.
+type ProwTestStats struct {
+	Name     string
+	Runs     int
+	Failures int
+
+	// LatestFailed is true if the test failed in its latest run.
+	LatestFailed bool
+
+	// FirstFailure is the first failing build of the latest streak of
+	// failures.
+	FirstFailure *ProwBuild
+
+	// LastGreen is the latest build in which the test passed.
+	LastGreen *ProwBuild
+
+	// LastRun is the latest build in which the test ran.
+	LastRun *ProwBuild
+}
+
+// ProwJobStats is the history of a Prow job.
+type ProwJobStats struct {
+	Job      string
+	Builds   []*ProwBuild
+	Failures int
+
+	// FirstFailure is the first failing build of the current streak of
+	// failures, nil if the latest build passed.
+	FirstFailure *ProwBuild
+
+	// LastGreen is the latest passed build.
+	LastGreen *ProwBuild
+
+	// Tests are the tests which failed at least once, ordered by their
+	// failure rate.
+	Tests []*ProwTestStats
+}
+
+// NewProwJobStats analyzes the builds of a job, which have to be ordered
+// from the oldest to the newest build.
+func NewProwJobStats(job string, builds []*ProwBuild) *ProwJobStats {
+	stats := &ProwJobStats{Job: job, Builds: builds}
+	tests := map[string]*ProwTestStats{}
+
+	for _, build := range builds {
+		if build.Passed {
+			stats.LastGreen = build
+			stats.FirstFailure = nil
+		} else {
+			stats.Failures++
+
+			if stats.FirstFailure == nil {
+				stats.FirstFailure = build
+			}
+		}
+
+		for name, passed := range build.Tests {
+			test, ok := tests[name]
+			if !ok {
+				test = &ProwTestStats{Name: name}
+				tests[name] = test
+			}
+
+			failedBefore := test.Runs > 0 && test.LatestFailed
+			test.Runs++
+			test.LastRun = build
+			test.LatestFailed = !passed
+
+			if passed {
+				test.LastGreen = build
+
+				continue
+			}
+
+			test.Failures++
+
+			if !failedBefore {
+				test.FirstFailure = build
+			}
+		}
+	}
+
+	for _, test := range tests {
+		if test.Failures > 0 {
+			stats.Tests = append(stats.Tests, test)
+		}
+	}
+
+	slices.SortFunc(stats.Tests, func(a, b *ProwTestStats) int {
+		// Compare a.Failures/a.Runs with b.Failures/b.Runs
+		if c := b.Failures*a.Runs - a.Failures*b.Runs; c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return stats
+}
+
+// Status returns the status of the job, which is failing if the latest
+// build failed and flaky if any of the analyzed builds failed.
+func (s *ProwJobStats) Status() testgrid.OverallStatus {
+	switch {
+	case s.FirstFailure != nil:
+		return testgrid.Failing
+	case s.Failures > 0:
+		return testgrid.Flaky
+	default:
+		return testgrid.Passing
+	}
+}
+
+// Records converts the job history into report records, one for the job
+// and one for every failed test.
+func (s *ProwJobStats) Records(short bool) []*CIReportRecord {
+	status := s.Status()
+	records := []*CIReportRecord{}
+
+	if short && status == testgrid.Passing {
+		return records
+	}
+
+	latest := s.Builds[len(s.Builds)-1]
+	details := []string{fmt.Sprintf("%d/%d builds failed", s.Failures, len(s.Builds))}
+
+	if s.FirstFailure != nil {
+		details = append(details, "first failing build "+s.FirstFailure.ID)
+	}
+
+	details = append(details, "last green commit "+lastGreenCommit(s.LastGreen))
+
+	records = append(records, &CIReportRecord{
+		Title:            s.Job,
+		URL:              fmt.Sprintf("%s/job-history/gs/%s/%s/%s", prowBaseURL, prowLogsBucket, prowLogsRoot, s.Job),
+		Status:           string(status),
+		StatusDetails:    strings.Join(details, ", "),
+		CreatedTimestamp: formatProwTime(s.Builds[0].Started),
+		UpdatedTimestamp: formatProwTime(latest.Finished),
+	})
+
+	for _, test := range s.Tests {
+		testStatus := testgrid.Flaky
+		if test.LatestFailed {
+			testStatus = testgrid.Failing
+		}
+
+		records = append(records, &CIReportRecord{
+			Title:  fmt.Sprintf("%s: %s", s.Job, test.Name),
+			URL:    prowBuildURL(s.Job, test.FirstFailure.ID),
+			Status: string(testStatus),
+			StatusDetails: fmt.Sprintf(
+				"failure rate %.0f%% (%d/%d), first failing build %s, last green commit %s",
+				100*float64(test.Failures)/float64(test.Runs), test.Failures, test.Runs,
+				test.FirstFailure.ID, lastGreenCommit(test.LastGreen),
+			),
+			CreatedTimestamp: formatProwTime(test.FirstFailure.Started),
+			UpdatedTimestamp: formatProwTime(test.LastRun.Finished),
+		})
+	}
+
+	return records
+}
+
+func prowBuildURL(job, build string) string {
+	return fmt.Sprintf("%s/view/gs/%s", prowBaseURL, path.Join(prowLogsBucket, prowLogsRoot, job, build))
+}
+
+func lastGreenCommit(build *ProwBuild) string {
+	switch {
+	case build == nil:
+		return "none"
+	case build.Commit == "":
+		return "unknown (build " + build.ID + ")"
+	case len(build.Commit) > shortCommitLength:
+		return build.Commit[:shortCommitLength]
+	default:
+		return build.Commit
+	}
+}
+
+func formatProwTime(t time.Time) string {
+	return t.UTC().Format(time.DateTime + " UTC")
+}
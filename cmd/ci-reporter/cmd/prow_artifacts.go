@@ -0,0 +1,416 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+const (
+	// prowLogsBucket is the public bucket containing the artifacts of the
+	// periodic Kubernetes CI jobs.
+	prowLogsBucket = "kubernetes-ci-logs"
+	prowLogsRoot   = "logs"
+
+	prowStartedFile  = "started.json"
+	prowFinishedFile = "finished.json"
+	prowArtifactsDir = "artifacts"
+	prowJUnitPattern = "junit_*.xml"
+)
+
+// errBuildNotFinished is returned for builds without a finished.json.
+var errBuildNotFinished = errors.New("build is not finished")
+
+// prowArtifactStore provides access to the job artifacts, which are stored
+// as <job>/<build>/{started.json,finished.json,artifacts/junit_*.xml}.
+type prowArtifactStore interface {
+	// ListDirs returns the names of the direct sub directories of the path.
+	ListDirs(ctx context.Context, dir string) ([]string, error)
+
+	// ListFiles returns the names of the files within the path.
+	ListFiles(ctx context.Context, dir string) ([]string, error)
+
+	// ReadFile returns the content of a file. Returns an error wrapping
+	// os.ErrNotExist if the file does not exist.
+	ReadFile(ctx context.Context, file string) ([]byte, error)
+}
+
+// localProwArtifactStore reads the artifacts from a local directory.
+type localProwArtifactStore struct {
+	root string
+}
+
+func (s *localProwArtifactStore) readDir(dir string, dirs bool) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(s.root, filepath.FromSlash(dir)))
+	if errors.Is(err, os.ErrNotExist) {
+		return []string{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading directory: %w", err)
+	}
+
+	names := []string{}
+
+	for _, entry := range entries {
+		if entry.IsDir() == dirs {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
+
+func (s *localProwArtifactStore) ListDirs(_ context.Context, dir string) ([]string, error) {
+	return s.readDir(dir, true)
+}
+
+func (s *localProwArtifactStore) ListFiles(_ context.Context, dir string) ([]string, error) {
+	return s.readDir(dir, false)
+}
+
+func (s *localProwArtifactStore) ReadFile(_ context.Context, file string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(s.root, filepath.FromSlash(file)))
+	if err != nil {
+		return nil, fmt.Errorf("reading file: %w", err)
+	}
+
+	return data, nil
+}
+
+// gcsProwArtifactStore reads the artifacts anonymously from a public GCS
+// bucket.
+type gcsProwArtifactStore struct {
+	bucket *storage.BucketHandle
+	root   string
+}
+
+func newGCSProwArtifactStore(ctx context.Context, bucket, root string) (*gcsProwArtifactStore, func() error, error) {
+	client, err := storage.NewClient(ctx, option.WithoutAuthentication())
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating storage client: %w", err)
+	}
+
+	return &gcsProwArtifactStore{bucket: client.Bucket(bucket), root: root}, client.Close, nil
+}
+
+func (s *gcsProwArtifactStore) list(ctx context.Context, dir string, dirs bool) ([]string, error) {
+	prefix := path.Join(s.root, dir) + "/"
+	it := s.bucket.Objects(ctx, &storage.Query{Prefix: prefix, Delimiter: "/"})
+	names := []string{}
+
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", prefix, err)
+		}
+
+		switch {
+		case dirs && attrs.Prefix != "":
+			names = append(names, strings.TrimSuffix(strings.TrimPrefix(attrs.Prefix, prefix), "/"))
+		case !dirs && attrs.Name != "":
+			names = append(names, strings.TrimPrefix(attrs.Name, prefix))
+		}
+	}
+
+	return names, nil
+}
+
+func (s *gcsProwArtifactStore) ListDirs(ctx context.Context, dir string) ([]string, error) {
+	return s.list(ctx, dir, true)
+}
+
+func (s *gcsProwArtifactStore) ListFiles(ctx context.Context, dir string) ([]string, error) {
+	return s.list(ctx, dir, false)
+}
+
+func (s *gcsProwArtifactStore) ReadFile(ctx context.Context, file string) ([]byte, error) {
+	name := path.Join(s.root, file)
+
+	r, err := s.bucket.Object(name).NewReader(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, fmt.Errorf("reading %s: %w", name, os.ErrNotExist)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", name, err)
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", name, err)
+	}
+
+	return data, nil
+}
+
+// prowStarted is the content of the started.json of a build.
+type prowStarted struct {
+	Timestamp   int64             `json:"timestamp"`
+	RepoCommit  string            `json:"repo-commit"`
+	RepoVersion string            `json:"repo-version"`
+	Repos       map[string]string `json:"repos"`
+}
+
+// prowFinished is the content of the finished.json of a build.
+type prowFinished struct {
+	Timestamp int64  `json:"timestamp"`
+	Passed    *bool  `json:"passed"`
+	Result    string `json:"result"`
+	Revision  string `json:"revision"`
+}
+
+// junitSuite is a junit <testsuites> or <testsuite> element, which can be
+// nested.
+type junitSuite struct {
+	Suites []junitSuite    `xml:"testsuite"`
+	Cases  []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string       `xml:"name,attr"`
+	ClassName string       `xml:"classname,attr"`
+	Failure   *junitResult `xml:"failure"`
+	Error     *junitResult `xml:"error"`
+	Skipped   *junitResult `xml:"skipped"`
+}
+
+type junitResult struct {
+	Message string `xml:"message,attr"`
+}
+
+// parseJUnit returns the result of every non skipped test case, keyed by the
+// test name. A test which ran multiple times is failed if any run failed.
+func parseJUnit(data []byte, results map[string]bool) error {
+	suite := junitSuite{}
+	if err := xml.Unmarshal(data, &suite); err != nil {
+		return fmt.Errorf("unmarshal junit: %w", err)
+	}
+
+	var walk func(junitSuite)
+
+	walk = func(s junitSuite) {
+		for _, c := range s.Cases {
+			if c.Skipped != nil {
+				continue
+			}
+
+			name := c.Name
+			if c.ClassName != "" {
+				name = c.ClassName + "." + c.Name
+			}
+
+			passed := c.Failure == nil && c.Error == nil
+			if previous, ok := results[name]; ok {
+				passed = passed && previous
+			}
+
+			results[name] = passed
+		}
+
+		for _, nested := range s.Suites {
+			walk(nested)
+		}
+	}
+
+	walk(suite)
+
+	return nil
+}
+
+// ProwBuild is a single finished build of a Prow job.
+type ProwBuild struct {
+	ID       string
+	Started  time.Time
+	Finished time.Time
+	Passed   bool
+	Commit   string
+
+	// Tests contains the result of every test, true if it passed.
+	Tests map[string]bool
+}
+
+// commit returns the tested commit of the build from the available
+// metadata.
+func (s *prowStarted) commit(finished *prowFinished) string {
+	if s.RepoCommit != "" {
+		return s.RepoCommit
+	}
+
+	if finished.Revision != "" {
+		return finished.Revision
+	}
+
+	// Version like v1.36.0-alpha.0.123+0123456789abcdef
+	if _, sha, ok := strings.Cut(s.RepoVersion, "+"); ok {
+		return sha
+	}
+
+	// Repos like "k8s.io/kubernetes": "master:0123456789abcdef"
+	if ref, ok := s.Repos["k8s.io/kubernetes"]; ok {
+		if i := strings.LastIndex(ref, ":"); i >= 0 {
+			return ref[i+1:]
+		}
+	}
+
+	return ""
+}
+
+// readProwBuild reads the artifacts of a build. Returns errBuildNotFinished
+// if the build is not finished yet.
+func readProwBuild(ctx context.Context, store prowArtifactStore, job, id string) (*ProwBuild, error) {
+	dir := path.Join(job, id)
+
+	finishedData, err := store.ReadFile(ctx, path.Join(dir, prowFinishedFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("reading build %s: %w", dir, errBuildNotFinished)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	finished := &prowFinished{}
+	if err := json.Unmarshal(finishedData, finished); err != nil {
+		return nil, fmt.Errorf("unmarshal %s of build %s: %w", prowFinishedFile, dir, err)
+	}
+
+	started := &prowStarted{}
+
+	startedData, err := store.ReadFile(ctx, path.Join(dir, prowStartedFile))
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(startedData, started); err != nil {
+			return nil, fmt.Errorf("unmarshal %s of build %s: %w", prowStartedFile, dir, err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, err
+	}
+
+	passed := finished.Result == "SUCCESS"
+	if finished.Passed != nil {
+		passed = *finished.Passed
+	}
+
+	build := &ProwBuild{
+		ID:       id,
+		Started:  time.Unix(started.Timestamp, 0).UTC(),
+		Finished: time.Unix(finished.Timestamp, 0).UTC(),
+		Passed:   passed,
+		Commit:   started.commit(finished),
+		Tests:    map[string]bool{},
+	}
+
+	artifacts := path.Join(dir, prowArtifactsDir)
+
+	files, err := store.ListFiles(ctx, artifacts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		if ok, _ := path.Match(prowJUnitPattern, file); !ok { //nolint:errcheck // the pattern is valid
+			continue
+		}
+
+		data, err := store.ReadFile(ctx, path.Join(artifacts, file))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := parseJUnit(data, build.Tests); err != nil {
+			return nil, fmt.Errorf("parsing %s of build %s: %w", file, dir, err)
+		}
+	}
+
+	return build, nil
+}
+
+// readProwBuilds reads the latest finished builds of a job, ordered from
+// the oldest to the newest build.
+func readProwBuilds(ctx context.Context, store prowArtifactStore, job string, limit int) ([]*ProwBuild, error) {
+	dirs, err := store.ListDirs(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	type buildID struct {
+		id  string
+		num uint64
+	}
+
+	ids := []buildID{}
+
+	for _, dir := range dirs {
+		num, err := strconv.ParseUint(dir, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		ids = append(ids, buildID{id: dir, num: num})
+	}
+
+	// Newest builds first
+	slices.SortFunc(ids, func(a, b buildID) int {
+		return cmp.Compare(b.num, a.num)
+	})
+
+	builds := []*ProwBuild{}
+
+	for _, id := range ids {
+		if len(builds) == limit {
+			break
+		}
+
+		build, err := readProwBuild(ctx, store, job, id.id)
+		if errors.Is(err, errBuildNotFinished) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	slices.Reverse(builds)
+
+	return builds, nil
+}
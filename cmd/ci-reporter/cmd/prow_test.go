@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeProwBuild writes the artifacts of a build, failed tests are prefixed
+// with "!".
+func writeProwBuild(t *testing.T, root, job string, build int, passed bool, tests ...string) {
+	t.Helper()
+
+	dir := filepath.Join(root, job, fmt.Sprint(build))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, prowArtifactsDir), 0o755))
+
+	ts := 1767225600 + int64(build)*3600
+	require.NoError(t, os.WriteFile(filepath.Join(dir, prowStartedFile), fmt.Appendf(nil,
+		`{"timestamp": %d, "repo-version": "v1.36.0-alpha.0.%d+commit%d"}`, ts, build, build,
+	), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, prowFinishedFile), fmt.Appendf(nil,
+		`{"timestamp": %d, "passed": %t, "result": "SUCCESS"}`, ts+1800, passed,
+	), 0o600))
+
+	cases := &strings.Builder{}
+
+	for _, test := range tests {
+		if name, ok := strings.CutPrefix(test, "!"); ok {
+			fmt.Fprintf(cases, `<testcase name=%q classname="e2e"><failure message="failed"/></testcase>`, name)
+		} else {
+			fmt.Fprintf(cases, `<testcase name=%q classname="e2e"/>`, test)
+		}
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, prowArtifactsDir, "junit_01.xml"), fmt.Appendf(nil,
+		`<testsuites><testsuite name="e2e">%s<testcase name="skipped" classname="e2e"><skipped/></testcase></testsuite></testsuites>`,
+		cases,
+	), 0o600))
+}
+
+func TestProwReporter(t *testing.T) {
+	root := t.TempDir()
+	job := "ci-kubernetes-e2e-gci-gce"
+
+	writeProwBuild(t, root, job, 1, true, "a", "b", "c")
+	writeProwBuild(t, root, job, 2, true, "a", "b", "c")
+	writeProwBuild(t, root, job, 3, false, "a", "!b", "c")
+	writeProwBuild(t, root, job, 9, true, "a", "b", "c")
+	writeProwBuild(t, root, job, 10, false, "!a", "!b", "c")
+	writeProwBuild(t, root, job, 11, false, "!a", "b", "c")
+
+	// Still running
+	require.NoError(t, os.MkdirAll(filepath.Join(root, job, "12"), 0o755))
+
+	_, err := readProwBuild(t.Context(), &localProwArtifactStore{root: root}, job, "12")
+	require.ErrorIs(t, err, errBuildNotFinished)
+
+	writeProwBuild(t, root, "ci-kubernetes-unit", 1, true, "unit")
+
+	cfg := &Config{
+		ProwArtifactsDir: root,
+		ProwBuilds:       5,
+		ProwJobs:         []string{job, "ci-kubernetes-unit", "ci-kubernetes-missing"},
+	}
+
+	records, err := ProwReporter{}.CollectReportData(t.Context(), cfg)
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+
+	require.Equal(t, job, records[0].Title)
+	require.Equal(t, "FAILING", records[0].Status)
+	require.Equal(t, "3/5 builds failed, first failing build 10, last green commit commit9", records[0].StatusDetails)
+	require.Equal(t, "https://prow.k8s.io/job-history/gs/kubernetes-ci-logs/logs/"+job, records[0].URL)
+
+	// Sorted by failure rate
+	require.Equal(t, job+": e2e.a", records[1].Title)
+	require.Equal(t, "FAILING", records[1].Status)
+	require.Equal(t, "failure rate 40% (2/5), first failing build 10, last green commit commit9", records[1].StatusDetails)
+	require.Equal(t, "https://prow.k8s.io/view/gs/kubernetes-ci-logs/logs/"+job+"/10", records[1].URL)
+
+	require.Equal(t, job+": e2e.b", records[2].Title)
+	require.Equal(t, "FLAKY", records[2].Status)
+	require.Equal(t, "failure rate 40% (2/5), first failing build 10, last green commit commit11", records[2].StatusDetails)
+
+	require.Equal(t, "ci-kubernetes-unit", records[3].Title)
+	require.Equal(t, "PASSING", records[3].Status)
+
+	cfg.ShortReport = true
+	records, err = ProwReporter{}.CollectReportData(t.Context(), cfg)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+}
+
+func TestParseJUnit(t *testing.T) {
+	results := map[string]bool{}
+
+	require.NoError(t, parseJUnit([]byte(`<testsuite>
+  <testcase name="TestA" classname="k8s.io/pkg"/>
+  <testcase name="TestB" classname="k8s.io/pkg"><error/></testcase>
+  <testcase name="TestA" classname="k8s.io/pkg"><failure/></testcase>
+  <testcase name="TestC"><skipped/></testcase>
+</testsuite>`), results))
+	require.Equal(t, map[string]bool{"k8s.io/pkg.TestA": false, "k8s.io/pkg.TestB": false}, results)
+
+	require.Error(t, parseJUnit([]byte("<testsuite"), results))
+}
+
+func TestProwStartedCommit(t *testing.T) {
+	for _, tc := range []struct {
+		started  prowStarted
+		finished prowFinished
+		expected string
+	}{
+		{started: prowStarted{RepoCommit: "abc"}, finished: prowFinished{Revision: "def"}, expected: "abc"},
+		{finished: prowFinished{Revision: "def"}, expected: "def"},
+		{started: prowStarted{RepoVersion: "v1.36.0-alpha.0.1+abc"}, expected: "abc"},
+		{started: prowStarted{Repos: map[string]string{"k8s.io/kubernetes": "master:abc"}}, expected: "abc"},
+		{expected: ""},
+	} {
+		require.Equal(t, tc.expected, tc.started.commit(&tc.finished))
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"slices"
+	"strings"
+
+	"k8s.io/release/pkg/testgrid"
+)
+
+const (
+	testgridBaseURL = "https://testgrid.k8s.io/"
+
+	// statusUnknown groups records without a status.
+	statusUnknown = "NO STATUS"
+)
+
+// statusGroup contains the records of a reporter with the same status.
+type statusGroup struct {
+	Status  string
+	Records []*CIReportRecord
+}
+
+// reportSection is a reporter with its records grouped by status.
+type reportSection struct {
+	Name     string
+	Total    int
+	Overview []CIReportOverview
+	Groups   []statusGroup
+}
+
+// statusOrder sorts the most severe testgrid states first.
+var statusOrder = []string{
+	string(testgrid.Failing),
+	string(testgrid.Flaky),
+	string(testgrid.Stale),
+	string(testgrid.Passing),
+}
+
+// reportSections groups the records of all reporters by status.
+func reportSections(reports *CIReportDataFields) []reportSection {
+	sections := []reportSection{}
+
+	for _, report := range *reports {
+		groups := map[string][]*CIReportRecord{}
+
+		for _, record := range report.Records {
+			status := strings.TrimSpace(record.Status)
+			if status == "" {
+				status = statusUnknown
+			}
+
+			groups[status] = append(groups[status], record)
+		}
+
+		statuses := make([]string, 0, len(groups))
+		for status := range groups {
+			statuses = append(statuses, status)
+		}
+
+		slices.SortFunc(statuses, compareStatus)
+
+		section := reportSection{
+			Name:     strings.ToUpper(string(report.Info.Name)),
+			Total:    len(report.Records),
+			Overview: report.Overview,
+		}
+
+		for _, status := range statuses {
+			records := groups[status]
+			slices.SortStableFunc(records, func(a, b *CIReportRecord) int {
+				if c := strings.Compare(a.TestgridBoard, b.TestgridBoard); c != 0 {
+					return c
+				}
+
+				return strings.Compare(a.Title, b.Title)
+			})
+
+			section.Groups = append(section.Groups, statusGroup{Status: status, Records: records})
+		}
+
+		sections = append(sections, section)
+	}
+
+	return sections
+}
+
+func compareStatus(a, b string) int {
+	ai, bi := slices.Index(statusOrder, a), slices.Index(statusOrder, b)
+
+	switch {
+	case ai >= 0 && bi >= 0:
+		return ai - bi
+	case ai >= 0:
+		return -1
+	case bi >= 0:
+		return 1
+	case a == statusUnknown:
+		return 1
+	case b == statusUnknown:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// testgridBoardURL returns the link to a testgrid dashboard. Project board
+// items refer to the dashboards without the sig-release prefix.
+func testgridBoardURL(board string) string {
+	board = strings.TrimSpace(board)
+	if board == "" {
+		return ""
+	}
+
+	if !strings.HasPrefix(board, "sig-") {
+		board = "sig-release-" + board
+	}
+
+	return testgridBaseURL + board
+}
+
+// markdownLink returns a markdown link or the escaped text if there is no
+// URL.
+func markdownLink(text, url string) string {
+	text = escapeMarkdownCell(text)
+	if url == "" {
+		return text
+	}
+
+	return fmt.Sprintf("[%s](%s)", text, url)
+}
+
+// RenderMarkdown renders the report as markdown, grouped by reporter and
+// status, to be pasted into meeting notes.
+func RenderMarkdown(reports *CIReportDataFields) string {
+	b := &strings.Builder{}
+	b.WriteString("# CI Signal Report\n")
+
+	for _, section := range reportSections(reports) {
+		fmt.Fprintf(b, "\n## %s (%d)\n", section.Name, section.Total)
+
+		if len(section.Overview) > 0 {
+			b.WriteString("\n| Testgrid Board | Passing | Flaky | Failing | Stale |\n|---|---:|---:|---:|---:|\n")
+
+			for _, o := range section.Overview {
+				fmt.Fprintf(b, "| %s | %d | %d | %d | %d |\n",
+					markdownLink(o.TestgridBoard, testgridBoardURL(o.TestgridBoard)), o.Passing, o.Flaky, o.Failing, o.Stale,
+				)
+			}
+		}
+
+		if len(section.Groups) == 0 {
+			b.WriteString("\nNothing to report.\n")
+
+			continue
+		}
+
+		for _, group := range section.Groups {
+			fmt.Fprintf(b, "\n### %s (%d)\n\n", group.Status, len(group.Records))
+			b.WriteString("| Testgrid Board | Title | Details |\n|---|---|---|\n")
+
+			for _, r := range group.Records {
+				fmt.Fprintf(b, "| %s | %s | %s |\n",
+					markdownLink(r.TestgridBoard, testgridBoardURL(r.TestgridBoard)),
+					markdownLink(r.Title, r.URL),
+					escapeMarkdownCell(r.StatusDetails),
+				)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+var htmlReportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"boardURL": testgridBoardURL,
+	"lower":    strings.ToLower,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>CI Signal Report</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #24292f; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #d0d7de; padding: 4px 8px; text-align: left; }
+th { background: #f6f8fa; }
+td.count { text-align: right; }
+h3 { padding: 2px 6px; border-left: 6px solid #8c959f; }
+h3.failing { border-color: #cf222e; }
+h3.flaky { border-color: #bf8700; }
+h3.passing { border-color: #1a7f37; }
+</style>
+</head>
+<body>
+<h1>CI Signal Report</h1>
+{{- range .}}
+<h2>{{.Name}} ({{.Total}})</h2>
+{{- if .Overview}}
+<table>
+<tr><th>Testgrid Board</th><th>Passing</th><th>Flaky</th><th>Failing</th><th>Stale</th></tr>
+{{- range .Overview}}
+<tr><td><a href="{{boardURL .TestgridBoard}}">{{.TestgridBoard}}</a></td><td class="count">{{.Passing}}</td><td class="count">{{.Flaky}}</td><td class="count">{{.Failing}}</td><td class="count">{{.Stale}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- range .Groups}}
+<h3 class="{{lower .Status}}">{{.Status}} ({{len .Records}})</h3>
+<table>
+<tr><th>Testgrid Board</th><th>Title</th><th>Details</th></tr>
+{{- range .Records}}
+<tr><td>{{with boardURL .TestgridBoard}}<a href="{{.}}">{{end}}{{.TestgridBoard}}{{if .TestgridBoard}}</a>{{end}}</td><td>{{if .URL}}<a href="{{.URL}}">{{.Title}}</a>{{else}}{{.Title}}{{end}}</td><td>{{.StatusDetails}}</td></tr>
+{{- end}}
+</table>
+{{- else}}
+<p>Nothing to report.</p>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
+
+// RenderHTML renders the report as self-contained HTML document, grouped by
+// reporter and status.
+func RenderHTML(reports *CIReportDataFields) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := htmlReportTemplate.Execute(buf, reportSections(reports)); err != nil {
+		return "", fmt.Errorf("executing HTML template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// newCIReportOverview counts the jobs of a testgrid dashboard by status.
+func newCIReportOverview(board testgrid.DashboardName, jobs testgrid.JobData) (CIReportOverview, error) {
+	overview, err := jobs.Overview()
+	if err != nil {
+		return CIReportOverview{}, fmt.Errorf("getting overview of %s: %w", board, err)
+	}
+
+	return CIReportOverview{
+		TestgridBoard: string(board),
+		Passing:       len(overview.PassingJobs),
+		Flaky:         len(overview.FlakyJobs),
+		Failing:       len(overview.FailingJobs),
+		Stale:         len(overview.StaleJobs),
+	}, nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/testgrid"
+)
+
+func newTestReports() *CIReportDataFields {
+	return &CIReportDataFields{
+		{
+			Info: CIReporterInfo{Name: GithubReporterName},
+			Records: []*CIReportRecord{
+				{Title: "[Flaky Test] e2e | serial", URL: "https://github.com/kubernetes/kubernetes/issues/1", Status: "In flight"},
+			},
+		},
+		{
+			Info: CIReporterInfo{Name: TestgridReporterName},
+			Overview: []CIReportOverview{
+				{TestgridBoard: "sig-release-master-blocking", Passing: 10, Flaky: 1, Failing: 1},
+			},
+			Records: []*CIReportRecord{
+				{TestgridBoard: "sig-release-master-blocking", Title: "unit", Status: "PASSING"},
+				{TestgridBoard: "sig-release-master-blocking", Title: "verify", Status: "FAILING", StatusDetails: "2/10 failed"},
+				{TestgridBoard: "sig-release-master-blocking", Title: "integration", Status: "FLAKY"},
+			},
+		},
+	}
+}
+
+func TestOutputFormat(t *testing.T) {
+	for _, tc := range []struct {
+		cfg         Config
+		expected    string
+		shouldError bool
+	}{
+		{cfg: Config{}, expected: FormatTable},
+		{cfg: Config{Format: FormatHTML}, expected: FormatHTML},
+		{cfg: Config{Format: FormatMarkdown, JSONOutput: true}, expected: FormatJSON},
+		{cfg: Config{Format: "pdf"}, shouldError: true},
+	} {
+		format, err := tc.cfg.OutputFormat()
+		if tc.shouldError {
+			require.Error(t, err)
+
+			continue
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, format)
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	out := RenderMarkdown(newTestReports())
+
+	for _, expected := range []string{
+		"## GITHUB (1)\n\n### In flight (1)",
+		"[[Flaky Test] e2e \\| serial](https://github.com/kubernetes/kubernetes/issues/1)",
+		"## TESTGRID (3)",
+		"| [sig-release-master-blocking](https://testgrid.k8s.io/sig-release-master-blocking) | 10 | 1 | 1 | 0 |",
+		"| [sig-release-master-blocking](https://testgrid.k8s.io/sig-release-master-blocking) | verify | 2/10 failed |",
+	} {
+		require.Contains(t, out, expected)
+	}
+
+	failing := strings.Index(out, "### FAILING (1)")
+	flaky := strings.Index(out, "### FLAKY (1)")
+	passing := strings.Index(out, "### PASSING (1)")
+	require.Positive(t, failing)
+	require.Less(t, failing, flaky)
+	require.Less(t, flaky, passing)
+}
+
+func TestRenderHTML(t *testing.T) {
+	out, err := RenderHTML(newTestReports())
+	require.NoError(t, err)
+
+	require.True(t, strings.HasPrefix(out, "<!DOCTYPE html>"))
+	require.Contains(t, out, `<h3 class="failing">FAILING (1)</h3>`)
+	require.Contains(t, out, `<a href="https://github.com/kubernetes/kubernetes/issues/1">[Flaky Test] e2e | serial</a>`)
+	require.Contains(t, out, `<td class="count">10</td>`)
+	require.Less(t, strings.Index(out, "FAILING"), strings.Index(out, "FLAKY"))
+}
+
+func TestPrintReporterDataFormats(t *testing.T) {
+	for _, format := range []string{FormatMarkdown, FormatHTML} {
+		cfg := &Config{Format: format, Filepath: filepath.Join(t.TempDir(), "report")}
+		require.NoError(t, PrintReporterData(cfg, newTestReports()))
+
+		out, err := os.ReadFile(cfg.Filepath)
+		require.NoError(t, err)
+		require.Contains(t, string(out), "CI Signal Report")
+	}
+
+	require.Error(t, PrintReporterData(&Config{Format: "pdf"}, newTestReports()))
+}
+
+func TestTestgridBoardURL(t *testing.T) {
+	require.Equal(t, "https://testgrid.k8s.io/sig-release-master-blocking", testgridBoardURL("master-blocking"))
+	require.Equal(t, "https://testgrid.k8s.io/sig-release-1.35-informing", testgridBoardURL("sig-release-1.35-informing"))
+	require.Empty(t, testgridBoardURL(""))
+}
+
+func TestNewCIReportOverview(t *testing.T) {
+	overview, err := newCIReportOverview("sig-release-master-blocking", testgrid.JobData{
+		"a": {OverallStatus: testgrid.Passing},
+		"b": {OverallStatus: testgrid.Stale},
+		"c": {OverallStatus: testgrid.Stale},
+	})
+	require.NoError(t, err)
+	require.Equal(t, CIReportOverview{TestgridBoard: "sig-release-master-blocking", Passing: 1, Stale: 2}, overview)
+
+	_, err = newCIReportOverview("sig-release-master-blocking", testgrid.JobData{"a": {}})
+	require.Error(t, err)
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -35,8 +36,8 @@ import (
 
 var rootCmd = &cobra.Command{
 	Use:   "reporter",
-	Short: "Github and Testgrid report generator",
-	Long:  "CI-Signal reporter that generates github and testgrid reports.",
+	Short: "Github, Testgrid and Prow report generator",
+	Long:  "CI-Signal reporter that generates github, testgrid and prow reports.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		setGithubConfig(cmd, args)
 		// all available reporters are used by default that are used to generate the report
@@ -59,7 +60,14 @@ type Config struct {
 	ReleaseVersion string
 	ShortReport    bool
 	JSONOutput     bool
+	Format         string
 	Filepath       string
+	HistoryDir     string
+	NoHistory      bool
+
+	ProwJobs         []string
+	ProwBuilds       int
+	ProwArtifactsDir string
 }
 
 var cfg = &Config{}
@@ -67,8 +75,11 @@ var cfg = &Config{}
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&cfg.ReleaseVersion, "release-version", "v", "", "Specify a Kubernetes release versions like '1.22' which will populate the report additionally")
 	rootCmd.PersistentFlags().BoolVarP(&cfg.ShortReport, "short", "s", false, "A short report for mails and slack")
-	rootCmd.PersistentFlags().BoolVar(&cfg.JSONOutput, "json", false, "Report output in json format")
+	rootCmd.PersistentFlags().BoolVar(&cfg.JSONOutput, "json", false, "Report output in json format, same as '--format json'")
+	rootCmd.PersistentFlags().StringVar(&cfg.Format, "format", FormatTable, fmt.Sprintf("Report output format, one of: %s", strings.Join(Formats(), ", ")))
 	rootCmd.PersistentFlags().StringVarP(&cfg.Filepath, "file", "f", "", "Specify a filepath to write the report to a file")
+	rootCmd.PersistentFlags().StringVar(&cfg.HistoryDir, "history-dir", DefaultHistoryDir(), "Directory to store the report history in")
+	rootCmd.PersistentFlags().BoolVar(&cfg.NoHistory, "no-history", false, "Do not add the report to the history")
 }
 
 // RunReport used to execute.
@@ -88,6 +99,19 @@ func RunReport(ctx context.Context, cfg *Config, reporters *CIReporters) error {
 		return err
 	}
 
+	// persist data for later comparison
+	if !cfg.NoHistory {
+		snapshot := &Snapshot{
+			Timestamp:      time.Now().UTC(),
+			ReleaseVersion: cfg.ReleaseVersion,
+			ShortReport:    cfg.ShortReport,
+			Reports:        *reports,
+		}
+		if err := NewHistoryStore(cfg.HistoryDir).Save(snapshot); err != nil {
+			logrus.Warnf("Unable to store report history: %v", err)
+		}
+	}
+
 	// visualize data
 	if err := PrintReporterData(cfg, reports); err != nil {
 		return fmt.Errorf("printing report data: %w", err)
@@ -110,8 +134,18 @@ func (d *CIReportDataFields) Marshal() ([]byte, error) {
 
 // CIReportData format of the ci report data that is being generated.
 type CIReportData struct {
-	Info    CIReporterInfo    `json:"info"`
-	Records []*CIReportRecord `json:"records"`
+	Info     CIReporterInfo     `json:"info"`
+	Records  []*CIReportRecord  `json:"records"`
+	Overview []CIReportOverview `json:"overview,omitempty"`
+}
+
+// CIReportOverview counts the jobs of a testgrid board by status.
+type CIReportOverview struct {
+	TestgridBoard string `json:"testgrid_board"`
+	Passing       int    `json:"passing"`
+	Flaky         int    `json:"flaky"`
+	Failing       int    `json:"failing"`
+	Stale         int    `json:"stale"`
 }
 
 // CIReporterInfo meta information about a reporter implementation.
@@ -145,11 +179,17 @@ type CIReporter interface {
 	CollectReportData(context.Context, *Config) ([]*CIReportRecord, error)
 }
 
+// ciReportCollector is implemented by reporters which provide more than the
+// records, like an overview of the collected data.
+type ciReportCollector interface {
+	CollectReport(context.Context, *Config) (*CIReportData, error)
+}
+
 // CIReporters used to specify multiple CIReports, type gets extended by helper functions to collect and visualize report data.
 type CIReporters []CIReporter
 
 // AllImplementedReporters list of implemented reports that are used to generate ci-reports.
-var AllImplementedReporters = CIReporters{GithubReporter{}, TestgridReporter{}}
+var AllImplementedReporters = CIReporters{GithubReporter{}, TestgridReporter{}, ProwReporter{}}
 
 // SearchReporter used to filter a implemented reporter by name.
 func SearchReporter(ctx context.Context, reporterName string) (CIReporter, error) { //nolint:ireturn // returning interface is intentional
@@ -182,6 +222,18 @@ func (r *CIReporters) CollectReportDataFromReporters(ctx context.Context, cfg *C
 		reporter := reporters[i]
 		reporterHead := reporter.GetCIReporterHead()
 
+		if collector, ok := reporter.(ciReportCollector); ok {
+			report, err := collector.CollectReport(ctx, cfg)
+			if err != nil {
+				return nil, err
+			}
+
+			report.Info = reporterHead
+			collectedReports = append(collectedReports, *report)
+
+			continue
+		}
+
 		reportData, err := reporter.CollectReportData(ctx, cfg)
 		if err != nil {
 			return nil, err
@@ -196,35 +248,66 @@ func (r *CIReporters) CollectReportDataFromReporters(ctx context.Context, cfg *C
 	return &collectedReports, nil
 }
 
+// reportOutput returns the stream to write a report to, which is either the
+// configured file or standard out.
+func reportOutput(cfg *Config) (*os.File, error) {
+	if cfg.Filepath == "" {
+		return os.Stdout, nil
+	}
+
+	out, err := os.OpenFile(cfg.Filepath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return nil, fmt.Errorf("could not open or create a file at %s to write the ci signal report to: %w", cfg.Filepath, err)
+	}
+
+	return out, nil
+}
+
+func closeReportOutput(out *os.File) {
+	if err := out.Close(); err != nil {
+		logrus.Errorf("Failed to close output file: %v", err)
+	}
+}
+
 // PrintReporterData used to print report data
 //  1. Get a output stream to write the data to
 //  2. Write data to stream
 //     2.1. Write data in JSON format if set so
 //     2.2. Write data in table format
 func PrintReporterData(cfg *Config, reports *CIReportDataFields) error {
+	format, err := cfg.OutputFormat()
+	if err != nil {
+		return err
+	}
+
 	// Get a stream to write the data to (file stream / standard out stream)
-	var out *os.File
+	out, err := reportOutput(cfg)
+	if err != nil {
+		return err
+	}
 
-	if cfg.Filepath != "" {
-		// open output file
-		fileOut, err := os.OpenFile(cfg.Filepath, os.O_WRONLY|os.O_CREATE, 0o666)
-		if err != nil {
-			return fmt.Errorf("could not open or create a file at %s to write the ci signal report to: %w", cfg.Filepath, err)
+	defer closeReportOutput(out)
+
+	// Write data to stream
+	switch format {
+	case FormatMarkdown:
+		if _, err := io.WriteString(out, RenderMarkdown(reports)); err != nil {
+			return fmt.Errorf("could not write to output stream: %w", err)
 		}
 
-		out = fileOut
-	} else {
-		out = os.Stdout
-	}
+		return nil
+	case FormatHTML:
+		rendered, err := RenderHTML(reports)
+		if err != nil {
+			return fmt.Errorf("rendering HTML report: %w", err)
+		}
 
-	defer func() {
-		if err := out.Close(); err != nil {
-			logrus.Errorf("Failed to close output file: %v", err)
+		if _, err := io.WriteString(out, rendered); err != nil {
+			return fmt.Errorf("could not write to output stream: %w", err)
 		}
-	}()
 
-	// Write data to stream
-	if cfg.JSONOutput {
+		return nil
+	case FormatJSON:
 		// print report in json format
 		d, err := reports.Marshal()
 		if err != nil {
@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -56,18 +58,40 @@ func (r TestgridReporter) GetCIReporterHead() CIReporterInfo {
 
 // CollectReportData implementation from CIReporter.
 func (r TestgridReporter) CollectReportData(ctx context.Context, cfg *Config) ([]*CIReportRecord, error) {
+	report, err := r.CollectReport(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return report.Records, nil
+}
+
+// CollectReport collects the report records together with the job overview
+// of every testgrid dashboard.
+func (r TestgridReporter) CollectReport(ctx context.Context, cfg *Config) (*CIReportData, error) {
 	testgridReportData, err := GetTestgridReportData(ctx, *cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	records := []*CIReportRecord{}
+	report := &CIReportData{
+		Info:     r.GetCIReporterHead(),
+		Records:  []*CIReportRecord{},
+		Overview: []CIReportOverview{},
+	}
 
 	for dashboardName, jobData := range testgridReportData {
+		// The overview is informational and must not fail the report.
+		if overview, err := newCIReportOverview(dashboardName, jobData); err != nil {
+			logrus.Warnf("Skipping overview: %v", err)
+		} else {
+			report.Overview = append(report.Overview, overview)
+		}
+
 		for jobName := range jobData {
 			jobSummary := jobData[jobName]
 			if !cfg.ShortReport || jobSummary.OverallStatus != testgrid.Passing {
-				records = append(records, &CIReportRecord{
+				report.Records = append(report.Records, &CIReportRecord{
 					TestgridBoard:    string(dashboardName),
 					Title:            string(jobName),
 					URL:              jobSummary.GetJobURL(jobName),
@@ -80,7 +104,11 @@ func (r TestgridReporter) CollectReportData(ctx context.Context, cfg *Config) ([
 		}
 	}
 
-	return records, nil
+	slices.SortFunc(report.Overview, func(a, b CIReportOverview) int {
+		return strings.Compare(a.TestgridBoard, b.TestgridBoard)
+	})
+
+	return report, nil
 }
 
 // GetTestgridReportData used to request the raw report data from testgrid.
@@ -20,18 +20,45 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	"sigs.k8s.io/release-utils/helpers"
 
+	"k8s.io/release/pkg/announce"
 	"k8s.io/release/pkg/consts"
 	"k8s.io/release/pkg/mail"
 	"k8s.io/release/pkg/release"
 )
 
-const noBrowserFlag = "no-browser"
+const (
+	noBrowserFlag = "no-browser"
+	senderFlag    = "sender"
+
+	senderGmail = "gmail"
+	senderSMTP  = "smtp"
+
+	// smtpPasswordEnvKey is the environment variable containing the SMTP
+	// password.
+	smtpPasswordEnvKey = "SMTP_PASSWORD" //nolint:gosec // not a credential
+
+	channelFlag = "channel"
+
+	channelSlack     = "slack"
+	channelMatrix    = "matrix"
+	channelDiscourse = "discourse"
+	channelFeed      = "feed"
+
+	slackWebhookEnvKey    = "SLACK_WEBHOOK_URL"
+	matrixWebhookEnvKey   = "MATRIX_WEBHOOK_URL"
+	discourseAPIKeyEnvKey = "DISCOURSE_API_KEY" //nolint:gosec // not a credential
+
+	// emailResultName is the name of the email in the results of all
+	// announcement channels.
+	emailResultName = "Email"
+)
 
 // sendAnnounceCmd represents the subcommand for `krel announce send`.
 var sendAnnounceCmd = &cobra.Command{
@@ -55,15 +82,38 @@ Use --%s to print the authorization URL for manual copy/paste
 the redirect will fail to load. Copy the full URL from the browser's
 address bar and paste it back into the terminal.
 
+To send from automation or non-Google accounts, use --%s=%s together
+with the --smtp-* flags. The SMTP password is read from the %s
+environment variable.
+
 Setting a valid Kubernetes tag (--%s,-t) is always necessary.
 
+The announcement can additionally be posted to other channels using
+--%s (options: %s, %s, %s, %s). The Slack and Matrix incoming webhook
+URLs are read from the %s and %s environment variables, the
+Discourse API key from %s. Channels are only notified together with
+--nomock, the mock run prints the message of every channel instead. The
+email and the channels are announced independently of each other, the
+outcome is reported per channel.
+
 If --%s,-p is given, then krel announce will only print the email
 content without doing anything else.`,
 		mail.KubernetesAnnounceGoogleGroup,
 		mail.KubernetesDevGoogleGroup,
 		mail.KubernetesAnnounceTestGoogleGroup,
 		noBrowserFlag,
+		senderFlag,
+		senderSMTP,
+		smtpPasswordEnvKey,
 		tagFlag,
+		channelFlag,
+		channelSlack,
+		channelMatrix,
+		channelDiscourse,
+		channelFeed,
+		slackWebhookEnvKey,
+		matrixWebhookEnvKey,
+		discourseAPIKeyEnvKey,
 		printOnlyFlag,
 	),
 	SilenceUsage:  true,
@@ -74,11 +124,33 @@ content without doing anything else.`,
 }
 
 type sendAnnounceOptions struct {
-	noBrowser bool
+	noBrowser    bool
+	sender       string
+	smtpHost     string
+	smtpPort     int
+	smtpSecurity string
+	smtpAuth     string
+	smtpUsername string
+	smtpFrom     string
+	smtpFromName string
+
+	channels          []string
+	goVersion         string
+	discourseURL      string
+	discourseUsername string
+	discourseCategory int
+	feedFile          string
+	feedFormat        string
 }
 
 var sendAnnounceOpts = &sendAnnounceOptions{}
 
+// announceSender is an email sender which can target Google Groups.
+type announceSender interface {
+	mail.EmailSender
+	SetGoogleGroupRecipients(groups ...mail.GoogleGroup)
+}
+
 func init() {
 	sendAnnounceCmd.PersistentFlags().BoolVar(
 		&sendAnnounceOpts.noBrowser,
@@ -87,6 +159,114 @@ func init() {
 		"disable automatic browser opening for OAuth (manual URL copy/paste)",
 	)
 
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.sender,
+		senderFlag,
+		senderGmail,
+		fmt.Sprintf("email sender to use (options: %s, %s)", senderGmail, senderSMTP),
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.smtpHost,
+		"smtp-host",
+		"",
+		"hostname of the SMTP server",
+	)
+
+	sendAnnounceCmd.PersistentFlags().IntVar(
+		&sendAnnounceOpts.smtpPort,
+		"smtp-port",
+		0,
+		"port of the SMTP server (defaults to 587 for starttls, 465 for tls and 25 for none)",
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.smtpSecurity,
+		"smtp-security",
+		string(mail.SMTPSecurityStartTLS),
+		fmt.Sprintf("encryption of the SMTP connection (options: %v)", mail.SMTPSecurities()),
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.smtpAuth,
+		"smtp-auth",
+		string(mail.SMTPAuthPlain),
+		fmt.Sprintf("SMTP authentication mechanism (options: %v)", mail.SMTPAuths()),
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.smtpUsername,
+		"smtp-username",
+		"",
+		"username for the SMTP authentication",
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.smtpFrom,
+		"smtp-from",
+		"",
+		"sender email address, defaults to the SMTP username",
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.smtpFromName,
+		"smtp-from-name",
+		"Kubernetes Release Managers",
+		"sender display name",
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringSliceVar(
+		&sendAnnounceOpts.channels,
+		channelFlag,
+		[]string{},
+		fmt.Sprintf(
+			"additional channels to post the announcement to (options: %s, %s, %s, %s)",
+			channelSlack, channelMatrix, channelDiscourse, channelFeed,
+		),
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.goVersion,
+		"go-version",
+		"",
+		"Go version used to build the release, parsed from the announcement if not set",
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.discourseURL,
+		"discourse-url",
+		"https://discuss.kubernetes.io",
+		"base URL of the Discourse forum",
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.discourseUsername,
+		"discourse-username",
+		"",
+		"Discourse user creating the announcement topic",
+	)
+
+	sendAnnounceCmd.PersistentFlags().IntVar(
+		&sendAnnounceOpts.discourseCategory,
+		"discourse-category",
+		0,
+		"ID of the Discourse category for the announcement topic",
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.feedFile,
+		"feed-file",
+		"",
+		"path to the static feed file to add the announcement to",
+	)
+
+	sendAnnounceCmd.PersistentFlags().StringVar(
+		&sendAnnounceOpts.feedFormat,
+		"feed-format",
+		string(announce.FeedFormatRSS),
+		fmt.Sprintf("format of the feed file (options: %v)", announce.FeedFormats()),
+	)
+
 	announceCmd.AddCommand(sendAnnounceCmd)
 }
 
@@ -118,11 +298,14 @@ func runAnnounce(ctx context.Context, opts *sendAnnounceOptions, announceRootOpt
 		return nil
 	}
 
-	logrus.Info("Starting Gmail OAuth flow")
+	notifiers, err := opts.newNotifiers()
+	if err != nil {
+		return err
+	}
 
-	sender, err := mail.NewGmailSender(ctx, opts.noBrowser)
+	sender, err := opts.newSender(ctx)
 	if err != nil {
-		return fmt.Errorf("creating Gmail sender: %w", err)
+		return err
 	}
 
 	groups := []mail.GoogleGroup{mail.KubernetesAnnounceTestGoogleGroup}
@@ -137,8 +320,6 @@ func runAnnounce(ctx context.Context, opts *sendAnnounceOptions, announceRootOpt
 
 	sender.SetGoogleGroupRecipients(groups...)
 
-	logrus.Info("Sending mail")
-
 	subject := fmt.Sprintf("Kubernetes %s is live!", tag)
 
 	yes := true
@@ -150,21 +331,193 @@ func runAnnounce(ctx context.Context, opts *sendAnnounceOptions, announceRootOpt
 		}
 	}
 
+	results := []announce.NotificationResult{}
+
 	if yes {
-		if err := sender.Send(string(content), subject); err != nil {
-			return fmt.Errorf("unable to send mail: %w", err)
+		logrus.Info("Sending mail")
+
+		err := sender.Send(string(content), subject)
+		if err == nil {
+			logrus.Infof("Successfully sent announcement: %q", subject)
+
+			for _, group := range groups {
+				logrus.Infof("Mailing list: https://groups.google.com/g/%s", group)
+			}
 		}
 
-		logrus.Infof("Successfully sent announcement: %q", subject)
+		results = append(results, announce.NotificationResult{Channel: emailResultName, Error: err})
+	} else {
+		logrus.Info("Skipping email")
+	}
+
+	results = append(results, notifyChannels(ctx, opts, tag, string(content), notifiers, rootOpts.nomock)...)
 
-		for _, group := range groups {
-			logrus.Infof("Mailing list: https://groups.google.com/g/%s", group)
+	for _, r := range results {
+		if r.Success() {
+			logrus.Infof("Channel %s: announced", r.Channel)
+		} else {
+			logrus.Errorf("Channel %s: failed: %v", r.Channel, r.Error)
 		}
 	}
 
+	if err := announce.NotificationErrors(results); err != nil {
+		return fmt.Errorf("announcing release: %w", err)
+	}
+
+	return nil
+}
+
+// newNotifiers creates the notifiers for the selected channels.
+func (o *sendAnnounceOptions) newNotifiers() ([]announce.Notifier, error) {
+	notifiers := make([]announce.Notifier, 0, len(o.channels))
+
+	for _, channel := range o.channels {
+		switch channel {
+		case channelSlack:
+			url := os.Getenv(slackWebhookEnvKey)
+			if url == "" {
+				return nil, fmt.Errorf("%s is required for the %s channel", slackWebhookEnvKey, channel)
+			}
+
+			notifiers = append(notifiers, announce.NewSlackNotifier(url))
+		case channelMatrix:
+			url := os.Getenv(matrixWebhookEnvKey)
+			if url == "" {
+				return nil, fmt.Errorf("%s is required for the %s channel", matrixWebhookEnvKey, channel)
+			}
+
+			notifiers = append(notifiers, announce.NewMatrixNotifier(url))
+		case channelDiscourse:
+			notifier, err := announce.NewDiscourseNotifier(
+				o.discourseURL, os.Getenv(discourseAPIKeyEnvKey), o.discourseUsername, o.discourseCategory,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("creating Discourse notifier: %w", err)
+			}
+
+			notifiers = append(notifiers, notifier)
+		case channelFeed:
+			notifier, err := announce.NewFeedNotifier(o.feedFile, announce.FeedFormat(o.feedFormat))
+			if err != nil {
+				return nil, fmt.Errorf("creating feed notifier: %w", err)
+			}
+
+			notifiers = append(notifiers, notifier)
+		default:
+			return nil, fmt.Errorf("unsupported announcement channel %q", channel)
+		}
+	}
+
+	return notifiers, nil
+}
+
+// notifyChannels posts the announcement to the additional channels and
+// returns the outcome per channel. The mock run only logs the message each
+// channel would publish.
+func notifyChannels(
+	ctx context.Context, opts *sendAnnounceOptions, tag, content string,
+	notifiers []announce.Notifier, nomock bool,
+) []announce.NotificationResult {
+	if len(notifiers) == 0 {
+		return nil
+	}
+
+	an, err := newAnnouncement(opts, tag, content)
+	if err != nil {
+		return failedNotifications(notifiers, err)
+	}
+
+	if nomock {
+		results, err := an.Notify(ctx, notifiers...)
+		if err != nil {
+			return failedNotifications(notifiers, err)
+		}
+
+		return results
+	}
+
+	msg, err := an.Message()
+	if err != nil {
+		return failedNotifications(notifiers, fmt.Errorf("building announcement message: %w", err))
+	}
+
+	for _, n := range notifiers {
+		logrus.Infof("Skipping %s in mock mode, the announcement would be:\n%s", n.Name(), n.Render(msg))
+	}
+
 	return nil
 }
 
+// newAnnouncement creates the announcement of the tag for the channels. The
+// Go version is parsed from the email content if not set in the options.
+func newAnnouncement(opts *sendAnnounceOptions, tag, content string) (*announce.Announce, error) {
+	goVersion := opts.goVersion
+	if goVersion == "" {
+		goVersion = announce.ParseGoVersion(content)
+	}
+
+	semver, err := helpers.TagStringToSemver(tag)
+	if err != nil {
+		return nil, fmt.Errorf("parsing tag %s: %w", tag, err)
+	}
+
+	return announce.NewAnnounce(announce.NewOptions().
+		WithTag(tag).
+		WithGoVersion(goVersion).
+		WithChangelogPath(fmt.Sprintf("CHANGELOG/CHANGELOG-%d.%d.md", semver.Major, semver.Minor))), nil
+}
+
+// failedNotifications returns the error as result for all channels.
+func failedNotifications(notifiers []announce.Notifier, err error) []announce.NotificationResult {
+	results := make([]announce.NotificationResult, 0, len(notifiers))
+	for _, n := range notifiers {
+		results = append(results, announce.NotificationResult{Channel: n.Name(), Error: err})
+	}
+
+	return results
+}
+
+// newSender creates the email sender selected by the options.
+func (o *sendAnnounceOptions) newSender(ctx context.Context) (announceSender, error) {
+	switch o.sender {
+	case senderGmail:
+		logrus.Info("Starting Gmail OAuth flow")
+
+		sender, err := mail.NewGmailSender(ctx, o.noBrowser)
+		if err != nil {
+			return nil, fmt.Errorf("creating Gmail sender: %w", err)
+		}
+
+		return sender, nil
+	case senderSMTP:
+		from := o.smtpFrom
+		if from == "" {
+			from = o.smtpUsername
+		}
+
+		logrus.Infof("Using SMTP server %s", o.smtpHost)
+
+		sender, err := mail.NewSMTPSender(&mail.SMTPOptions{
+			Host:     o.smtpHost,
+			Port:     o.smtpPort,
+			Security: mail.SMTPSecurity(o.smtpSecurity),
+			Auth:     mail.SMTPAuth(o.smtpAuth),
+			Username: o.smtpUsername,
+			Password: os.Getenv(smtpPasswordEnvKey),
+			From:     mail.Recipient{Name: o.smtpFromName, Address: from},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating SMTP sender: %w", err)
+		}
+
+		return sender, nil
+	default:
+		return nil, fmt.Errorf(
+			"unsupported sender %q, must be %s or %s", o.sender, senderGmail, senderSMTP,
+		)
+	}
+}
+
 func (o *announceOptions) Validate() error {
 	if o.tag == "" {
 		return errors.New("need to specify a tag value")
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/announce"
+	"k8s.io/release/pkg/announce/announcefakes"
+)
+
+func TestNotifyChannels(t *testing.T) {
+	opts := &sendAnnounceOptions{goVersion: "1.22.5"}
+
+	newNotifiers := func() (slack, feed *announcefakes.FakeNotifier) {
+		slack = &announcefakes.FakeNotifier{}
+		slack.NameReturns("Slack")
+		slack.NotifyReturns(errors.New("webhook failed"))
+
+		feed = &announcefakes.FakeNotifier{}
+		feed.NameReturns("RSS feed")
+
+		return slack, feed
+	}
+
+	// The mock run renders the message of every channel without notifying
+	slack, feed := newNotifiers()
+	results := notifyChannels(t.Context(), opts, "v1.30.1", "", []announce.Notifier{slack, feed}, false)
+	require.Empty(t, results)
+
+	for _, n := range []*announcefakes.FakeNotifier{slack, feed} {
+		require.Equal(t, 1, n.RenderCallCount())
+		require.Equal(t, "v1.30.1", n.RenderArgsForCall(0).Tag)
+		require.Zero(t, n.NotifyCallCount())
+	}
+
+	// A failing channel does not prevent notifying the others
+	slack, feed = newNotifiers()
+	results = notifyChannels(t.Context(), opts, "v1.30.1", "", []announce.Notifier{slack, feed}, true)
+	require.Len(t, results, 2)
+	require.Equal(t, "Slack", results[0].Channel)
+	require.EqualError(t, results[0].Error, "webhook failed")
+	require.Equal(t, "RSS feed", results[1].Channel)
+	require.True(t, results[1].Success())
+
+	// An invalid announcement fails all channels
+	slack, feed = newNotifiers()
+	results = notifyChannels(t.Context(), opts, "invalid", "", []announce.Notifier{slack, feed}, true)
+	require.Len(t, results, 2)
+
+	for _, r := range results {
+		require.ErrorContains(t, r.Error, "parsing tag invalid")
+	}
+}
@@ -21,8 +21,12 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/blang/semver/v4"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
@@ -78,9 +82,62 @@ delete all content from the file.
 	Args: argFunc,
 }
 
+var cveImportCmd = &cobra.Command{
+	Use:   "import <file|id>",
+	Short: "Create a CVE map from an OSV or CVE JSON 5.0 record",
+	Long: `The import command converts an OSV or CVE JSON 5.0 record into a CVE map.
+The record is read from a local file or downloaded by its ID: CVE IDs are
+fetched from the CVE services, all other IDs (like GHSA-xxxx-xxxx-xxxx) from
+osv.dev.
+
+The CVSS score and rating are computed from the vector of the record, linked
+pull requests and the tracking issue are taken from the referenced
+kubernetes/kubernetes GitHub URLs.
+
+The map is printed to standard output or written to the file specified by
+--output. Use --publish to upload it to the release bucket.
+`,
+	Example: `krel cve import CVE-2023-5528
+krel cve import GHSA-hq6q-c2x6-hmch --output cve-map.yaml
+krel cve import ./CVE-2023-5528.json --publish`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Args:          cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return importCVE(cveOpts, args[0])
+	},
+}
+
+var cveExportCmd = &cobra.Command{
+	Use:   "export [CVE-ID...]",
+	Short: "Export CVE maps as OSV and CSAF security advisories",
+	Long: `The export command writes an OSV JSON and a CSAF 2.0 advisory for every
+CVE. The CVE maps are read from the release bucket by their ID or from the
+local map files specified by --file.
+
+The affected and fixed version ranges are derived from the release tags whose
+release notes contain one of the pull requests linked in the CVE map. The
+release notes are read from the release notes index, which maps every tag to
+its release notes JSON.
+`,
+	Example: `krel cve export CVE-2023-5528 --min-version 1.27.0
+krel cve export -f cve-map.yaml --format osv --output-dir advisories`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return exportCVEs(cveOpts, args, time.Now())
+	},
+}
+
 type cveOptions struct {
-	CVE      string   // CVE identifier to work on
-	mapFiles []string // List of mapfiles
+	CVE               string   // CVE identifier to work on
+	mapFiles          []string // List of mapfiles
+	output            string   // Path to write the imported map to
+	publish           bool     // Upload the imported map to the bucket
+	formats           []string // Advisory formats to export
+	outputDir         string   // Directory to write the advisories to
+	releaseNotesIndex string   // Location of the release notes index
+	minVersion        string   // Lowest release to consider for the version ranges
 }
 
 var argFunc = func(cmd *cobra.Command, args []string) error {
@@ -107,7 +164,50 @@ func init() {
 		"update vulnerability data from a local map file",
 	)
 
-	cveCmd.AddCommand(cveEditCmd, cveDeleteCmd)
+	cveImportCmd.PersistentFlags().StringVarP(
+		&cveOpts.output,
+		"output",
+		"o",
+		"",
+		"write the imported CVE map to a file instead of standard output",
+	)
+
+	cveImportCmd.PersistentFlags().BoolVar(
+		&cveOpts.publish,
+		"publish",
+		false,
+		"upload the imported CVE map to the release bucket",
+	)
+
+	cveExportCmd.PersistentFlags().StringSliceVar(
+		&cveOpts.formats,
+		"format",
+		[]string{cve.FormatOSV, cve.FormatCSAF},
+		fmt.Sprintf("advisory formats to export, one or more of: %s, %s", cve.FormatOSV, cve.FormatCSAF),
+	)
+
+	cveExportCmd.PersistentFlags().StringVar(
+		&cveOpts.outputDir,
+		"output-dir",
+		".",
+		"directory to write the advisories to",
+	)
+
+	cveExportCmd.PersistentFlags().StringVar(
+		&cveOpts.releaseNotesIndex,
+		"release-notes-index",
+		cve.ReleaseNotesIndexURL,
+		"path or URL of the release notes index mapping the release tags to their release notes JSON",
+	)
+
+	cveExportCmd.PersistentFlags().StringVar(
+		&cveOpts.minVersion,
+		"min-version",
+		"",
+		"ignore releases before this version when deriving the version ranges, like 1.27.0",
+	)
+
+	cveCmd.AddCommand(cveEditCmd, cveDeleteCmd, cveImportCmd, cveExportCmd)
 	rootCmd.AddCommand(cveCmd)
 }
 
@@ -223,3 +323,173 @@ func editExistingCVE(opts *cveOptions) (err error) {
 	// If the file was changed, re-write it:
 	return client.Write(opts.CVE, tempFilePath)
 }
+
+// importCVE converts a vulnerability record into a CVE map.
+func importCVE(opts *cveOptions, source string) error {
+	client := cve.NewClient()
+
+	data, err := client.Import(source)
+	if err != nil {
+		return fmt.Errorf("importing CVE: %w", err)
+	}
+
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validating imported CVE data: %w", err)
+	}
+
+	yamlCode, err := cve.MarshalMap(data)
+	if err != nil {
+		return err
+	}
+
+	mapPath := opts.output
+
+	switch {
+	case mapPath != "":
+		//nolint:gosec // CVE maps are public
+		if err := os.WriteFile(mapPath, yamlCode, 0o644); err != nil {
+			return fmt.Errorf("writing CVE map: %w", err)
+		}
+
+		logrus.Infof("Wrote %s map to %s", data.ID, mapPath)
+	case opts.publish:
+		file, err := os.CreateTemp("", "cve-data-*.yaml")
+		if err != nil {
+			return fmt.Errorf("creating CVE map file: %w", err)
+		}
+		defer os.Remove(file.Name())
+
+		if _, err := file.Write(yamlCode); err != nil {
+			file.Close()
+
+			return fmt.Errorf("writing CVE map: %w", err)
+		}
+
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("closing CVE map: %w", err)
+		}
+
+		mapPath = file.Name()
+	default:
+		fmt.Print(string(yamlCode))
+	}
+
+	if !opts.publish {
+		return nil
+	}
+
+	logrus.Infof("Publishing %s entry", data.ID)
+
+	return client.Write(data.ID, mapPath)
+}
+
+// exportCVEs writes the security advisories of the CVEs.
+func exportCVEs(opts *cveOptions, ids []string, date time.Time) error {
+	for _, format := range opts.formats {
+		if format != cve.FormatOSV && format != cve.FormatCSAF {
+			return fmt.Errorf("unsupported advisory format %q", format)
+		}
+	}
+
+	var minVersion *semver.Version
+
+	if opts.minVersion != "" {
+		v, err := semver.ParseTolerant(opts.minVersion)
+		if err != nil {
+			return fmt.Errorf("parsing minimum version: %w", err)
+		}
+
+		minVersion = &v
+	}
+
+	mapFiles := slices.Clone(opts.mapFiles)
+	client := cve.NewClient()
+
+	tempFiles := []string{}
+	defer func() {
+		for _, tempFile := range tempFiles {
+			os.Remove(tempFile)
+		}
+	}()
+
+	for _, id := range ids {
+		id = strings.ToUpper(id)
+		if err := client.CheckID(id); err != nil {
+			return fmt.Errorf("invalid CVE ID %s. Format must match %s", id, cve.CVEIDRegExp)
+		}
+
+		file, err := client.CopyToTemp(id)
+		if err != nil {
+			return fmt.Errorf("copying CVE entry %s: %w", id, err)
+		}
+
+		file.Close()
+		tempFiles = append(tempFiles, file.Name())
+	}
+
+	mapFiles = append(mapFiles, tempFiles...)
+
+	if len(mapFiles) == 0 {
+		return errors.New("no CVE specified, pass the CVE IDs or map files")
+	}
+
+	cves := []*cve.CVE{}
+
+	for _, mapFile := range mapFiles {
+		fileCVEs, err := cve.ReadMapFile(mapFile)
+		if err != nil {
+			return fmt.Errorf("reading map file %s: %w", mapFile, err)
+		}
+
+		for _, data := range fileCVEs {
+			if err := data.Validate(); err != nil {
+				return fmt.Errorf("validating %s in map file %s: %w", data.ID, mapFile, err)
+			}
+		}
+
+		cves = append(cves, fileCVEs...)
+	}
+
+	logrus.Infof("Reading release notes from %s", opts.releaseNotesIndex)
+
+	releases, err := cve.LoadReleaseNotes(opts.releaseNotesIndex, minVersion)
+	if err != nil {
+		return fmt.Errorf("loading release notes: %w", err)
+	}
+
+	if err := os.MkdirAll(opts.outputDir, 0o755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	for _, data := range cves {
+		fixed := releases.FixedVersions(data)
+		if len(fixed) == 0 {
+			logrus.Warnf("No release notes contain the linked PRs of %s, exporting without affected versions", data.ID)
+		}
+
+		ranges := cve.AffectedRanges(fixed)
+
+		for _, format := range opts.formats {
+			export := cve.ExportOSV
+			if format == cve.FormatCSAF {
+				export = cve.ExportCSAF
+			}
+
+			advisory, err := export(data, ranges, date)
+			if err != nil {
+				return fmt.Errorf("exporting %s as %s: %w", data.ID, format, err)
+			}
+
+			path := filepath.Join(opts.outputDir, fmt.Sprintf("%s.%s.json", data.ID, format))
+
+			//nolint:gosec // advisories are public
+			if err := os.WriteFile(path, advisory, 0o644); err != nil {
+				return fmt.Errorf("writing advisory: %w", err)
+			}
+
+			logrus.Infof("Wrote %s advisory for %s to %s", format, data.ID, path)
+		}
+	}
+
+	return nil
+}
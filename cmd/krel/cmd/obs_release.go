@@ -44,11 +44,11 @@ submit a Google Cloud Build (GCB) job which does:
 1. Check Prerequisites: Verify that a valid %s environment variable is set.
    A basic hardware check will ensure that enough disk space is available, too.
 
-2. Initialize OBS root and configuration: creates the directory structure
-   needed for working with OBS and a configuration file which contains
-   credentials to authenticate with OBS.
+2. Initialize OBS root and API client: creates the directory structure
+   needed for working with OBS and the client which authenticates with the
+   OBS API.
 
-3. Run "osc release" for each package: this triggers a new OBS job that's
+3. Release each package via the OBS API: this triggers a new OBS job that's
    going to publish successful builds to the configured maintenance project.
    Configuration to which project the packages should be published is done via
    OBS UI.
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"k8s.io/release/pkg/obs/repoindex"
+)
+
+var repoIndexOpts = repoindex.DefaultOptions()
+
+// obsRepoIndexCmd represents the subcommand for `krel obs repo-index`.
+var obsRepoIndexCmd = &cobra.Command{
+	Use:   "repo-index",
+	Short: "generate apt and yum repository metadata for a directory of packages",
+	Long: fmt.Sprintf(`krel obs repo-index
+
+Generates the repository metadata for all .deb and .rpm packages found in a
+directory, so that the directory can be served from any static host:
+
+- apt: Packages, Packages.gz and Release of a flat repository, which is added
+  via "deb [signed-by=<key>] <url> /".
+- yum: repodata/repomd.xml and repodata/primary.xml.gz, which is added via
+  "baseurl=<url>".
+
+If a signing key is given, the metadata gets signed: InRelease and Release.gpg
+for apt and repodata/repomd.xml.asc for yum. The public key is written to
+Release.key and repodata/repomd.xml.key. The signing key has to be an armored
+OpenPGP private key, as exported by "gpg --armor --export-secret-keys". The
+passphrase of an encrypted key is read from the %s
+environment variable.
+`, repoindex.SigningKeyPassphraseKey),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runOBSRepoIndex(repoIndexOpts)
+	},
+}
+
+func init() {
+	obsRepoIndexCmd.PersistentFlags().StringVar(
+		&repoIndexOpts.Path,
+		"dir",
+		repoIndexOpts.Path,
+		"directory containing the packages, where the repository metadata is written to",
+	)
+
+	obsRepoIndexCmd.PersistentFlags().StringVar(
+		&repoIndexOpts.SigningKeyPath,
+		"signing-key",
+		repoIndexOpts.SigningKeyPath,
+		"armored OpenPGP private key to sign the repository metadata",
+	)
+
+	obsRepoIndexCmd.PersistentFlags().StringVar(
+		&repoIndexOpts.Origin,
+		"origin",
+		repoIndexOpts.Origin,
+		"origin of the apt repository",
+	)
+
+	obsRepoIndexCmd.PersistentFlags().StringVar(
+		&repoIndexOpts.Label,
+		"label",
+		repoIndexOpts.Label,
+		"label of the apt repository",
+	)
+
+	obsRepoIndexCmd.PersistentFlags().StringVar(
+		&repoIndexOpts.Description,
+		"description",
+		repoIndexOpts.Description,
+		"description of the apt repository",
+	)
+
+	obsCmd.AddCommand(obsRepoIndexCmd)
+}
+
+func runOBSRepoIndex(opts *repoindex.Options) error {
+	logrus.Debugf("Using options: %s", opts.String())
+
+	opts.SigningKeyPassphrase = os.Getenv(repoindex.SigningKeyPassphraseKey)
+
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("running krel obs repo-index: %w", err)
+	}
+
+	if err := repoindex.New(opts).Run(); err != nil {
+		return fmt.Errorf("running krel obs repo-index: %w", err)
+	}
+
+	return nil
+}
@@ -29,8 +29,16 @@ var specsOpts = specs.DefaultOptions()
 
 // obsSpecsCmd represents the subcommand for `krel obs specs`.
 var obsSpecsCmd = &cobra.Command{
-	Use:           "specs",
-	Short:         "generate specs and artifacts archive",
+	Use:   "specs",
+	Short: "generate specs and artifacts archive",
+	Long: `krel obs specs
+
+Generates the RPM spec and the artifacts archive of a package from the
+templates. If the package metadata selects the "deb" format, the Debian source
+package is generated as well: the .dsc file and the debian directory files,
+which are prefixed with "debian." as expected by the OBS debtransform.
+Otherwise the Debian packages are built from the spec file via debbuild.
+`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
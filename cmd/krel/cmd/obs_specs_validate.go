@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"k8s.io/release/pkg/obs/specs"
+)
+
+// obsSpecsValidateCmd represents the subcommand for `krel obs specs validate`.
+var obsSpecsValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "validate the package metadata of the templates",
+	Long: `krel obs specs validate
+
+Validates the metadata.yaml file of the template directory without building
+any package:
+
+- The version constraints of every package are valid, do not overlap and cover
+  all release versions from the lowest constrained version onwards.
+- The source URL templates only use known placeholders and render an absolute
+  URL, which depends on the package version and architecture.
+- Dependencies refer to defined packages, their version constraints match at
+  least one version covered by the metadata of the dependency and they do not
+  form cycles.
+`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runValidateOBSSpecs(specsOpts)
+	},
+}
+
+func init() {
+	obsSpecsCmd.AddCommand(obsSpecsValidateCmd)
+}
+
+func runValidateOBSSpecs(opts *specs.Options) error {
+	errs, err := specs.New(opts).ValidateMetadata(opts.SpecTemplatePath)
+	if err != nil {
+		return fmt.Errorf("running krel obs specs validate: %w", err)
+	}
+
+	for _, err := range errs {
+		logrus.Error(err)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("running krel obs specs validate: found %d issues in package metadata", len(errs))
+	}
+
+	logrus.Info("Package metadata is valid")
+
+	return nil
+}
@@ -46,9 +46,9 @@ submit a Google Cloud Build (GCB) job which does:
    also checks for the existence of required spec files. A basic hardware check
    will ensure that enough disk space is available, too.
 
-2. Initialize OBS root and configuration: creates the directory structure
-   needed for working with OBS and a configuration file which contains
-   credentials to authenticate with OBS.
+2. Initialize OBS root and API client: creates the directory structure
+   needed for working with OBS and the client which authenticates with the
+   OBS API. The API URL can be changed via the %s environment variable.
 
 3. Generate specs and artifacts archive: given specs templates are executed to
    fill information such as version and dependencies. Binaries needed to build
@@ -56,7 +56,7 @@ submit a Google Cloud Build (GCB) job which does:
 
 4. Push artifacts to OBS: generated specs and artifacts archive are pushed to
    the given OBS project/package.
-`, obs.OBSPasswordKey),
+`, obs.OBSPasswordKey, obs.OBSAPIURLKey),
 	SilenceUsage:  true,
 	SilenceErrors: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -75,6 +75,9 @@ const (
 	obsProjectFlag          = "project"
 	obsSourceFlag           = "source"
 	obsWaitFlag             = "wait"
+	obsWaitIntervalFlag     = "wait-interval"
+	obsWaitTimeoutFlag      = "wait-timeout"
+	obsWaitSummaryFlag      = "wait-summary"
 )
 
 func init() {
@@ -188,6 +191,31 @@ func init() {
 			"Wait for the OBS build results to succeed",
 		)
 
+	obsStageCmd.PersistentFlags().
+		DurationVar(
+			&obsStageOptions.WaitInterval,
+			obsWaitIntervalFlag,
+			obsStageOptions.WaitInterval,
+			"Interval between two OBS build results requests when waiting",
+		)
+
+	obsStageCmd.PersistentFlags().
+		DurationVar(
+			&obsStageOptions.WaitTimeout,
+			obsWaitTimeoutFlag,
+			obsStageOptions.WaitTimeout,
+			"Maximum duration to wait for the OBS build results, no limit if zero",
+		)
+
+	obsStageCmd.PersistentFlags().
+		StringVar(
+			&obsStageOptions.WaitSummaryPath,
+			obsWaitSummaryFlag,
+			"",
+			"Path to write a JSON summary of the OBS build results to after waiting. "+
+				"A submitted job writes it into its workspace and archives it in the release bucket",
+		)
+
 	for _, flag := range []string{buildVersionFlag, submitJobFlag} {
 		if err := obsStageCmd.PersistentFlags().MarkHidden(flag); err != nil {
 			logrus.Fatal(err)
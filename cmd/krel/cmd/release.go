@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -29,6 +30,8 @@ import (
 	"k8s.io/release/pkg/release"
 )
 
+const skipImageVerificationFlag = "skip-image-signature-verification"
+
 // releaseCmd represents the subcommand for `krel release`.
 var releaseCmd = &cobra.Command{
 	Use:   releaseCmdUse,
@@ -64,6 +67,14 @@ Build (GCB) job, which does:
 
 8. Archive: Copies the release process logs to a bucket and sets private
    permissions on it.
+
+The signatures of the container images are verified against the allowed
+certificate identities and OIDC issuers. All failures are reported together.
+Use --skip-image-signature-verification to explicitly disable the check.
+
+Using --plan does not modify anything, but prints a manifest of the git
+objects, bucket objects, version markers and announcements the run would
+produce.
 `, github.TokenEnvKey),
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -121,6 +132,57 @@ func init() {
 			"Run the Google Cloud Build job synchronously",
 		)
 
+	releaseCmd.PersistentFlags().
+		StringVar(
+			&releaseOptions.BucketOverride,
+			bucketFlag,
+			"",
+			"Override the default bucket, for example with a local directory "+
+				"(file:///path) or S3 compatible bucket (s3://bucket). Requires --submit=false",
+		)
+
+	releaseCmd.PersistentFlags().
+		StringVar(
+			&releaseOptions.ImageVerificationPolicy.CertIdentity,
+			certIdentityFlag,
+			releaseOptions.ImageVerificationPolicy.CertIdentity,
+			"The identity expected in the Fulcio certificate of the container image signatures. Requires --submit=false",
+		)
+
+	releaseCmd.PersistentFlags().
+		StringVar(
+			&releaseOptions.ImageVerificationPolicy.CertIdentityRegexp,
+			certIdentityRegexpFlag,
+			releaseOptions.ImageVerificationPolicy.CertIdentityRegexp,
+			"A regular expression alternative to --"+certIdentityFlag+". Requires --submit=false",
+		)
+
+	releaseCmd.PersistentFlags().
+		StringVar(
+			&releaseOptions.ImageVerificationPolicy.CertOidcIssuer,
+			certOidcIssuerFlag,
+			releaseOptions.ImageVerificationPolicy.CertOidcIssuer,
+			"The OIDC issuer expected in the Fulcio certificate of the container image signatures. Requires --submit=false",
+		)
+
+	releaseCmd.PersistentFlags().
+		StringVar(
+			&releaseOptions.ImageVerificationPolicy.CertOidcIssuerRegexp,
+			certOidcIssuerRegexpFlag,
+			releaseOptions.ImageVerificationPolicy.CertOidcIssuerRegexp,
+			"A regular expression alternative to --"+certOidcIssuerFlag+". Requires --submit=false",
+		)
+
+	releaseCmd.PersistentFlags().
+		BoolVar(
+			&releaseOptions.ImageVerificationPolicy.Skip,
+			skipImageVerificationFlag,
+			false,
+			"Do not verify the container image signatures. Requires --submit=false",
+		)
+
+	addPlanFlags(releaseCmd)
+
 	if err := releaseCmd.PersistentFlags().MarkHidden(submitJobFlag); err != nil {
 		logrus.Fatal(err)
 	}
@@ -134,9 +196,31 @@ func init() {
 
 func runRelease(options *anago.ReleaseOptions) error {
 	options.NoMock = rootOpts.nomock
+
+	if planRun {
+		if err := validatePlanFormat(); err != nil {
+			return err
+		}
+
+		rel, plan := anago.NewReleasePlan(options)
+		if err := rel.Run(); err != nil {
+			return fmt.Errorf("plan release: %w", err)
+		}
+
+		return writePlan(plan)
+	}
+
 	rel := anago.NewRelease(options)
 
 	if submitJob {
+		if options.BucketOverride != "" {
+			return fmt.Errorf("--%s is not supported when submitting a job", bucketFlag)
+		}
+
+		if *options.ImageVerificationPolicy != *release.DefaultImageVerificationPolicy() {
+			return errors.New("changing the image verification policy is not supported when submitting a job")
+		}
+
 		// Perform a local check of the specified options
 		// before launching a Cloud Build job:
 		if err := options.Validate(&anago.State{}); err != nil {
@@ -197,7 +197,9 @@ func init() {
 		"maps-from",
 		"m",
 		[]string{},
-		"specify a location to recursively look for release notes *.y[a]ml file mappings",
+		"specify a location to recursively look for release notes *.y[a]ml file mappings. "+
+			"Bucket (gs://, s3://), web (https://) and GitHub (github://org/repo/path[@ref]) "+
+			"locations are read lazily per PR from pr-<number>-map.yaml files",
 	)
 
 	releaseNotesCmd.PersistentFlags().BoolVar(
@@ -17,7 +17,9 @@ limitations under the License.
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -58,6 +60,15 @@ Build (GCB) job which does:
    into the local working repository.
 
 6. Stage: Copies the build artifacts to a Google Cloud Bucket.
+
+The progress is recorded in a checkpoint file after each successful step. A
+failed run can be continued locally by using --resume, which skips all
+completed steps if the build version, release versions and workspace commit
+still match the checkpoint.
+
+Using --plan does not modify anything, but prints a manifest of the git tags
+and branches, bucket objects, container images and announcements the run would
+produce. The manifest can be reviewed before running in --nomock mode.
 `, github.TokenEnvKey, release.BuildDir),
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -70,12 +81,23 @@ var (
 	stageOptions = anago.DefaultStageOptions()
 	submitJob    = true
 	stream       = false
+	resumeFrom   = ""
+	planRun      = false
+	planFormat   = anago.PlanFormatYAML
+	planFile     = ""
 )
 
 const (
 	buildVersionFlag = "build-version"
 	submitJobFlag    = "submit"
 	streamFlag       = "stream"
+	checkpointFlag   = "checkpoint"
+	resumeFlag       = "resume"
+	bucketFlag       = "bucket"
+	planFlag         = "plan"
+	planFormatFlag   = "plan-format"
+	planFileFlag     = "plan-file"
+	mapProvidersFlag = "map-providers"
 )
 
 func init() {
@@ -125,6 +147,44 @@ func init() {
 			"Run the Google Cloud Build job synchronously",
 		)
 
+	stageCmd.PersistentFlags().
+		StringVar(
+			&stageOptions.BucketOverride,
+			bucketFlag,
+			"",
+			"Override the default bucket, for example with a local directory "+
+				"(file:///path) or S3 compatible bucket (s3://bucket). Requires --submit=false",
+		)
+
+	stageCmd.PersistentFlags().
+		StringSliceVar(
+			&stageOptions.MapProviders,
+			mapProvidersFlag,
+			[]string{},
+			"Additional release notes map locations used for the changelog, like a "+
+				"bucket (gs://, s3://), web (https://) or GitHub (github://org/repo/path[@ref]) "+
+				"location. Requires --submit=false",
+		)
+
+	stageCmd.PersistentFlags().
+		StringVar(
+			&stageOptions.CheckpointFile,
+			checkpointFlag,
+			stageOptions.CheckpointFile,
+			"Path to the file recording the progress of the stage run",
+		)
+
+	stageCmd.PersistentFlags().
+		StringVar(
+			&resumeFrom,
+			resumeFlag,
+			"",
+			"Resume a failed stage run from the provided checkpoint file "+
+				"by skipping all completed steps (implies --submit=false)",
+		)
+
+	addPlanFlags(stageCmd)
+
 	for _, flag := range []string{buildVersionFlag, submitJobFlag} {
 		if err := stageCmd.PersistentFlags().MarkHidden(flag); err != nil {
 			logrus.Fatal(err)
@@ -136,9 +196,55 @@ func init() {
 
 func runStage(options *anago.StageOptions) error {
 	options.NoMock = rootOpts.nomock
+
+	// The checkpoint and workspace only exist locally, which means that
+	// resuming is not possible within a new Google Cloud Build job.
+	if resumeFrom != "" {
+		options.CheckpointFile = resumeFrom
+		options.Resume = true
+		submitJob = false
+	}
+
+	if planRun {
+		if err := validatePlanFormat(); err != nil {
+			return err
+		}
+
+		if options.Resume {
+			return fmt.Errorf("--%s cannot be used together with --%s", planFlag, resumeFlag)
+		}
+
+		// The build version is usually discovered when submitting the job.
+		if options.BuildVersion == "" {
+			buildVersion, err := release.NewVersion().GetKubeVersionForBranch(
+				release.VersionTypeCILatest, options.ReleaseBranch,
+			)
+			if err != nil {
+				return fmt.Errorf("get build version: %w", err)
+			}
+
+			options.BuildVersion = buildVersion
+		}
+
+		stage, plan := anago.NewStagePlan(options)
+		if err := stage.Run(); err != nil {
+			return fmt.Errorf("plan stage: %w", err)
+		}
+
+		return writePlan(plan)
+	}
+
 	stage := anago.NewStage(options)
 
 	if submitJob {
+		if options.BucketOverride != "" {
+			return fmt.Errorf("--%s is not supported when submitting a job", bucketFlag)
+		}
+
+		if len(options.MapProviders) > 0 {
+			return fmt.Errorf("--%s is not supported when submitting a job", mapProvidersFlag)
+		}
+
 		// Perform a local check of the specified options before launching a
 		// Cloud Build job:
 		if err := options.Validate(&anago.State{}); err != nil {
@@ -150,3 +256,67 @@ func runStage(options *anago.StageOptions) error {
 
 	return stage.Run()
 }
+
+// addPlanFlags adds the flags for recording a plan instead of running.
+func addPlanFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().
+		BoolVar(
+			&planRun,
+			planFlag,
+			false,
+			"Do not modify anything, but output a manifest of the planned "+
+				"changes (implies --submit=false)",
+		)
+
+	cmd.PersistentFlags().
+		StringVar(
+			&planFormat,
+			planFormatFlag,
+			anago.PlanFormatYAML,
+			fmt.Sprintf(
+				"The format of the plan, must be one of: '%s', '%s'",
+				anago.PlanFormatYAML, anago.PlanFormatJSON,
+			),
+		)
+
+	cmd.PersistentFlags().
+		StringVar(
+			&planFile,
+			planFileFlag,
+			"",
+			"Write the plan into the file instead of stdout",
+		)
+}
+
+// validatePlanFormat verifies the plan format before doing any work.
+func validatePlanFormat() error {
+	if planFormat != anago.PlanFormatYAML && planFormat != anago.PlanFormatJSON {
+		return fmt.Errorf("unsupported --%s: %s", planFormatFlag, planFormat)
+	}
+
+	return nil
+}
+
+// writePlan outputs the recorded plan to stdout or the plan file.
+func writePlan(plan *anago.Plan) (err error) {
+	if planFile == "" {
+		return plan.Write(os.Stdout, planFormat)
+	}
+
+	f, err := os.Create(planFile)
+	if err != nil {
+		return fmt.Errorf("create plan file: %w", err)
+	}
+
+	defer func() {
+		err = errors.Join(err, f.Close())
+	}()
+
+	if err := plan.Write(f, planFormat); err != nil {
+		return err
+	}
+
+	logrus.Infof("Wrote plan to %s", planFile)
+
+	return nil
+}
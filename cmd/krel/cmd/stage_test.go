@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStageMapProviders(t *testing.T) {
+	t.Cleanup(func() {
+		stageOptions.MapProviders = []string{}
+		submitJob = true
+	})
+
+	require.NoError(t, stageCmd.PersistentFlags().Parse([]string{
+		"--map-providers=gs://bucket/maps,https://example.com/maps",
+		"--map-providers=github://kubernetes/sig-release/maps@master",
+	}))
+
+	require.Equal(t, []string{
+		"gs://bucket/maps",
+		"https://example.com/maps",
+		"github://kubernetes/sig-release/maps@master",
+	}, stageOptions.MapProviders)
+
+	// The map providers are only available for local runs
+	submitJob = true
+	err := runStage(stageOptions)
+	require.EqualError(t, err, "--map-providers is not supported when submitting a job")
+}
@@ -88,6 +88,7 @@ type githubPageCmdLineOptions struct {
 	noupdate         bool
 	draft            bool
 	sbom             bool
+	sbomCycloneDX    bool
 	sbomFormat       string
 	name             string
 	repo             string
@@ -153,6 +154,12 @@ func init() {
 		true,
 		"Generate an SPDX bill of materials and attach it to the release",
 	)
+	githubPageCmd.PersistentFlags().BoolVar(
+		&ghPageOpts.sbomCycloneDX,
+		"sbom-cyclonedx",
+		true,
+		"Additionally convert the SBOM to CycloneDX "+sbom.CycloneDXSpecVersion+" and attach it to the release",
+	)
 	githubPageCmd.PersistentFlags().StringVar(
 		&ghPageOpts.sbomFormat,
 		"sbom-format",
@@ -286,17 +293,24 @@ func runGithubPage(opts *githubPageCmdLineOptions) (err error) {
 		return fmt.Errorf("getting assets: %w", err)
 	}
 
-	sbomStr := ""
+	sbomStr, cycloneDXStr := "", ""
 	if opts.sbom {
 		// Generate the assets file
-		sbomStr, err = sbom.NewSBOM(&sbom.Options{
+		generator := sbom.NewSBOM(&sbom.Options{
 			ReleaseName:   opts.name,
 			Repo:          opts.repo,
 			RepoDirectory: opts.repoPath,
 			Assets:        assets,
 			Tag:           commandLineOpts.tag,
 			Format:        sbom.SBOMFormat(opts.sbomFormat),
-		}).Generate()
+		})
+
+		if opts.sbomCycloneDX {
+			sbomStr, cycloneDXStr, err = generator.GenerateWithCycloneDX()
+		} else {
+			sbomStr, err = generator.Generate()
+		}
+
 		if err != nil {
 			return fmt.Errorf("generating sbom: %w", err)
 		}
@@ -306,6 +320,13 @@ func runGithubPage(opts *githubPageCmdLineOptions) (err error) {
 		if commandLineOpts.nomock {
 			defer os.Remove(sbomStr)
 		}
+
+		if cycloneDXStr != "" {
+			opts.assets = append(opts.assets, cycloneDXStr+":CycloneDX Software Bill of Materials (SBOM)")
+			if commandLineOpts.nomock {
+				defer os.Remove(cycloneDXStr)
+			}
+		}
 	}
 
 	var newAssets []string //nolint:gocritic
@@ -317,6 +338,10 @@ func runGithubPage(opts *githubPageCmdLineOptions) (err error) {
 		newAssets = append(newAssets, sbomStr)
 	}
 
+	if cycloneDXStr != "" {
+		newAssets = append(newAssets, cycloneDXStr)
+	}
+
 	// Build the release page options
 	ghOpts := github.Options{
 		AssetFiles:            newAssets,
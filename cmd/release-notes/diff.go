@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/release-utils/env"
+
+	"k8s.io/release/pkg/notes"
+)
+
+const (
+	diffFormatText = "text"
+	diffFormatJSON = "json"
+)
+
+type diffOptions struct {
+	format string
+}
+
+// Validate checks if the diff options are valid.
+func (o *diffOptions) Validate() error {
+	if o.format != diffFormatText && o.format != diffFormatJSON {
+		return fmt.Errorf(
+			"invalid diff format %q, must be %s or %s",
+			o.format, diffFormatText, diffFormatJSON,
+		)
+	}
+
+	return nil
+}
+
+func addDiff(parent *cobra.Command) {
+	diffOpts := &diffOptions{}
+
+	diffCmd := &cobra.Command{
+		Short: "Compares two release notes JSON documents",
+		Long: `release-notes diff compares two release notes documents generated by
+release-notes generate --format=json, for example before and after editing
+release notes maps.
+
+It reports the added, removed and modified notes per PR. Modified notes are
+detected by their content hash and contain the field level changes, like the
+text, kinds, SIGs or the action required flag.`,
+		Use:           "diff <old.json> <new.json>",
+		Example:       "release-notes diff release-notes-rc.0.json release-notes-rc.1.json",
+		Args:          cobra.ExactArgs(2),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PreRunE: func(*cobra.Command, []string) error {
+			return diffOpts.Validate()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDiff(cmd.OutOrStdout(), diffOpts, args[0], args[1])
+		},
+	}
+
+	diffCmd.PersistentFlags().StringVar(
+		&diffOpts.format,
+		"format",
+		env.Default("DIFF_FORMAT", diffFormatText),
+		fmt.Sprintf("The format of the diff output (options: %s, %s)", diffFormatText, diffFormatJSON),
+	)
+
+	parent.AddCommand(diffCmd)
+}
+
+func runDiff(w io.Writer, opts *diffOptions, oldPath, newPath string) error {
+	oldNotes, err := readReleaseNotesJSON(oldPath)
+	if err != nil {
+		return err
+	}
+
+	newNotes, err := readReleaseNotesJSON(newPath)
+	if err != nil {
+		return err
+	}
+
+	diff, err := notes.DiffReleaseNotes(oldNotes, newNotes)
+	if err != nil {
+		return fmt.Errorf("diffing release notes: %w", err)
+	}
+
+	if opts.format == diffFormatJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(diff); err != nil {
+			return fmt.Errorf("encoding JSON output: %w", err)
+		}
+
+		return nil
+	}
+
+	if _, err := fmt.Fprintln(w, diff.String()); err != nil {
+		return fmt.Errorf("writing diff: %w", err)
+	}
+
+	return nil
+}
+
+func readReleaseNotesJSON(path string) (*notes.ReleaseNotes, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening release notes: %w", err)
+	}
+	defer f.Close()
+
+	releaseNotes, err := notes.ParseReleaseNotesJSON(f)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	return releaseNotes, nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/notes"
+)
+
+func TestRunDiff(t *testing.T) {
+	dir := t.TempDir()
+	oldPath := filepath.Join(dir, "old.json")
+	newPath := filepath.Join(dir, "new.json")
+
+	require.NoError(t, os.WriteFile(oldPath, []byte(
+		`{"1": {"pr_number": 1, "text": "Old text", "kinds": ["bug"]}}`,
+	), os.FileMode(0o644)))
+	require.NoError(t, os.WriteFile(newPath, []byte(
+		`{"1": {"pr_number": 1, "text": "New text", "kinds": ["bug"]}, "2": {"pr_number": 2, "text": "Added"}}`,
+	), os.FileMode(0o644)))
+
+	for _, tc := range []struct {
+		name        string
+		opts        *diffOptions
+		oldPath     string
+		shouldError bool
+		assert      func(string)
+	}{
+		{
+			name:    "text output",
+			opts:    &diffOptions{format: diffFormatText},
+			oldPath: oldPath,
+			assert: func(output string) {
+				require.Contains(t, output, "1 added, 0 removed, 1 modified release notes")
+				require.Contains(t, output, `text: "Old text" -> "New text"`)
+			},
+		},
+		{
+			name:    "JSON output",
+			opts:    &diffOptions{format: diffFormatJSON},
+			oldPath: oldPath,
+			assert: func(output string) {
+				diff := &notes.ReleaseNotesDiff{}
+				require.NoError(t, json.Unmarshal([]byte(output), diff))
+				require.Len(t, diff.Added, 1)
+				require.Empty(t, diff.Removed)
+				require.Len(t, diff.Modified, 1)
+				require.Equal(t, "text", diff.Modified[0].Changes[0].Field)
+			},
+		},
+		{
+			name:        "non existing file",
+			opts:        &diffOptions{format: diffFormatText},
+			oldPath:     filepath.Join(dir, "missing.json"),
+			shouldError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			output := &strings.Builder{}
+
+			err := runDiff(output, tc.opts, tc.oldPath, newPath)
+			if tc.shouldError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			tc.assert(output.String())
+		})
+	}
+}
+
+func TestDiffOptionsValidate(t *testing.T) {
+	require.NoError(t, (&diffOptions{format: diffFormatText}).Validate())
+	require.NoError(t, (&diffOptions{format: diffFormatJSON}).Validate())
+	require.Error(t, (&diffOptions{format: "yaml"}).Validate())
+}
+
+func TestDiffFormatEnv(t *testing.T) {
+	// The generate format must not leak into the diff command
+	t.Setenv("FORMAT", "markdown")
+	t.Setenv("DIFF_FORMAT", diffFormatJSON)
+
+	parent := &cobra.Command{}
+	addDiff(parent)
+
+	diffCmd, _, err := parent.Find([]string{"diff"})
+	require.NoError(t, err)
+	require.Equal(t, diffFormatJSON, diffCmd.PersistentFlags().Lookup("format").DefValue)
+}
@@ -47,6 +47,30 @@ func addGenerateFlags(subcommand *cobra.Command) {
 		"Upload URL of github",
 	)
 
+	// forge is the code hosting platform to gather the pull requests from.
+	subcommand.PersistentFlags().StringVar(
+		&opts.Forge,
+		"forge",
+		env.Default("FORGE", options.ForgeGitHub),
+		fmt.Sprintf(
+			"The code hosting platform of the repository (options: %s). "+
+				"GitLab and Gitea tokens are read from $%s and $%s",
+			strings.Join(options.Forges(), ", "),
+			options.GitLabTokenEnvKey, options.GiteaTokenEnvKey,
+		),
+	)
+
+	// forgeURL is the base URL of the GitLab or Gitea instance.
+	subcommand.PersistentFlags().StringVar(
+		&opts.ForgeURL,
+		"forge-url",
+		env.Default("FORGE_URL", ""),
+		fmt.Sprintf(
+			"Base URL of the GitLab or Gitea instance (defaults to %s or %s)",
+			options.DefaultGitLabURL, options.DefaultGiteaURL,
+		),
+	)
+
 	// githubOrg contains name of github organization that holds the repo to scrape.
 	subcommand.PersistentFlags().StringVar(
 		&opts.GithubOrg,
@@ -141,7 +165,7 @@ func addGenerateFlags(subcommand *cobra.Command) {
 		"format",
 		env.Default("FORMAT", options.FormatMarkdown),
 		fmt.Sprintf("The format for notes output (options: %s)",
-			options.FormatJSON+", "+options.FormatMarkdown,
+			strings.Join(options.Formats(), ", "),
 		),
 	)
 
@@ -234,7 +258,9 @@ func addGenerateFlags(subcommand *cobra.Command) {
 		"maps-from",
 		"m",
 		[]string{},
-		"specify a location to recursively look for release notes *.y[a]ml file mappings",
+		"specify a location to recursively look for release notes *.y[a]ml file mappings. "+
+			"Bucket (gs://, s3://), web (https://) and GitHub (github://org/repo/path[@ref]) "+
+			"locations are read lazily per PR from pr-<number>-map.yaml files",
 	)
 }
 
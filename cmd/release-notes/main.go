@@ -113,6 +113,37 @@ func WriteReleaseNotes(releaseNotes *notes.ReleaseNotes) (err error) {
 			return fmt.Errorf("creating release note document: %w", err)
 		}
 
+		if opts.Format != options.FormatMarkdown {
+			if releaseNotesOpts.dependencies || releaseNotesOpts.tableOfContents {
+				logrus.Infof(
+					"Skipping dependency report and table of contents for format %s",
+					opts.Format,
+				)
+			}
+
+			if err := doc.FetchDownloads(opts.ReleaseBucket, opts.ReleaseTars, ""); err != nil {
+				return fmt.Errorf("fetching release note downloads: %w", err)
+			}
+
+			renderer, err := document.NewRenderer(opts.Format, opts.GoTemplate)
+			if err != nil {
+				return fmt.Errorf("creating release note renderer: %w", err)
+			}
+
+			content, err := renderer.Render(doc)
+			if err != nil {
+				return fmt.Errorf("rendering release note document: %w", err)
+			}
+
+			if _, err := output.WriteString(content); err != nil {
+				return fmt.Errorf("writing output file: %w", err)
+			}
+
+			logrus.Infof("Release notes written to file: %s", output.Name())
+
+			return nil
+		}
+
 		markdown, err := doc.RenderMarkdownTemplate(opts.ReleaseBucket, opts.ReleaseTars, "", opts.GoTemplate)
 		if err != nil {
 			return fmt.Errorf("rendering release note document with template: %w", err)
@@ -177,7 +208,7 @@ func hackDefaultSubcommand(cmd *cobra.Command) {
 
 		// Check if the first arg corresponds to a registered subcommand
 		for _, command := range cmd.Commands() {
-			if command.Use == os.Args[1] {
+			if command.Name() == os.Args[1] {
 				return
 			}
 		}
@@ -201,6 +232,7 @@ func main() {
 
 	addGenerate(cmd)
 	addCheckPR(cmd)
+	addDiff(cmd)
 
 	cmd.AddCommand(version.WithFont("slant"))
 
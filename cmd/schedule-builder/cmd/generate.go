@@ -0,0 +1,381 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/release-utils/helpers"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	monthsFlag         = "months"
+	exclusionsPathFlag = "exclusions-path"
+
+	defaultGenerateMonths = 12
+
+	// maxExclusionShifts is the number of weeks a patch release gets moved
+	// to avoid an exclusion before the month is skipped.
+	maxExclusionShifts = 2
+
+	generateHelp = `# Draft generated by "schedule-builder generate", review before adopting:
+# https://github.com/kubernetes/release/tree/master/cmd/schedule-builder
+---
+`
+
+	noteMaintenanceMode = "Maintenance mode release, only critical fixes"
+	noteFinalRelease    = "Final patch release before end of life"
+)
+
+type generateOptions struct {
+	months         int
+	exclusionsPath string
+}
+
+var generateOpts = &generateOptions{}
+
+var generateCmd = &cobra.Command{
+	Use:   "generate --config-path path/to/schedule.yaml [--months N] [--exclusions-path path/to/exclusions.yaml]",
+	Short: "Generate a draft of the upcoming patch releases for every supported branch",
+	Long: `Generate a draft of the upcoming patch releases for every supported branch.
+
+The monthly patch releases follow the usual cadence with the cherry pick
+deadline on the first Friday and the target date on the second Tuesday of the
+month. Releases are planned until the end of life date of a branch and get
+marked if the branch is in maintenance mode.
+
+Holidays and freezes can be excluded by providing a YAML file like:
+
+exclusions:
+  - name: End of year holidays
+    start: 2025-12-15
+    end: 2026-01-05
+
+A release which collides with an exclusion is moved by up to two weeks within
+the same month, otherwise no release is planned for that month.
+`,
+	Example:       "schedule-builder generate -c ../website/data/releases/schedule.yaml --months 6",
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(*cobra.Command, []string) error {
+		return runGenerate(opts, generateOpts, time.Now())
+	},
+}
+
+func init() {
+	generateCmd.PersistentFlags().IntVarP(
+		&generateOpts.months,
+		monthsFlag,
+		"m",
+		defaultGenerateMonths,
+		"number of months to plan the patch releases for",
+	)
+
+	generateCmd.PersistentFlags().StringVar(
+		&generateOpts.exclusionsPath,
+		exclusionsPathFlag,
+		"",
+		"path to a YAML file containing holidays and freezes without patch releases",
+	)
+
+	rootCmd.AddCommand(generateCmd)
+}
+
+// exclusionPeriod is a parsed Exclusion.
+type exclusionPeriod struct {
+	name       string
+	start, end time.Time
+}
+
+func (e *exclusionPeriod) contains(t time.Time) bool {
+	return !t.Before(e.start) && !t.After(e.end)
+}
+
+func parseExclusions(exclusions Exclusions) ([]exclusionPeriod, error) {
+	periods := make([]exclusionPeriod, 0, len(exclusions.Exclusions))
+
+	for i, e := range exclusions.Exclusions {
+		if e == nil {
+			continue
+		}
+
+		start, err := time.Parse(refDate, strings.TrimSpace(e.Start))
+		if err != nil {
+			return nil, fmt.Errorf("parse start of exclusion %d (%s): %w", i, e.Name, err)
+		}
+
+		end := start
+		if e.End != "" {
+			end, err = time.Parse(refDate, strings.TrimSpace(e.End))
+			if err != nil {
+				return nil, fmt.Errorf("parse end of exclusion %d (%s): %w", i, e.Name, err)
+			}
+		}
+
+		if end.Before(start) {
+			return nil, fmt.Errorf("exclusion %d (%s) ends before it starts", i, e.Name)
+		}
+
+		periods = append(periods, exclusionPeriod{name: e.Name, start: start, end: end})
+	}
+
+	return periods, nil
+}
+
+// excludedBy returns the exclusion which contains any of the dates.
+func excludedBy(exclusions []exclusionPeriod, dates ...time.Time) *exclusionPeriod {
+	for i := range exclusions {
+		for _, date := range dates {
+			if exclusions[i].contains(date) {
+				return &exclusions[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// monthlyPatchSlots returns the cherry pick deadlines and target dates of
+// the monthly patch releases for the given number of months, starting with
+// the first release targeted after the reference time.
+func monthlyPatchSlots(refTime time.Time, months int, exclusions []exclusionPeriod) []*PatchRelease {
+	slots := []*PatchRelease{}
+	month := time.Date(refTime.Year(), refTime.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	if time.Date(month.Year(), month.Month(), secondTuesday(month), 0, 0, 0, 0, time.UTC).Before(refTime) {
+		month = month.AddDate(0, 1, 0)
+	}
+
+	for range months {
+		cherryPick := time.Date(month.Year(), month.Month(), firstFriday(month), 0, 0, 0, 0, time.UTC)
+		target := time.Date(month.Year(), month.Month(), secondTuesday(month), 0, 0, 0, 0, time.UTC)
+		note := ""
+
+		for shift := 0; ; shift++ {
+			exclusion := excludedBy(exclusions, cherryPick, target)
+			if exclusion == nil {
+				break
+			}
+
+			if shift == maxExclusionShifts || target.AddDate(0, 0, 7).Month() != month.Month() {
+				logrus.Warnf(
+					"Skipping patch release in %s because of exclusion %q", month.Format(refDateMonthly), exclusion.name,
+				)
+
+				cherryPick = time.Time{}
+
+				break
+			}
+
+			logrus.Infof("Moving patch release in %s by one week because of exclusion %q", month.Format(refDateMonthly), exclusion.name)
+
+			cherryPick = cherryPick.AddDate(0, 0, 7)
+			target = target.AddDate(0, 0, 7)
+			note = "Moved because of " + exclusion.name
+		}
+
+		if !cherryPick.IsZero() {
+			slots = append(slots, &PatchRelease{
+				CherryPickDeadline: cherryPick.Format(refDate),
+				TargetDate:         target.Format(refDate),
+				Note:               note,
+			})
+		}
+
+		month = month.AddDate(0, 1, 0)
+	}
+
+	return slots
+}
+
+// generatePatchSchedule plans the patch releases of all supported branches
+// for the given number of months.
+func generatePatchSchedule(
+	refTime time.Time, schedule PatchSchedule, exclusions []exclusionPeriod, months int,
+) (*GeneratedSchedule, error) {
+	if months < 1 {
+		return nil, errors.New("number of months has to be at least 1")
+	}
+
+	slots := monthlyPatchSlots(refTime, months, exclusions)
+	generated := &GeneratedSchedule{UpcomingReleases: slots}
+
+	for _, sched := range schedule.Schedules {
+		if sched == nil {
+			continue
+		}
+
+		if sched.Next == nil {
+			logrus.Warnf("Next release not set for %s, skipping", sched.Release)
+
+			continue
+		}
+
+		branch, err := generateBranchReleases(sched, slots)
+		if err != nil {
+			return nil, fmt.Errorf("generate releases for %s: %w", sched.Release, err)
+		}
+
+		generated.Branches = append(generated.Branches, branch)
+	}
+
+	return generated, nil
+}
+
+func generateBranchReleases(sched *Schedule, slots []*PatchRelease) (*GeneratedBranch, error) {
+	branch := &GeneratedBranch{
+		Release:                  sched.Release,
+		EndOfLifeDate:            sched.EndOfLifeDate,
+		MaintenanceModeStartDate: sched.MaintenanceModeStartDate,
+		Releases:                 []*PatchRelease{},
+	}
+
+	version, err := helpers.TagStringToSemver(sched.Next.Release)
+	if err != nil {
+		return nil, fmt.Errorf("parse semver version: %w", err)
+	}
+
+	nextTarget, err := time.Parse(refDate, sched.Next.TargetDate)
+	if err != nil {
+		return nil, fmt.Errorf("parse target date: %w", err)
+	}
+
+	// An unknown end of life date ("TBD") does not limit the releases.
+	eol, eolErr := time.Parse(refDate, sched.EndOfLifeDate)
+
+	for _, slot := range slots {
+		target, err := time.Parse(refDate, slot.TargetDate)
+		if err != nil {
+			return nil, fmt.Errorf("parse slot target date: %w", err)
+		}
+
+		release := &PatchRelease{
+			Release:            version.String(),
+			CherryPickDeadline: slot.CherryPickDeadline,
+			TargetDate:         slot.TargetDate,
+		}
+
+		switch {
+		case monthIndex(target) < monthIndex(nextTarget):
+			// The next release of the branch is planned later.
+			continue
+
+		case monthIndex(target) == monthIndex(nextTarget):
+			// Keep the already planned next release.
+			release.CherryPickDeadline = sched.Next.CherryPickDeadline
+			release.TargetDate = sched.Next.TargetDate
+			target = nextTarget
+		}
+
+		if eolErr == nil && target.After(eol) {
+			if len(branch.Releases) > 0 {
+				branch.Releases[len(branch.Releases)-1].Note = noteFinalRelease
+			}
+
+			break
+		}
+
+		if isInMaintenanceWindow(target, sched) {
+			release.Note = noteMaintenanceMode
+		}
+
+		branch.Releases = append(branch.Releases, release)
+
+		if err := version.IncrementPatch(); err != nil {
+			return nil, fmt.Errorf("increment patch version: %w", err)
+		}
+	}
+
+	return branch, nil
+}
+
+func monthIndex(t time.Time) int {
+	return t.Year()*12 + int(t.Month())
+}
+
+func runGenerate(opts *options, generateOpts *generateOptions, refTime time.Time) error {
+	if opts.configPath == "" {
+		return fmt.Errorf("need to set the '--%s' flag", configPathFlag)
+	}
+
+	logrus.Infof("Reading schedule file: %s", opts.configPath)
+
+	data, err := os.ReadFile(opts.configPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the file: %w", err)
+	}
+
+	var schedule PatchSchedule
+	if err := yaml.UnmarshalStrict(data, &schedule); err != nil {
+		return fmt.Errorf("failed to decode patch schedule: %w", err)
+	}
+
+	var exclusions Exclusions
+
+	if generateOpts.exclusionsPath != "" {
+		logrus.Infof("Reading exclusions file: %s", generateOpts.exclusionsPath)
+
+		data, err := os.ReadFile(generateOpts.exclusionsPath)
+		if err != nil {
+			return fmt.Errorf("failed to read exclusions file: %w", err)
+		}
+
+		if err := yaml.UnmarshalStrict(data, &exclusions); err != nil {
+			return fmt.Errorf("failed to decode exclusions: %w", err)
+		}
+	}
+
+	periods, err := parseExclusions(exclusions)
+	if err != nil {
+		return fmt.Errorf("parsing exclusions: %w", err)
+	}
+
+	logrus.Infof("Generating patch releases for %d months", generateOpts.months)
+
+	generated, err := generatePatchSchedule(refTime, schedule, periods, generateOpts.months)
+	if err != nil {
+		return fmt.Errorf("generating patch schedule: %w", err)
+	}
+
+	yamlBytes, err := yaml.Marshal(generated)
+	if err != nil {
+		return fmt.Errorf("marshal generated schedule YAML: %w", err)
+	}
+
+	scheduleOut := generateHelp + string(yamlBytes)
+	println(scheduleOut)
+
+	if opts.outputFile != "" {
+		logrus.Infof("Saving generated schedule to file: %s", opts.outputFile)
+		//nolint:gosec // the draft is meant to be shared
+		if err := os.WriteFile(opts.outputFile, []byte(scheduleOut), 0o644); err != nil {
+			return fmt.Errorf("failed to save generated schedule to the file: %w", err)
+		}
+
+		logrus.Info("File saved")
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePatchSchedule(t *testing.T) {
+	schedule := PatchSchedule{
+		Schedules: []*Schedule{
+			{
+				Release:                  "1.33",
+				Next:                     &PatchRelease{Release: "1.33.5", CherryPickDeadline: "2025-10-10", TargetDate: "2025-10-14"},
+				EndOfLifeDate:            dateTBD,
+				MaintenanceModeStartDate: dateTBD,
+			},
+			{
+				Release:                  "1.31",
+				Next:                     &PatchRelease{Release: "1.31.13", CherryPickDeadline: "2025-09-05", TargetDate: "2025-09-09"},
+				EndOfLifeDate:            "2025-12-28",
+				MaintenanceModeStartDate: "2025-10-28",
+			},
+			{Release: "1.30"},
+		},
+	}
+
+	exclusions, err := parseExclusions(Exclusions{Exclusions: []*Exclusion{
+		{Name: "KubeCon", Start: "2025-11-10", End: "2025-11-14"},
+		{Name: "Holidays", Start: "2026-01-01", End: "2026-01-31"},
+	}})
+	require.NoError(t, err)
+
+	generated, err := generatePatchSchedule(time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), schedule, exclusions, 4)
+	require.NoError(t, err)
+
+	require.Equal(t, []*PatchRelease{
+		{CherryPickDeadline: "2025-10-10", TargetDate: "2025-10-14"},
+		{CherryPickDeadline: "2025-11-21", TargetDate: "2025-11-25", Note: "Moved because of KubeCon"},
+		{CherryPickDeadline: "2025-12-05", TargetDate: "2025-12-09"},
+	}, generated.UpcomingReleases)
+
+	require.Len(t, generated.Branches, 2)
+	require.Equal(t, &GeneratedBranch{
+		Release:                  "1.33",
+		EndOfLifeDate:            dateTBD,
+		MaintenanceModeStartDate: dateTBD,
+		Releases: []*PatchRelease{
+			{Release: "1.33.5", CherryPickDeadline: "2025-10-10", TargetDate: "2025-10-14"},
+			{Release: "1.33.6", CherryPickDeadline: "2025-11-21", TargetDate: "2025-11-25"},
+			{Release: "1.33.7", CherryPickDeadline: "2025-12-05", TargetDate: "2025-12-09"},
+		},
+	}, generated.Branches[0])
+	require.Equal(t, &GeneratedBranch{
+		Release:                  "1.31",
+		EndOfLifeDate:            "2025-12-28",
+		MaintenanceModeStartDate: "2025-10-28",
+		Releases: []*PatchRelease{
+			{Release: "1.31.13", CherryPickDeadline: "2025-10-10", TargetDate: "2025-10-14"},
+			{Release: "1.31.14", CherryPickDeadline: "2025-11-21", TargetDate: "2025-11-25", Note: noteMaintenanceMode},
+			{Release: "1.31.15", CherryPickDeadline: "2025-12-05", TargetDate: "2025-12-09", Note: noteMaintenanceMode},
+		},
+	}, generated.Branches[1])
+}
+
+func TestGeneratePatchScheduleFinalRelease(t *testing.T) {
+	schedule := PatchSchedule{Schedules: []*Schedule{{
+		Release:                  "1.31",
+		Next:                     &PatchRelease{Release: "1.31.13", CherryPickDeadline: "2025-10-10", TargetDate: "2025-10-14"},
+		EndOfLifeDate:            "2025-11-28",
+		MaintenanceModeStartDate: "2025-10-28",
+	}}}
+
+	// Starts in November since the October release is already targeted
+	generated, err := generatePatchSchedule(time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC), schedule, nil, 3)
+	require.NoError(t, err)
+	require.Len(t, generated.UpcomingReleases, 3)
+	require.Equal(t, "2025-11-11", generated.UpcomingReleases[0].TargetDate)
+	require.Equal(t, []*PatchRelease{
+		{Release: "1.31.13", CherryPickDeadline: "2025-11-07", TargetDate: "2025-11-11", Note: noteFinalRelease},
+	}, generated.Branches[0].Releases)
+}
+
+func TestGeneratePatchScheduleFailure(t *testing.T) {
+	_, err := generatePatchSchedule(time.Now(), PatchSchedule{}, nil, 0)
+	require.Error(t, err)
+
+	_, err = generatePatchSchedule(time.Now(), PatchSchedule{Schedules: []*Schedule{{
+		Release: "1.31",
+		Next:    &PatchRelease{Release: "1.31.13", TargetDate: "TBD"},
+	}}}, nil, 1)
+	require.Error(t, err)
+
+	_, err = parseExclusions(Exclusions{Exclusions: []*Exclusion{{Name: "invalid", Start: "2025-12-01", End: "2025-11-01"}}})
+	require.ErrorContains(t, err, "ends before it starts")
+
+	_, err = parseExclusions(Exclusions{Exclusions: []*Exclusion{{Name: "invalid", Start: "TBD"}}})
+	require.Error(t, err)
+}
+
+func TestRunGenerate(t *testing.T) {
+	tempDir := t.TempDir()
+	exclusionsPath := filepath.Join(tempDir, "exclusions.yaml")
+	require.NoError(t, os.WriteFile(exclusionsPath, []byte("exclusions:\n- name: Freeze\n  start: 2020-07-14\n"), 0o600))
+
+	o := &options{configPath: "testdata/schedule.yaml", outputFile: filepath.Join(tempDir, "draft.yaml")}
+	require.NoError(t, runGenerate(o, &generateOptions{months: 2, exclusionsPath: exclusionsPath}, time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)))
+
+	out, err := os.ReadFile(o.outputFile)
+	require.NoError(t, err)
+	require.Contains(t, string(out), generateHelp)
+	require.Contains(t, string(out), "- cherryPickDeadline: \"2020-07-17\"\n  note: Moved because of Freeze\n  targetDate: \"2020-07-21\"\n")
+	require.Contains(t, string(out), "release: 1.18.5")
+
+	require.Error(t, runGenerate(&options{}, generateOpts, time.Now()))
+}
@@ -0,0 +1,393 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	icalProdID    = "-//Kubernetes//schedule-builder//EN"
+	icalUIDDomain = "schedule-builder.k8s.io"
+	icalDate      = "20060102"
+	icalTimestamp = "20060102T150405Z"
+
+	// icalLineLength is the maximum line length in octets before folding,
+	// excluding the line break.
+	icalLineLength = 75
+)
+
+var (
+	// timelineDateLayouts are the supported formats of Timeline.When.
+	timelineDateLayouts = []string{
+		"Mon January 2, 2006",
+		"Monday January 2, 2006",
+		"Mon Jan 2, 2006",
+		"January 2, 2006",
+		refDate,
+	}
+
+	// timelineRangeRegex matches date ranges within a month, like
+	// "October 11-15, 2021".
+	timelineRangeRegex = regexp.MustCompile(`^(?:[A-Za-z]+ )?([A-Za-z]+) (\d{1,2})-(\d{1,2}), (\d{4})$`)
+
+	uidInvalidChars = regexp.MustCompile(`[^a-z0-9.]+`)
+)
+
+// icalEvent is a single all-day event of the calendar.
+type icalEvent struct {
+	// UID identifies the event across regenerations of the calendar, it
+	// does not depend on the date so that moved events update in place.
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+
+	// End is the exclusive end date, it defaults to the day after Start.
+	End time.Time
+}
+
+// parseICalSchedule decodes either a patch or a release cycle schedule and
+// returns it together with the end of life branches as iCalendar.
+func parseICalSchedule(data []byte, eolBranches EolBranches, stamp time.Time) (string, error) {
+	var (
+		patchSchedule   PatchSchedule
+		releaseSchedule ReleaseSchedule
+		events          []icalEvent
+		name            string
+	)
+
+	patchErr := yaml.UnmarshalStrict(data, &patchSchedule)
+	if patchErr == nil {
+		logrus.Info("Using patch schedule for calendar")
+
+		name = "Kubernetes Patch Releases"
+		events = patchScheduleEvents(patchSchedule)
+	} else {
+		if err := yaml.UnmarshalStrict(data, &releaseSchedule); err != nil {
+			return "", fmt.Errorf(
+				"failed to decode as patch or release schedule: %w", errors.Join(patchErr, err),
+			)
+		}
+
+		logrus.Info("Using release cycle schedule for calendar")
+
+		name = "Kubernetes Release Cycle"
+		events = releaseScheduleEvents(releaseSchedule)
+	}
+
+	// Branches reaching their end of life can be part of the patch schedule
+	// and the end of life branches. The end of life branch wins, because it
+	// also contains the final patch release.
+	eolEvents := eolBranchEvents(eolBranches)
+
+	eolUIDs := map[string]bool{}
+	for _, event := range eolEvents {
+		eolUIDs[event.UID] = true
+	}
+
+	events = slices.DeleteFunc(events, func(event icalEvent) bool {
+		return eolUIDs[event.UID]
+	})
+	events = append(events, eolEvents...)
+
+	logrus.Infof("Calendar contains %d events", len(events))
+
+	return renderICal(name, events, stamp), nil
+}
+
+// patchScheduleEvents returns the cherry pick deadlines, target dates and
+// end of life dates of a patch schedule.
+func patchScheduleEvents(schedule PatchSchedule) []icalEvent {
+	events := []icalEvent{}
+
+	for _, upcoming := range schedule.UpcomingReleases {
+		if upcoming == nil {
+			continue
+		}
+
+		targetDate, err := time.Parse(refDate, strings.TrimSpace(upcoming.TargetDate))
+		if err != nil {
+			logrus.Warnf("Skipping upcoming release with target date %q: %v", upcoming.TargetDate, err)
+
+			continue
+		}
+
+		// Upcoming releases have no version, the month is their identity.
+		id := "monthly-" + targetDate.Format("2006-01")
+		month := targetDate.Format(refDateMonthly)
+
+		events = appendDateEvent(events, id+"-cherry-pick-deadline", upcoming.CherryPickDeadline,
+			month+" patch releases: cherry pick deadline", upcoming.Note)
+		events = appendDateEvent(events, id+"-target-date", upcoming.TargetDate,
+			month+" patch releases", upcoming.Note)
+	}
+
+	seen := map[string]bool{}
+
+	for _, sched := range schedule.Schedules {
+		if sched == nil {
+			continue
+		}
+
+		patches := sched.PreviousPatches
+		if sched.Next != nil {
+			patches = append([]*PatchRelease{sched.Next}, patches...)
+		}
+
+		for _, patch := range patches {
+			if patch == nil || seen[patch.Release] {
+				continue
+			}
+
+			seen[patch.Release] = true
+			id := "patch-" + patch.Release
+
+			events = appendDateEvent(events, id+"-cherry-pick-deadline", patch.CherryPickDeadline,
+				fmt.Sprintf("Kubernetes %s cherry pick deadline", patch.Release), patch.Note)
+			events = appendDateEvent(events, id+"-target-date", patch.TargetDate,
+				fmt.Sprintf("Kubernetes %s release", patch.Release), patch.Note)
+		}
+
+		events = appendDateEvent(events, "maintenance-"+sched.Release, sched.MaintenanceModeStartDate,
+			fmt.Sprintf("Kubernetes %s enters maintenance mode", sched.Release), "")
+		events = appendDateEvent(events, "eol-"+sched.Release, sched.EndOfLifeDate,
+			fmt.Sprintf("Kubernetes %s end of life", sched.Release), "")
+	}
+
+	return events
+}
+
+// releaseScheduleEvents returns the timeline entries of a release cycle
+// schedule.
+func releaseScheduleEvents(schedule ReleaseSchedule) []icalEvent {
+	events := []icalEvent{}
+
+	for _, release := range schedule.Releases {
+		ids := map[string]int{}
+
+		for _, timeline := range release.Timeline {
+			what := strings.TrimSpace(timeline.What)
+
+			// Identical entries within a release get a counter suffix.
+			id := fmt.Sprintf("release-%s-%s", release.Version, what)
+			ids[id]++
+
+			if ids[id] > 1 {
+				id = fmt.Sprintf("%s-%d", id, ids[id])
+			}
+
+			start, end, err := parseTimelineDate(timeline.When)
+			if err != nil {
+				logrus.Infof("Skipping timeline entry %q: %v", what, err)
+
+				continue
+			}
+
+			description := []string{}
+
+			for _, field := range []struct{ name, value string }{
+				{"Who", timeline.Who},
+				{"Week", timeline.Week},
+				{"CI Signal", timeline.CISignal},
+			} {
+				if value := strings.TrimSpace(field.value); value != "" {
+					description = append(description, fmt.Sprintf("%s: %s", field.name, value))
+				}
+			}
+
+			events = append(events, icalEvent{
+				UID:         icalUID(id),
+				Summary:     fmt.Sprintf("Kubernetes %s: %s", release.Version, what),
+				Description: strings.Join(description, "\n"),
+				Start:       start,
+				End:         end,
+			})
+		}
+	}
+
+	return events
+}
+
+// eolBranchEvents returns the end of life dates of the branches.
+func eolBranchEvents(eolBranches EolBranches) []icalEvent {
+	events := []icalEvent{}
+	seen := map[string]bool{}
+
+	for _, branch := range eolBranches.Branches {
+		if branch == nil || seen[branch.Release] {
+			continue
+		}
+
+		seen[branch.Release] = true
+
+		description := branch.Note
+		if branch.FinalPatchRelease != "" {
+			description = strings.TrimSpace(fmt.Sprintf(
+				"Final patch release: %s\n%s", branch.FinalPatchRelease, branch.Note,
+			))
+		}
+
+		events = appendDateEvent(events, "eol-"+branch.Release, branch.EndOfLifeDate,
+			fmt.Sprintf("Kubernetes %s end of life", branch.Release), description)
+	}
+
+	return events
+}
+
+// appendDateEvent adds a single day event for the date if it can be
+// parsed. Placeholders like "TBD" are skipped.
+func appendDateEvent(events []icalEvent, id, date, summary, description string) []icalEvent {
+	start, err := time.Parse(refDate, strings.TrimSpace(date))
+	if err != nil {
+		logrus.Debugf("Skipping %q with date %q: %v", summary, date, err)
+
+		return events
+	}
+
+	return append(events, icalEvent{
+		UID:         icalUID(id),
+		Summary:     summary,
+		Description: strings.TrimSpace(description),
+		Start:       start,
+		End:         start.AddDate(0, 0, 1),
+	})
+}
+
+// parseTimelineDate parses a Timeline.When value into the start and the
+// exclusive end date.
+func parseTimelineDate(when string) (start, end time.Time, err error) {
+	when = strings.Join(strings.Fields(when), " ")
+
+	for _, layout := range timelineDateLayouts {
+		if start, err := time.Parse(layout, when); err == nil {
+			return start, start.AddDate(0, 0, 1), nil
+		}
+	}
+
+	if match := timelineRangeRegex.FindStringSubmatch(when); match != nil {
+		start, err := time.Parse("January 2, 2006", fmt.Sprintf("%s %s, %s", match[1], match[2], match[4]))
+		if err != nil {
+			return start, end, fmt.Errorf("parse range start %q: %w", when, err)
+		}
+
+		last, err := time.Parse("January 2, 2006", fmt.Sprintf("%s %s, %s", match[1], match[3], match[4]))
+		if err != nil {
+			return start, end, fmt.Errorf("parse range end %q: %w", when, err)
+		}
+
+		if last.Before(start) {
+			return start, end, fmt.Errorf("range %q ends before it starts", when)
+		}
+
+		return start, last.AddDate(0, 0, 1), nil
+	}
+
+	return start, end, fmt.Errorf("unsupported date %q", when)
+}
+
+// icalUID converts the identifier into a globally unique event UID.
+func icalUID(id string) string {
+	id = strings.Trim(uidInvalidChars.ReplaceAllString(strings.ToLower(id), "-"), "-")
+
+	return id + "@" + icalUIDDomain
+}
+
+// renderICal returns the events as RFC 5545 calendar. The events are sorted
+// by date to produce stable output.
+func renderICal(name string, events []icalEvent, stamp time.Time) string {
+	slices.SortStableFunc(events, func(a, b icalEvent) int {
+		return cmp.Or(a.Start.Compare(b.Start), strings.Compare(a.UID, b.UID))
+	})
+
+	b := &strings.Builder{}
+	writeICalLine(b, "BEGIN", "VCALENDAR")
+	writeICalLine(b, "VERSION", "2.0")
+	writeICalLine(b, "PRODID", icalProdID)
+	writeICalLine(b, "CALSCALE", "GREGORIAN")
+	writeICalLine(b, "METHOD", "PUBLISH")
+	writeICalLine(b, "X-WR-CALNAME", escapeICalText(name))
+
+	for _, event := range events {
+		end := event.End
+		if !end.After(event.Start) {
+			end = event.Start.AddDate(0, 0, 1)
+		}
+
+		writeICalLine(b, "BEGIN", "VEVENT")
+		writeICalLine(b, "UID", event.UID)
+		writeICalLine(b, "DTSTAMP", stamp.UTC().Format(icalTimestamp))
+		writeICalLine(b, "DTSTART;VALUE=DATE", event.Start.Format(icalDate))
+		writeICalLine(b, "DTEND;VALUE=DATE", end.Format(icalDate))
+		writeICalLine(b, "SUMMARY", escapeICalText(event.Summary))
+
+		if event.Description != "" {
+			writeICalLine(b, "DESCRIPTION", escapeICalText(event.Description))
+		}
+
+		writeICalLine(b, "TRANSP", "TRANSPARENT")
+		writeICalLine(b, "END", "VEVENT")
+	}
+
+	writeICalLine(b, "END", "VCALENDAR")
+
+	return b.String()
+}
+
+// writeICalLine writes a content line, folded after 75 octets as required
+// by RFC 5545.
+func writeICalLine(b *strings.Builder, name, value string) {
+	line := name + ":" + value
+	limit := icalLineLength
+
+	for len(line) > limit {
+		// Do not split multi-byte UTF-8 sequences.
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+
+		// Continuation lines start with a space.
+		limit = icalLineLength - 1
+	}
+
+	b.WriteString(line + "\r\n")
+}
+
+// escapeICalText escapes a TEXT property value.
+func escapeICalText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testStamp = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+const expectedPatchICal = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Kubernetes//schedule-builder//EN\r\n" +
+	"CALSCALE:GREGORIAN\r\n" +
+	"METHOD:PUBLISH\r\n" +
+	"X-WR-CALNAME:Kubernetes Patch Releases\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:patch-1.18.3-cherry-pick-deadline@schedule-builder.k8s.io\r\n" +
+	"DTSTAMP:20260102T030405Z\r\n" +
+	"DTSTART;VALUE=DATE:20200515\r\n" +
+	"DTEND;VALUE=DATE:20200516\r\n" +
+	"SUMMARY:Kubernetes 1.18.3 cherry pick deadline\r\n" +
+	"DESCRIPTION:Out of band release\\, see\\; the notes\r\n" +
+	"TRANSP:TRANSPARENT\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:patch-1.18.3-target-date@schedule-builder.k8s.io\r\n" +
+	"DTSTAMP:20260102T030405Z\r\n" +
+	"DTSTART;VALUE=DATE:20200520\r\n" +
+	"DTEND;VALUE=DATE:20200521\r\n" +
+	"SUMMARY:Kubernetes 1.18.3 release\r\n" +
+	"DESCRIPTION:Out of band release\\, see\\; the notes\r\n" +
+	"TRANSP:TRANSPARENT\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:monthly-2020-06-cherry-pick-deadline@schedule-builder.k8s.io\r\n" +
+	"DTSTAMP:20260102T030405Z\r\n" +
+	"DTSTART;VALUE=DATE:20200612\r\n" +
+	"DTEND;VALUE=DATE:20200613\r\n" +
+	"SUMMARY:June 2020 patch releases: cherry pick deadline\r\n" +
+	"TRANSP:TRANSPARENT\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:patch-1.18.4-cherry-pick-deadline@schedule-builder.k8s.io\r\n" +
+	"DTSTAMP:20260102T030405Z\r\n" +
+	"DTSTART;VALUE=DATE:20200612\r\n" +
+	"DTEND;VALUE=DATE:20200613\r\n" +
+	"SUMMARY:Kubernetes 1.18.4 cherry pick deadline\r\n" +
+	"TRANSP:TRANSPARENT\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:monthly-2020-06-target-date@schedule-builder.k8s.io\r\n" +
+	"DTSTAMP:20260102T030405Z\r\n" +
+	"DTSTART;VALUE=DATE:20200617\r\n" +
+	"DTEND;VALUE=DATE:20200618\r\n" +
+	"SUMMARY:June 2020 patch releases\r\n" +
+	"TRANSP:TRANSPARENT\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:patch-1.18.4-target-date@schedule-builder.k8s.io\r\n" +
+	"DTSTAMP:20260102T030405Z\r\n" +
+	"DTSTART;VALUE=DATE:20200617\r\n" +
+	"DTEND;VALUE=DATE:20200618\r\n" +
+	"SUMMARY:Kubernetes 1.18.4 release\r\n" +
+	"TRANSP:TRANSPARENT\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:eol-1.17@schedule-builder.k8s.io\r\n" +
+	"DTSTAMP:20260102T030405Z\r\n" +
+	"DTSTART;VALUE=DATE:20210228\r\n" +
+	"DTEND;VALUE=DATE:20210301\r\n" +
+	"SUMMARY:Kubernetes 1.17 end of life\r\n" +
+	"DESCRIPTION:Final patch release: 1.17.17\r\n" +
+	"TRANSP:TRANSPARENT\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseICalSchedulePatch(t *testing.T) {
+	data := []byte(`
+upcoming_releases:
+- cherryPickDeadline: 2020-06-12
+  targetDate: 2020-06-17
+schedules:
+- release: "1.18"
+  next:
+    release: 1.18.4
+    cherryPickDeadline: 2020-06-12
+    targetDate: 2020-06-17
+  endOfLifeDate: TBD
+  maintenanceModeStartDate: TBD
+  previousPatches:
+  - release: 1.18.3
+    cherryPickDeadline: 2020-05-15
+    targetDate: 2020-05-20
+    note: Out of band release, see; the notes
+  - release: 1.18.4
+    cherryPickDeadline: 2020-06-12
+    targetDate: 2020-06-17
+`)
+
+	eolBranches := EolBranches{Branches: []*EolBranch{{
+		Release:           "1.17",
+		FinalPatchRelease: "1.17.17",
+		EndOfLifeDate:     "2021-02-28",
+	}}}
+
+	out, err := parseICalSchedule(data, eolBranches, testStamp)
+	require.NoError(t, err)
+	require.Equal(t, expectedPatchICal, out)
+}
+
+func TestParseICalScheduleRelease(t *testing.T) {
+	data, err := os.ReadFile("testdata/rel-schedule.yaml")
+	require.NoError(t, err)
+
+	out, err := parseICalSchedule(data, EolBranches{}, testStamp)
+	require.NoError(t, err)
+
+	require.Contains(t, out, "X-WR-CALNAME:Kubernetes Release Cycle\r\n")
+	require.Contains(t, out, "UID:release-1.23-start-of-release-cycle@schedule-builder.k8s.io\r\n"+
+		"DTSTAMP:20260102T030405Z\r\n"+
+		"DTSTART;VALUE=DATE:20210823\r\n"+
+		"DTEND;VALUE=DATE:20210824\r\n"+
+		"SUMMARY:Kubernetes 1.23: Start of Release Cycle\r\n"+
+		"DESCRIPTION:Who: Lead\\nWeek: week 1\\nCI Signal: master-blocking\r\n")
+
+	// Date ranges span multiple days
+	require.Contains(t, out, "DTSTART;VALUE=DATE:20211011\r\nDTEND;VALUE=DATE:20211016\r\n"+
+		"SUMMARY:Kubernetes 1.23: KubeCon NA + Co-located events\r\n")
+
+	// TBD entries are skipped
+	require.NotContains(t, out, "alpha.2")
+	require.Equal(t, 32, strings.Count(out, "BEGIN:VEVENT"))
+
+	// Long lines are folded
+	for line := range strings.SplitSeq(out, "\r\n") {
+		require.LessOrEqual(t, len(line), 75)
+	}
+
+	require.Contains(t, out, "SUMMARY:Kubernetes 1.23: Release retrospective part 1 (7:30am PST during th\r\n e SIG Release meeting)\r\n")
+}
+
+func TestParseICalScheduleStableUIDs(t *testing.T) {
+	const template = `
+releases:
+- version: "1.30"
+  timeline:
+  - what: Code Freeze
+    when: %s
+`
+
+	first, err := parseICalSchedule([]byte(strings.ReplaceAll(template, "%s", "Tue March 5, 2024")), EolBranches{}, testStamp)
+	require.NoError(t, err)
+
+	moved, err := parseICalSchedule([]byte(strings.ReplaceAll(template, "%s", "Tue March 12, 2024")), EolBranches{}, testStamp)
+	require.NoError(t, err)
+
+	uid := "UID:release-1.30-code-freeze@schedule-builder.k8s.io\r\n"
+	require.Contains(t, first, uid)
+	require.Contains(t, moved, uid)
+	require.NotEqual(t, first, moved)
+}
+
+func TestParseICalScheduleUniqueEOL(t *testing.T) {
+	data := []byte(`
+schedules:
+- release: "1.30"
+  endOfLifeDate: 2025-06-28
+  maintenanceModeStartDate: 2025-04-28
+`)
+
+	eolBranches := EolBranches{Branches: []*EolBranch{
+		{Release: "1.30", FinalPatchRelease: "1.30.14", EndOfLifeDate: "2025-06-28"},
+		{Release: "1.30", FinalPatchRelease: "1.30.14", EndOfLifeDate: "2025-06-28"},
+	}}
+
+	out, err := parseICalSchedule(data, eolBranches, testStamp)
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(out, "UID:eol-1.30@schedule-builder.k8s.io\r\n"))
+	require.Contains(t, out, "DESCRIPTION:Final patch release: 1.30.14\r\n")
+	require.Contains(t, out, "UID:maintenance-1.30@schedule-builder.k8s.io\r\n")
+}
+
+func TestParseICalScheduleFailure(t *testing.T) {
+	_, err := parseICalSchedule([]byte("schedule:\n- bad: schedule\n"), EolBranches{}, testStamp)
+	require.Error(t, err)
+}
+
+func TestParseTimelineDate(t *testing.T) {
+	for _, tc := range []struct {
+		when       string
+		start, end string
+		shouldErr  bool
+	}{
+		{when: "Mon August 23, 2021", start: "2021-08-23", end: "2021-08-24"},
+		{when: "Monday  August 23, 2021", start: "2021-08-23", end: "2021-08-24"},
+		{when: "August 23, 2021", start: "2021-08-23", end: "2021-08-24"},
+		{when: "2021-08-23", start: "2021-08-23", end: "2021-08-24"},
+		{when: "October 11-15, 2021", start: "2021-10-11", end: "2021-10-16"},
+		{when: "Mon October 11-15, 2021", start: "2021-10-11", end: "2021-10-16"},
+		{when: "October 15-11, 2021", shouldErr: true},
+		{when: "TBD", shouldErr: true},
+		{when: "", shouldErr: true},
+	} {
+		start, end, err := parseTimelineDate(tc.when)
+		if tc.shouldErr {
+			require.Error(t, err, tc.when)
+
+			continue
+		}
+
+		require.NoError(t, err, tc.when)
+		require.Equal(t, tc.start, start.Format(refDate), tc.when)
+		require.Equal(t, tc.end, end.Format(refDate), tc.when)
+	}
+}
@@ -70,3 +70,32 @@ type Timeline struct {
 	CISignal string `yaml:"ciSignal"`
 	Tldr     bool   `yaml:"tldr"`
 }
+
+// GeneratedSchedule is the draft of upcoming patch releases created by the
+// generate command.
+type GeneratedSchedule struct {
+	UpcomingReleases []*PatchRelease    `json:"upcoming_releases,omitempty" yaml:"upcoming_releases,omitempty"`
+	Branches         []*GeneratedBranch `json:"branches,omitempty"          yaml:"branches,omitempty"`
+}
+
+// GeneratedBranch contains the planned patch releases of a supported branch.
+type GeneratedBranch struct {
+	Release                  string          `json:"release,omitempty"                  yaml:"release,omitempty"`
+	EndOfLifeDate            string          `json:"endOfLifeDate,omitempty"            yaml:"endOfLifeDate,omitempty"`
+	MaintenanceModeStartDate string          `json:"maintenanceModeStartDate,omitempty" yaml:"maintenanceModeStartDate,omitempty"`
+	Releases                 []*PatchRelease `json:"releases,omitempty"                 yaml:"releases,omitempty"`
+}
+
+// Exclusions is the list of periods without patch releases, like holidays
+// or freezes.
+type Exclusions struct {
+	Exclusions []*Exclusion `json:"exclusions,omitempty" yaml:"exclusions,omitempty"`
+}
+
+// Exclusion is a single period without patch releases. The end date is
+// inclusive and defaults to the start date.
+type Exclusion struct {
+	Name  string `json:"name,omitempty"  yaml:"name,omitempty"`
+	Start string `json:"start,omitempty" yaml:"start,omitempty"`
+	End   string `json:"end,omitempty"   yaml:"end,omitempty"`
+}
@@ -31,7 +31,7 @@ import (
 
 // rootCmd represents the base command when called without any subcommands.
 var rootCmd = &cobra.Command{
-	Use:               "schedule-builder --config-path path/to/schedule.yaml --type <release>/or/<patch>[--output-file <filename.md>]",
+	Use:               "schedule-builder --config-path path/to/schedule.yaml --type <release>/or/<patch>/or/<ical> [--output-file <filename.md>]",
 	Short:             "schedule-builder generate a human readable format of the Kubernetes release schedule",
 	Example:           "schedule-builder --config-path /home/user/kubernetes/sig-release/releases/schedule.yaml --type release",
 	SilenceUsage:      true,
@@ -63,6 +63,7 @@ const (
 	versionFlag       = "version"
 	typePatch         = "patch"
 	typeRelease       = "release"
+	typeICal          = "ical"
 )
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -111,7 +112,7 @@ func init() {
 		typeFlag,
 		"t",
 		"patch",
-		fmt.Sprintf("type of file to be produced - release cycle schedule or patch schedule. To be set to '%s' or '%s' and respective yaml needs to be supplied with '--%s'", typeRelease, typePatch, configPathFlag),
+		fmt.Sprintf("type of file to be produced - release cycle schedule or patch schedule. To be set to '%s' or '%s' and respective yaml needs to be supplied with '--%s'. Use '%s' to produce an iCalendar (.ics) file from either schedule", typeRelease, typePatch, configPathFlag, typeICal),
 	)
 
 	rootCmd.PersistentFlags().BoolVarP(
@@ -169,15 +170,9 @@ func run(opts *options) error {
 			return fmt.Errorf("failed to decode patch schedule: %w", err)
 		}
 
-		if opts.eolConfigPath != "" {
-			data, err := os.ReadFile(opts.eolConfigPath)
-			if err != nil {
-				return fmt.Errorf("failed to read end of life config path: %w", err)
-			}
-
-			if err := yaml.UnmarshalStrict(data, &eolBranches); err != nil {
-				return fmt.Errorf("failed to decode end of life branches: %w", err)
-			}
+		eolBranches, err = readEolBranches(opts.eolConfigPath)
+		if err != nil {
+			return err
 		}
 
 		if opts.update {
@@ -213,8 +208,23 @@ func run(opts *options) error {
 
 		println(scheduleOut)
 
+	case typeICal:
+		eolBranches, err = readEolBranches(opts.eolConfigPath)
+		if err != nil {
+			return err
+		}
+
+		logrus.Infof("Generating iCalendar output for type %q", typeICal)
+
+		scheduleOut, err = parseICalSchedule(data, eolBranches, time.Now())
+		if err != nil {
+			return fmt.Errorf("parsing schedule for calendar: %w", err)
+		}
+
+		println(scheduleOut)
+
 	default:
-		return fmt.Errorf("type must be either %q or %q", typeRelease, typePatch)
+		return fmt.Errorf("type must be one of %q, %q or %q", typeRelease, typePatch, typeICal)
 	}
 
 	if opts.outputFile != "" && scheduleOut != "" {
@@ -231,6 +241,26 @@ func run(opts *options) error {
 	return nil
 }
 
+// readEolBranches reads the end of life branches if the path is set.
+func readEolBranches(path string) (EolBranches, error) {
+	var eolBranches EolBranches
+
+	if path == "" {
+		return eolBranches, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return eolBranches, fmt.Errorf("failed to read end of life config path: %w", err)
+	}
+
+	if err := yaml.UnmarshalStrict(data, &eolBranches); err != nil {
+		return eolBranches, fmt.Errorf("failed to decode end of life branches: %w", err)
+	}
+
+	return eolBranches, nil
+}
+
 // SetAndValidate sets some default options and verifies if options are valid.
 func (o *options) SetAndValidate() error {
 	logrus.Info("Validating options")
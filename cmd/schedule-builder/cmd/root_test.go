@@ -211,6 +211,22 @@ func TestRun(t *testing.T) {
 				require.Equal(t, expectedReleaseOut, string(outFile))
 			},
 		},
+		{
+			name: "should parse successfully-ical type",
+			options: &options{
+				configPath: "testdata/schedule.yaml",
+				typeFile:   "ical",
+			},
+			expect: func(err error, out string) {
+				// checks the error of run func call
+				require.NoError(t, err)
+
+				outFile, errFile := os.ReadFile(out)
+				require.NoError(t, errFile)
+				require.Contains(t, string(outFile), "BEGIN:VCALENDAR\r\n")
+				require.Contains(t, string(outFile), "UID:patch-1.18.4-target-date@schedule-builder.k8s.io\r\n")
+			},
+		},
 		{
 			name: "should fail parsing",
 			options: &options{
@@ -0,0 +1,500 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	yamlv4 "go.yaml.in/yaml/v4"
+
+	"sigs.k8s.io/release-utils/helpers"
+	"sigs.k8s.io/yaml"
+)
+
+const dateTBD = "TBD"
+
+var validateCmd = &cobra.Command{
+	Use:   "validate --config-path path/to/schedule.yaml [--eol-config-path path/to/eol.yaml]",
+	Short: "Check the patch schedule and end of life branches for inconsistencies",
+	Long: `Check the patch schedule and end of life branches for inconsistencies.
+
+The following invariants are verified and all violations are reported
+together with their location in the YAML files:
+
+- dates use the YYYY-MM-DD format ('TBD' is allowed for the end of life
+  and maintenance mode dates)
+- cherry pick deadlines are not after the target date
+- patch releases of a branch do not overlap and are in descending order
+- the next patch release is not already listed in the previous patches
+- patch releases belong to the branch they are listed for
+- the maintenance mode does not start after the end of life date
+- branches past their end of life date are not part of the schedules
+- end of life branches are not part of the schedules
+`,
+	Example:       "schedule-builder validate -c ../website/data/releases/schedule.yaml -e ../website/data/releases/eol.yaml",
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(*cobra.Command, []string) error {
+		return runValidate(opts, time.Now())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+}
+
+// violation is a single inconsistency found in a schedule file.
+type violation struct {
+	File    string
+	Path    string
+	Line    int
+	Column  int
+	Message string
+}
+
+// String returns the violation in the common file:line:column format.
+func (v *violation) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s", v.File, v.Line, v.Column, v.Path, v.Message)
+}
+
+// yamlPath addresses a node in a YAML document using mapping keys (string)
+// and sequence indices (int).
+type yamlPath []any
+
+// String returns the path in the schedules[0].next.targetDate notation.
+func (p yamlPath) String() string {
+	b := &strings.Builder{}
+
+	for _, elem := range p {
+		switch e := elem.(type) {
+		case int:
+			fmt.Fprintf(b, "[%d]", e)
+		default:
+			if b.Len() > 0 {
+				b.WriteString(".")
+			}
+
+			fmt.Fprint(b, e)
+		}
+	}
+
+	return b.String()
+}
+
+// with returns a copy of the path with the elements appended.
+func (p yamlPath) with(elems ...any) yamlPath {
+	return append(slices.Clone(p), elems...)
+}
+
+// validator collects the violations of a single YAML file.
+type validator struct {
+	file       string
+	root       *yamlv4.Node
+	violations []violation
+}
+
+func newValidator(file string, data []byte) (*validator, error) {
+	root := &yamlv4.Node{}
+	if err := yamlv4.Unmarshal(data, root); err != nil {
+		return nil, fmt.Errorf("parsing YAML of %s: %w", file, err)
+	}
+
+	return &validator{file: file, root: root}, nil
+}
+
+// report adds a violation for the node at the path. If the path does not
+// exist, like for omitted fields, the closest existing parent is used as
+// location.
+func (v *validator) report(path yamlPath, format string, args ...any) {
+	line, column := v.position(path)
+
+	v.violations = append(v.violations, violation{
+		File:    v.file,
+		Path:    path.String(),
+		Line:    line,
+		Column:  column,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) position(path yamlPath) (line, column int) {
+	node := v.root
+	if node.Kind == yamlv4.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	for _, elem := range path {
+		next := childNode(node, elem)
+		if next == nil {
+			break
+		}
+
+		node = next
+	}
+
+	return node.Line, node.Column
+}
+
+func childNode(node *yamlv4.Node, elem any) *yamlv4.Node {
+	switch e := elem.(type) {
+	case int:
+		if node.Kind == yamlv4.SequenceNode && e >= 0 && e < len(node.Content) {
+			return node.Content[e]
+		}
+	case string:
+		if node.Kind != yamlv4.MappingNode {
+			return nil
+		}
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == e {
+				return node.Content[i+1]
+			}
+		}
+	}
+
+	return nil
+}
+
+// date parses the date at the path and reports it if invalid. The returned
+// bool is false if no date is available for further checks.
+func (v *validator) date(path yamlPath, value string, allowTBD bool) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		v.report(path, "date is missing")
+
+		return time.Time{}, false
+	}
+
+	if allowTBD && value == dateTBD {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse(refDate, value)
+	if err != nil {
+		v.report(path, "invalid date %q, expected format YYYY-MM-DD", value)
+
+		return time.Time{}, false
+	}
+
+	return date, true
+}
+
+// checkedRelease is a patch release with its parsed values. Values which
+// could not be parsed are zero.
+type checkedRelease struct {
+	path       yamlPath
+	release    string
+	version    *semver.Version
+	cherryPick time.Time
+	target     time.Time
+}
+
+// patchRelease checks a single patch release. The version is only verified
+// if the branch is set.
+func (v *validator) patchRelease(path yamlPath, patch *PatchRelease, branch string, strictDates bool) checkedRelease {
+	r := checkedRelease{path: path, release: patch.Release}
+
+	if branch != "" {
+		version, err := helpers.TagStringToSemver(patch.Release)
+		if err != nil {
+			v.report(path.with("release"), "invalid patch release version %q", patch.Release)
+		} else if fmt.Sprintf("%d.%d", version.Major, version.Minor) != branch {
+			v.report(path.with("release"), "patch release %s does not belong to branch %s", patch.Release, branch)
+		} else {
+			r.version = &version
+		}
+	}
+
+	// Historic previous patches contain notes instead of a cherry pick
+	// deadline, for example for no-op releases.
+	if strictDates {
+		r.cherryPick, _ = v.date(path.with("cherryPickDeadline"), patch.CherryPickDeadline, false)
+	} else if parsed, err := time.Parse(refDate, strings.TrimSpace(patch.CherryPickDeadline)); err == nil {
+		r.cherryPick = parsed
+	}
+
+	r.target, _ = v.date(path.with("targetDate"), patch.TargetDate, false)
+
+	if !r.cherryPick.IsZero() && !r.target.IsZero() && r.cherryPick.After(r.target) {
+		v.report(path.with("cherryPickDeadline"),
+			"cherry pick deadline %s is after the target date %s",
+			r.cherryPick.Format(refDate), r.target.Format(refDate),
+		)
+	}
+
+	return r
+}
+
+// validatePatchSchedule checks the invariants of the patch schedule and
+// the end of life branches. The reference time is used to detect branches
+// which should have been moved to end of life.
+func validatePatchSchedule(
+	refTime time.Time, schedule *PatchSchedule, scheduleValidator *validator,
+	eolBranches *EolBranches, eolValidator *validator,
+) {
+	validateUpcomingReleases(schedule, scheduleValidator)
+
+	supported := map[string]int{}
+
+	for i, sched := range schedule.Schedules {
+		path := yamlPath{"schedules", i}
+
+		if sched == nil {
+			scheduleValidator.report(path, "schedule is empty")
+
+			continue
+		}
+
+		if prev, ok := supported[sched.Release]; ok {
+			scheduleValidator.report(path.with("release"), "branch %s is already listed in schedules[%d]", sched.Release, prev)
+		} else {
+			supported[sched.Release] = i
+		}
+
+		validateBranchSchedule(refTime, path, sched, scheduleValidator)
+	}
+
+	if eolBranches == nil {
+		return
+	}
+
+	seen := map[string]bool{}
+
+	for i, branch := range eolBranches.Branches {
+		path := yamlPath{"branches", i}
+
+		if branch == nil {
+			eolValidator.report(path, "branch is empty")
+
+			continue
+		}
+
+		if seen[branch.Release] {
+			eolValidator.report(path.with("release"), "branch %s is listed more than once", branch.Release)
+		}
+
+		seen[branch.Release] = true
+
+		if idx, ok := supported[branch.Release]; ok {
+			eolValidator.report(path.with("release"),
+				"end of life branch %s is still part of the patch schedule (schedules[%d])", branch.Release, idx,
+			)
+		}
+
+		eolValidator.date(path.with("endOfLifeDate"), branch.EndOfLifeDate, false)
+
+		if branch.FinalPatchRelease != "" {
+			version, err := helpers.TagStringToSemver(branch.FinalPatchRelease)
+			if err != nil {
+				eolValidator.report(path.with("finalPatchRelease"), "invalid patch release version %q", branch.FinalPatchRelease)
+			} else if fmt.Sprintf("%d.%d", version.Major, version.Minor) != branch.Release {
+				eolValidator.report(path.with("finalPatchRelease"),
+					"final patch release %s does not belong to branch %s", branch.FinalPatchRelease, branch.Release,
+				)
+			}
+		}
+	}
+}
+
+func validateUpcomingReleases(schedule *PatchSchedule, v *validator) {
+	var previousTarget time.Time
+
+	for i, upcoming := range schedule.UpcomingReleases {
+		path := yamlPath{"upcoming_releases", i}
+
+		if upcoming == nil {
+			v.report(path, "upcoming release is empty")
+
+			continue
+		}
+
+		r := v.patchRelease(path, upcoming, "", true)
+		if r.target.IsZero() {
+			continue
+		}
+
+		if !previousTarget.IsZero() && !r.cherryPick.IsZero() && !r.cherryPick.After(previousTarget) {
+			v.report(path.with("cherryPickDeadline"),
+				"cherry pick deadline %s is not after the target date %s of the previous upcoming release",
+				r.cherryPick.Format(refDate), previousTarget.Format(refDate),
+			)
+		}
+
+		previousTarget = r.target
+	}
+}
+
+func validateBranchSchedule(refTime time.Time, path yamlPath, sched *Schedule, v *validator) {
+	eol, eolOK := v.date(path.with("endOfLifeDate"), sched.EndOfLifeDate, true)
+	mms, mmsOK := v.date(path.with("maintenanceModeStartDate"), sched.MaintenanceModeStartDate, true)
+
+	if eolOK && mmsOK && mms.After(eol) {
+		v.report(path.with("maintenanceModeStartDate"),
+			"maintenance mode start %s is after the end of life date %s",
+			mms.Format(refDate), eol.Format(refDate),
+		)
+	}
+
+	if eolOK && refTime.After(eol) {
+		v.report(path.with("endOfLifeDate"),
+			"branch %s reached its end of life on %s but is still part of the schedules",
+			sched.Release, eol.Format(refDate),
+		)
+	}
+
+	releases := []checkedRelease{}
+	versions := map[string]yamlPath{}
+
+	if sched.Next == nil {
+		v.report(path.with("next"), "next patch release is missing")
+	} else {
+		releases = append(releases, v.patchRelease(path.with("next"), sched.Next, sched.Release, true))
+		versions[sched.Next.Release] = path.with("next")
+	}
+
+	for i, patch := range sched.PreviousPatches {
+		patchPath := path.with("previousPatches", i)
+
+		if patch == nil {
+			v.report(patchPath, "patch release is empty")
+
+			continue
+		}
+
+		if existing, ok := versions[patch.Release]; ok {
+			if existing.String() == path.with("next").String() {
+				v.report(patchPath.with("release"), "next patch release %s is already listed in the previous patches", patch.Release)
+			} else {
+				v.report(patchPath.with("release"), "patch release %s is already listed in %s", patch.Release, existing)
+			}
+
+			continue
+		}
+
+		versions[patch.Release] = patchPath
+
+		releases = append(releases, v.patchRelease(patchPath, patch, sched.Release, false))
+	}
+
+	// Releases are listed from the newest to the oldest one and a release
+	// cycle has to end before the next one starts.
+	for i := 1; i < len(releases); i++ {
+		newer, older := releases[i-1], releases[i]
+
+		if newer.version != nil && older.version != nil && !older.version.LT(*newer.version) {
+			v.report(older.path.with("release"), "patch release %s is not older than %s", older.release, newer.release)
+		}
+
+		if newer.target.IsZero() || older.target.IsZero() {
+			continue
+		}
+
+		start := newer.cherryPick
+		if start.IsZero() {
+			start = newer.target
+		}
+
+		if !start.After(older.target) {
+			v.report(newer.path, "patch release %s overlaps with %s which targets %s",
+				newer.release, older.release, older.target.Format(refDate),
+			)
+		}
+	}
+}
+
+func runValidate(opts *options, refTime time.Time) error {
+	if opts.configPath == "" {
+		return fmt.Errorf("need to set the '--%s' flag", configPathFlag)
+	}
+
+	logrus.Infof("Reading schedule file: %s", opts.configPath)
+
+	data, err := os.ReadFile(opts.configPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the file: %w", err)
+	}
+
+	var schedule PatchSchedule
+	if err := yaml.UnmarshalStrict(data, &schedule); err != nil {
+		return fmt.Errorf("failed to decode patch schedule: %w", err)
+	}
+
+	scheduleValidator, err := newValidator(opts.configPath, data)
+	if err != nil {
+		return err
+	}
+
+	var (
+		eolBranches  *EolBranches
+		eolValidator *validator
+	)
+
+	if opts.eolConfigPath != "" {
+		logrus.Infof("Reading end of life file: %s", opts.eolConfigPath)
+
+		eolData, err := os.ReadFile(opts.eolConfigPath)
+		if err != nil {
+			return fmt.Errorf("failed to read end of life config path: %w", err)
+		}
+
+		eolBranches = &EolBranches{}
+		if err := yaml.UnmarshalStrict(eolData, eolBranches); err != nil {
+			return fmt.Errorf("failed to decode end of life branches: %w", err)
+		}
+
+		eolValidator, err = newValidator(opts.eolConfigPath, eolData)
+		if err != nil {
+			return err
+		}
+	}
+
+	logrus.Info("Validating schedule")
+	validatePatchSchedule(refTime, &schedule, scheduleValidator, eolBranches, eolValidator)
+
+	violations := scheduleValidator.violations
+	if eolValidator != nil {
+		violations = append(violations, eolValidator.violations...)
+	}
+
+	if len(violations) == 0 {
+		logrus.Info("Schedule is valid")
+
+		return nil
+	}
+
+	slices.SortStableFunc(violations, func(a, b violation) int {
+		return cmp.Or(strings.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line), cmp.Compare(a.Column, b.Column))
+	})
+
+	errs := make([]error, 0, len(violations))
+	for _, v := range violations {
+		errs = append(errs, errors.New(v.String()))
+	}
+
+	return fmt.Errorf("found %d schedule violations:\n%w", len(violations), errors.Join(errs...))
+}
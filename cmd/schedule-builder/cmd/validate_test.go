@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const expectedViolations = `found 10 schedule violations:
+testdata/invalid_eol.yaml:2:14: branches[0].release: end of life branch 1.27 is still part of the patch schedule (schedules[2])
+testdata/invalid_eol.yaml:6:24: branches[1].finalPatchRelease: final patch release 1.25.15 does not belong to branch 1.26
+testdata/invalid_eol.yaml:7:20: branches[1].endOfLifeDate: invalid date "TBD", expected format YYYY-MM-DD
+testdata/invalid_schedule.yaml:4:25: upcoming_releases[1].cherryPickDeadline: cherry pick deadline 2024-06-14 is after the target date 2024-06-11
+testdata/invalid_schedule.yaml:9:7: schedules[0].next: patch release 1.30.1 overlaps with 1.30.0 which targets 2024-05-20
+testdata/invalid_schedule.yaml:15:18: schedules[0].previousPatches[0].release: next patch release 1.30.1 is already listed in the previous patches
+testdata/invalid_schedule.yaml:23:16: schedules[1].next.release: patch release 1.28.5 does not belong to branch 1.29
+testdata/invalid_schedule.yaml:25:19: schedules[1].next.targetDate: invalid date "2024/05/14", expected format YYYY-MM-DD
+testdata/invalid_schedule.yaml:27:31: schedules[1].maintenanceModeStartDate: maintenance mode start 2025-03-28 is after the end of life date 2025-02-28
+testdata/invalid_schedule.yaml:33:20: schedules[2].endOfLifeDate: branch 1.27 reached its end of life on 2024-04-28 but is still part of the schedules`
+
+func TestRunValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		options  *options
+		refTime  time.Time
+		errorMsg string
+	}{
+		{
+			name:    "valid schedule",
+			options: &options{configPath: "testdata/schedule.yaml"},
+			refTime: time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "all violations",
+			options: &options{
+				configPath:    "testdata/invalid_schedule.yaml",
+				eolConfigPath: "testdata/invalid_eol.yaml",
+			},
+			refTime:  time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			errorMsg: expectedViolations,
+		},
+		{
+			name:     "missing config path",
+			options:  &options{},
+			errorMsg: "need to set the '--config-path' flag",
+		},
+		{
+			name:     "undecodable schedule",
+			options:  &options{configPath: "testdata/bad_schedule.yaml"},
+			errorMsg: "failed to decode patch schedule",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := runValidate(tc.options, tc.refTime)
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorContains(t, err, tc.errorMsg)
+		})
+	}
+}
+
+func TestValidateBranchScheduleOrder(t *testing.T) {
+	data := []byte(`schedules:
+- release: "1.30"
+  next:
+    release: 1.30.2
+    cherryPickDeadline: 2024-06-07
+    targetDate: 2024-06-11
+  endOfLifeDate: TBD
+  maintenanceModeStartDate: TBD
+  previousPatches:
+  - release: 1.30.0
+    cherryPickDeadline: 2024-04-12
+    targetDate: 2024-04-17
+  - release: 1.30.1
+    cherryPickDeadline: No-op release
+    targetDate: 2024-05-14
+`)
+
+	v, err := newValidator("schedule.yaml", data)
+	require.NoError(t, err)
+
+	validateBranchSchedule(time.Time{}, yamlPath{"schedules", 0}, &Schedule{
+		Release:                  "1.30",
+		Next:                     &PatchRelease{Release: "1.30.2", CherryPickDeadline: "2024-06-07", TargetDate: "2024-06-11"},
+		EndOfLifeDate:            dateTBD,
+		MaintenanceModeStartDate: dateTBD,
+		PreviousPatches: []*PatchRelease{
+			{Release: "1.30.0", CherryPickDeadline: "2024-04-12", TargetDate: "2024-04-17"},
+			{Release: "1.30.1", CherryPickDeadline: "No-op release", TargetDate: "2024-05-14"},
+		},
+	}, v)
+
+	require.Len(t, v.violations, 2)
+	require.Equal(t,
+		"schedule.yaml:13:14: schedules[0].previousPatches[1].release: patch release 1.30.1 is not older than 1.30.0",
+		v.violations[0].String(),
+	)
+	require.Equal(t,
+		"schedule.yaml:10:5: schedules[0].previousPatches[0]: patch release 1.30.0 overlaps with 1.30.1 which targets 2024-05-14",
+		v.violations[1].String(),
+	)
+}
+
+func TestYAMLPathString(t *testing.T) {
+	require.Equal(t, "schedules[0].next.targetDate", yamlPath{"schedules", 0, "next", "targetDate"}.String())
+	require.Equal(t, "[1]", yamlPath{1}.String())
+	require.Empty(t, yamlPath{}.String())
+}
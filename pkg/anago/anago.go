@@ -85,6 +85,12 @@ type Options struct {
 	// The build version to be released. Has to be specified in the format:
 	// `vX.Y.Z-[alpha|beta|rc].N.C+SHA`
 	BuildVersion string
+
+	// BucketOverride can be used to replace the default production or test
+	// bucket. Besides Google Cloud Storage, it supports local directories
+	// (`file:///path`) and S3 compatible object stores (`s3://bucket`), which
+	// allows rehearsing releases without Google Cloud.
+	BucketOverride string
 }
 
 // DefaultOptions returns a new Options instance.
@@ -98,8 +104,8 @@ func DefaultOptions() *Options {
 // String returns a string representation for the `ReleaseOptions` type.
 func (o *Options) String() string {
 	return fmt.Sprintf(
-		"NoMock: %v, ReleaseType: %q, BuildVersion: %q, ReleaseBranch: %q",
-		o.NoMock, o.ReleaseType, o.BuildVersion, o.ReleaseBranch,
+		"NoMock: %v, ReleaseType: %q, BuildVersion: %q, ReleaseBranch: %q, BucketOverride: %q",
+		o.NoMock, o.ReleaseType, o.BuildVersion, o.ReleaseBranch, o.BucketOverride,
 	)
 }
 
@@ -142,8 +148,12 @@ func (o *Options) ValidateBuildVersion(state *State) error {
 	return nil
 }
 
-// Bucket returns the Google Cloud Bucket for these `Options`.
+// Bucket returns the bucket for these `Options`.
 func (o *Options) Bucket() string {
+	if o.BucketOverride != "" {
+		return o.BucketOverride
+	}
+
 	if o.NoMock {
 		return release.ProductionBucket
 	}
@@ -200,6 +210,9 @@ func (s *State) SetVersions(versions *release.Versions) {
 // StageState holds the release process state.
 type StageState struct {
 	*State
+
+	// checkpoint is the progress of the current stage run.
+	checkpoint *Checkpoint
 }
 
 // DefaultStageState create a new default `StageState`.
@@ -209,21 +222,47 @@ func DefaultStageState() *StageState {
 	}
 }
 
+// SetCheckpoint can be used to set the checkpoint of the current run.
+func (s *StageState) SetCheckpoint(checkpoint *Checkpoint) {
+	s.checkpoint = checkpoint
+}
+
+// Checkpoint returns the checkpoint of the current run.
+func (s *StageState) Checkpoint() *Checkpoint {
+	return s.checkpoint
+}
+
 // StageOptions contains the options for running `Stage`.
 type StageOptions struct {
 	*Options
+
+	// CheckpointFile is the path to the JSON file which records the progress
+	// of the stage run after each successful step.
+	CheckpointFile string
+
+	// Resume indicates that a previous run should be continued from the
+	// CheckpointFile by skipping all completed steps.
+	Resume bool
+
+	// MapProviders are additional release notes map locations used for
+	// generating the changelog, for example `gs://bucket/path`.
+	MapProviders []string
 }
 
 // DefaultStageOptions create a new default `StageOptions`.
 func DefaultStageOptions() *StageOptions {
 	return &StageOptions{
-		Options: DefaultOptions(),
+		Options:        DefaultOptions(),
+		CheckpointFile: checkpointFile,
 	}
 }
 
 // String returns a string representation for the `StageOptions` type.
 func (s *StageOptions) String() string {
-	return s.Options.String()
+	return fmt.Sprintf(
+		"%s, CheckpointFile: %q, Resume: %v, MapProviders: %v",
+		s.Options.String(), s.CheckpointFile, s.Resume, s.MapProviders,
+	)
 }
 
 // Validate if the options are correctly set.
@@ -240,6 +279,10 @@ func (s *StageOptions) Validate(state *State) error {
 		}
 	}
 
+	if s.Resume && s.CheckpointFile == "" {
+		return errors.New("resuming requires a checkpoint file")
+	}
+
 	return nil
 }
 
@@ -288,68 +331,92 @@ func (s *Stage) Run() error {
 		return fmt.Errorf("validate options: %w", err)
 	}
 
-	logger.WithStep().Info("Checking prerequisites")
-
-	if err := s.client.CheckPrerequisites(); err != nil {
-		return fmt.Errorf("check prerequisites: %w", err)
-	}
-
-	logger.WithStep().Info("Checking release branch state")
-
-	if err := s.client.CheckReleaseBranchState(); err != nil {
-		return fmt.Errorf("check release branch state: %w", err)
-	}
-
-	logger.WithStep().Info("Generating release version")
-
-	if err := s.client.GenerateReleaseVersion(); err != nil {
-		return fmt.Errorf("generate release version: %w", err)
-	}
-
-	logger.WithStep().Info("Preparing workspace")
-
-	if err := s.client.PrepareWorkspace(); err != nil {
-		return fmt.Errorf("prepare workspace: %w", err)
-	}
-
-	logger.WithStep().Info("Tagging repository")
-
-	if err := s.client.TagRepository(); err != nil {
-		return fmt.Errorf("tag repository: %w", err)
-	}
-
-	logger.WithStep().Info("Building release")
+	logger.WithStep().Info("Loading checkpoint")
 
-	if err := s.client.Build(); err != nil {
-		return fmt.Errorf("build release: %w", err)
+	if err := s.client.LoadCheckpoint(); err != nil {
+		return fmt.Errorf("load checkpoint: %w", err)
 	}
 
-	logger.WithStep().Info("Generating changelog")
+	logger.WithStep().Info("Checking prerequisites")
 
-	if err := s.client.GenerateChangelog(); err != nil {
-		return fmt.Errorf("generate changelog: %w", err)
+	if err := s.client.CheckPrerequisites(); err != nil {
+		return fmt.Errorf("check prerequisites: %w", err)
 	}
 
-	logger.WithStep().Info("Verifying artifacts and generating bill of materials")
-
-	g := new(errgroup.Group)
-
-	g.Go(func() error {
-		return s.client.VerifyArtifacts()
-	})
+	for _, step := range []struct {
+		name, msg, action string
+		run               func() error
+	}{
+		{
+			name:   StepCheckReleaseBranchState,
+			msg:    "Checking release branch state",
+			action: "check release branch state",
+			run:    s.client.CheckReleaseBranchState,
+		},
+		{
+			name:   StepGenerateReleaseVersion,
+			msg:    "Generating release version",
+			action: "generate release version",
+			run:    s.client.GenerateReleaseVersion,
+		},
+		{
+			name:   StepPrepareWorkspace,
+			msg:    "Preparing workspace",
+			action: "prepare workspace",
+			run:    s.client.PrepareWorkspace,
+		},
+		{
+			name:   StepTagRepository,
+			msg:    "Tagging repository",
+			action: "tag repository",
+			run:    s.client.TagRepository,
+		},
+		{
+			name:   StepBuild,
+			msg:    "Building release",
+			action: "build release",
+			run:    s.client.Build,
+		},
+		{
+			name:   StepGenerateChangelog,
+			msg:    "Generating changelog",
+			action: "generate changelog",
+			run:    s.client.GenerateChangelog,
+		},
+		{
+			name:   StepVerifyArtifactsAndBOM,
+			msg:    "Verifying artifacts and generating bill of materials",
+			action: "verify artifacts and generate bill of materials",
+			run: func() error {
+				g := new(errgroup.Group)
+				g.Go(s.client.VerifyArtifacts)
+				g.Go(s.client.GenerateBillOfMaterials)
+
+				return g.Wait()
+			},
+		},
+		{
+			name:   StepStageArtifacts,
+			msg:    "Staging artifacts",
+			action: "stage release artifacts",
+			run:    s.client.StageArtifacts,
+		},
+	} {
+		if s.client.IsStepCompleted(step.name) {
+			logger.WithStep().Infof("%s: already completed, skipping", step.msg)
+
+			continue
+		}
 
-	g.Go(func() error {
-		return s.client.GenerateBillOfMaterials()
-	})
+		logger.WithStep().Info(step.msg)
 
-	if err := g.Wait(); err != nil {
-		return err
-	}
-
-	logger.WithStep().Info("Staging artifacts")
+		if err := step.run(); err != nil {
+			return fmt.Errorf("%s: %w", step.action, err)
+		}
 
-	if err := s.client.StageArtifacts(); err != nil {
-		return fmt.Errorf("stage release artifacts: %w", err)
+		if err := s.client.SaveCheckpoint(step.name); err != nil {
+			return fmt.Errorf("save checkpoint after %s: %w", step.action, err)
+		}
 	}
 
 	logger.Info("Stage done")
@@ -372,12 +439,17 @@ func DefaultReleaseState() *ReleaseState {
 // ReleaseOptions contains the options for running `Release`.
 type ReleaseOptions struct {
 	*Options
+
+	// ImageVerificationPolicy defines which container image signatures are
+	// accepted when validating the released images.
+	ImageVerificationPolicy *release.ImageVerificationPolicy
 }
 
 // DefaultReleaseOptions create a new default `ReleaseOptions`.
 func DefaultReleaseOptions() *ReleaseOptions {
 	return &ReleaseOptions{
-		Options: DefaultOptions(),
+		Options:                 DefaultOptions(),
+		ImageVerificationPolicy: release.DefaultImageVerificationPolicy(),
 	}
 }
 
@@ -396,6 +468,10 @@ func (r *ReleaseOptions) Validate(state *State) error {
 		return fmt.Errorf("validating build version: %w", err)
 	}
 
+	if err := r.ImageVerificationPolicy.Validate(); err != nil {
+		return fmt.Errorf("validating image verification policy: %w", err)
+	}
+
 	return nil
 }
 
@@ -116,6 +116,28 @@ func TestRunStage(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{ // LoadCheckpoint fails
+			prepare: func(mock *anagofakes.FakeStageClient) {
+				mock.LoadCheckpointReturns(err)
+			},
+			shouldError: true,
+		},
+		{ // SaveCheckpoint fails
+			prepare: func(mock *anagofakes.FakeStageClient) {
+				mockGenerateReleaseVersionStage(mock)
+				mock.SaveCheckpointReturns(err)
+			},
+			shouldError: true,
+		},
+		{ // completed steps are skipped
+			prepare: func(mock *anagofakes.FakeStageClient) {
+				mock.IsStepCompletedCalls(func(step string) bool {
+					return step != anago.StepStageArtifacts
+				})
+				mock.BuildReturns(err)
+			},
+			shouldError: false,
+		},
 	} {
 		opts := anago.DefaultStageOptions()
 		sut := anago.NewStage(opts)
@@ -293,7 +315,7 @@ func TestStagingOptionsValidate(t *testing.T) {
 	}{
 		{ // valid build version should validate
 			provided: &anago.StageOptions{
-				&anago.Options{
+				Options: &anago.Options{
 					ReleaseType:   release.ReleaseTypeAlpha,
 					ReleaseBranch: git.DefaultBranch,
 					BuildVersion:  "v1.20.0-beta.1.203+8f6ffb24df9896",
@@ -303,7 +325,7 @@ func TestStagingOptionsValidate(t *testing.T) {
 		},
 		{ // empty build version should validate
 			provided: &anago.StageOptions{
-				&anago.Options{
+				Options: &anago.Options{
 					ReleaseType:   release.ReleaseTypeAlpha,
 					ReleaseBranch: git.DefaultBranch,
 				},
@@ -312,7 +334,7 @@ func TestStagingOptionsValidate(t *testing.T) {
 		},
 		{ // invalid build version should not validate
 			provided: &anago.StageOptions{
-				&anago.Options{
+				Options: &anago.Options{
 					ReleaseType:   release.ReleaseTypeAlpha,
 					ReleaseBranch: git.DefaultBranch,
 					BuildVersion:  "decaf-bad",
@@ -320,6 +342,16 @@ func TestStagingOptionsValidate(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{ // resume without checkpoint file should not validate
+			provided: &anago.StageOptions{
+				Options: &anago.Options{
+					ReleaseType:   release.ReleaseTypeAlpha,
+					ReleaseBranch: git.DefaultBranch,
+				},
+				Resume: true,
+			},
+			shouldError: true,
+		},
 	} {
 		state := anago.DefaultState()
 
@@ -45,9 +45,10 @@ type FakeReleaseImpl struct {
 		result1 bool
 		result2 error
 	}
-	CheckPrerequisitesStub        func() error
+	CheckPrerequisitesStub        func(string) error
 	checkPrerequisitesMutex       sync.RWMutex
 	checkPrerequisitesArgsForCall []struct {
+		arg1 string
 	}
 	checkPrerequisitesReturns struct {
 		result1 error
@@ -281,12 +282,13 @@ type FakeReleaseImpl struct {
 	updateGitHubPageReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ValidateImagesStub        func(string, string, string) error
+	ValidateImagesStub        func(string, string, string, *release.ImageVerificationPolicy) error
 	validateImagesMutex       sync.RWMutex
 	validateImagesArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 string
+		arg4 *release.ImageVerificationPolicy
 	}
 	validateImagesReturns struct {
 		result1 error
@@ -364,17 +366,18 @@ func (fake *FakeReleaseImpl) BranchNeedsCreationReturnsOnCall(i int, result1 boo
 	}{result1, result2}
 }
 
-func (fake *FakeReleaseImpl) CheckPrerequisites() error {
+func (fake *FakeReleaseImpl) CheckPrerequisites(arg1 string) error {
 	fake.checkPrerequisitesMutex.Lock()
 	ret, specificReturn := fake.checkPrerequisitesReturnsOnCall[len(fake.checkPrerequisitesArgsForCall)]
 	fake.checkPrerequisitesArgsForCall = append(fake.checkPrerequisitesArgsForCall, struct {
-	}{})
+		arg1 string
+	}{arg1})
 	stub := fake.CheckPrerequisitesStub
 	fakeReturns := fake.checkPrerequisitesReturns
-	fake.recordInvocation("CheckPrerequisites", []interface{}{})
+	fake.recordInvocation("CheckPrerequisites", []interface{}{arg1})
 	fake.checkPrerequisitesMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
@@ -388,12 +391,19 @@ func (fake *FakeReleaseImpl) CheckPrerequisitesCallCount() int {
 	return len(fake.checkPrerequisitesArgsForCall)
 }
 
-func (fake *FakeReleaseImpl) CheckPrerequisitesCalls(stub func() error) {
+func (fake *FakeReleaseImpl) CheckPrerequisitesCalls(stub func(string) error) {
 	fake.checkPrerequisitesMutex.Lock()
 	defer fake.checkPrerequisitesMutex.Unlock()
 	fake.CheckPrerequisitesStub = stub
 }
 
+func (fake *FakeReleaseImpl) CheckPrerequisitesArgsForCall(i int) string {
+	fake.checkPrerequisitesMutex.RLock()
+	defer fake.checkPrerequisitesMutex.RUnlock()
+	argsForCall := fake.checkPrerequisitesArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeReleaseImpl) CheckPrerequisitesReturns(result1 error) {
 	fake.checkPrerequisitesMutex.Lock()
 	defer fake.checkPrerequisitesMutex.Unlock()
@@ -1561,20 +1571,21 @@ func (fake *FakeReleaseImpl) UpdateGitHubPageReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
-func (fake *FakeReleaseImpl) ValidateImages(arg1 string, arg2 string, arg3 string) error {
+func (fake *FakeReleaseImpl) ValidateImages(arg1 string, arg2 string, arg3 string, arg4 *release.ImageVerificationPolicy) error {
 	fake.validateImagesMutex.Lock()
 	ret, specificReturn := fake.validateImagesReturnsOnCall[len(fake.validateImagesArgsForCall)]
 	fake.validateImagesArgsForCall = append(fake.validateImagesArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 string
-	}{arg1, arg2, arg3})
+		arg4 *release.ImageVerificationPolicy
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.ValidateImagesStub
 	fakeReturns := fake.validateImagesReturns
-	fake.recordInvocation("ValidateImages", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("ValidateImages", []interface{}{arg1, arg2, arg3, arg4})
 	fake.validateImagesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1588,17 +1599,17 @@ func (fake *FakeReleaseImpl) ValidateImagesCallCount() int {
 	return len(fake.validateImagesArgsForCall)
 }
 
-func (fake *FakeReleaseImpl) ValidateImagesCalls(stub func(string, string, string) error) {
+func (fake *FakeReleaseImpl) ValidateImagesCalls(stub func(string, string, string, *release.ImageVerificationPolicy) error) {
 	fake.validateImagesMutex.Lock()
 	defer fake.validateImagesMutex.Unlock()
 	fake.ValidateImagesStub = stub
 }
 
-func (fake *FakeReleaseImpl) ValidateImagesArgsForCall(i int) (string, string, string) {
+func (fake *FakeReleaseImpl) ValidateImagesArgsForCall(i int) (string, string, string, *release.ImageVerificationPolicy) {
 	fake.validateImagesMutex.RLock()
 	defer fake.validateImagesMutex.RUnlock()
 	argsForCall := fake.validateImagesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeReleaseImpl) ValidateImagesReturns(result1 error) {
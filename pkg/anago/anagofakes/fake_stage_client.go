@@ -96,6 +96,27 @@ type FakeStageClient struct {
 	initStateMutex       sync.RWMutex
 	initStateArgsForCall []struct {
 	}
+	IsStepCompletedStub        func(string) bool
+	isStepCompletedMutex       sync.RWMutex
+	isStepCompletedArgsForCall []struct {
+		arg1 string
+	}
+	isStepCompletedReturns struct {
+		result1 bool
+	}
+	isStepCompletedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	LoadCheckpointStub        func() error
+	loadCheckpointMutex       sync.RWMutex
+	loadCheckpointArgsForCall []struct {
+	}
+	loadCheckpointReturns struct {
+		result1 error
+	}
+	loadCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	PrepareWorkspaceStub        func() error
 	prepareWorkspaceMutex       sync.RWMutex
 	prepareWorkspaceArgsForCall []struct {
@@ -106,6 +127,17 @@ type FakeStageClient struct {
 	prepareWorkspaceReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveCheckpointStub        func(string) error
+	saveCheckpointMutex       sync.RWMutex
+	saveCheckpointArgsForCall []struct {
+		arg1 string
+	}
+	saveCheckpointReturns struct {
+		result1 error
+	}
+	saveCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StageArtifactsStub        func() error
 	stageArtifactsMutex       sync.RWMutex
 	stageArtifactsArgsForCall []struct {
@@ -556,6 +588,120 @@ func (fake *FakeStageClient) InitStateCalls(stub func()) {
 	fake.InitStateStub = stub
 }
 
+func (fake *FakeStageClient) IsStepCompleted(arg1 string) bool {
+	fake.isStepCompletedMutex.Lock()
+	ret, specificReturn := fake.isStepCompletedReturnsOnCall[len(fake.isStepCompletedArgsForCall)]
+	fake.isStepCompletedArgsForCall = append(fake.isStepCompletedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IsStepCompletedStub
+	fakeReturns := fake.isStepCompletedReturns
+	fake.recordInvocation("IsStepCompleted", []interface{}{arg1})
+	fake.isStepCompletedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageClient) IsStepCompletedCallCount() int {
+	fake.isStepCompletedMutex.RLock()
+	defer fake.isStepCompletedMutex.RUnlock()
+	return len(fake.isStepCompletedArgsForCall)
+}
+
+func (fake *FakeStageClient) IsStepCompletedCalls(stub func(string) bool) {
+	fake.isStepCompletedMutex.Lock()
+	defer fake.isStepCompletedMutex.Unlock()
+	fake.IsStepCompletedStub = stub
+}
+
+func (fake *FakeStageClient) IsStepCompletedArgsForCall(i int) string {
+	fake.isStepCompletedMutex.RLock()
+	defer fake.isStepCompletedMutex.RUnlock()
+	argsForCall := fake.isStepCompletedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStageClient) IsStepCompletedReturns(result1 bool) {
+	fake.isStepCompletedMutex.Lock()
+	defer fake.isStepCompletedMutex.Unlock()
+	fake.IsStepCompletedStub = nil
+	fake.isStepCompletedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeStageClient) IsStepCompletedReturnsOnCall(i int, result1 bool) {
+	fake.isStepCompletedMutex.Lock()
+	defer fake.isStepCompletedMutex.Unlock()
+	fake.IsStepCompletedStub = nil
+	if fake.isStepCompletedReturnsOnCall == nil {
+		fake.isStepCompletedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isStepCompletedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeStageClient) LoadCheckpoint() error {
+	fake.loadCheckpointMutex.Lock()
+	ret, specificReturn := fake.loadCheckpointReturnsOnCall[len(fake.loadCheckpointArgsForCall)]
+	fake.loadCheckpointArgsForCall = append(fake.loadCheckpointArgsForCall, struct {
+	}{})
+	stub := fake.LoadCheckpointStub
+	fakeReturns := fake.loadCheckpointReturns
+	fake.recordInvocation("LoadCheckpoint", []interface{}{})
+	fake.loadCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageClient) LoadCheckpointCallCount() int {
+	fake.loadCheckpointMutex.RLock()
+	defer fake.loadCheckpointMutex.RUnlock()
+	return len(fake.loadCheckpointArgsForCall)
+}
+
+func (fake *FakeStageClient) LoadCheckpointCalls(stub func() error) {
+	fake.loadCheckpointMutex.Lock()
+	defer fake.loadCheckpointMutex.Unlock()
+	fake.LoadCheckpointStub = stub
+}
+
+func (fake *FakeStageClient) LoadCheckpointReturns(result1 error) {
+	fake.loadCheckpointMutex.Lock()
+	defer fake.loadCheckpointMutex.Unlock()
+	fake.LoadCheckpointStub = nil
+	fake.loadCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageClient) LoadCheckpointReturnsOnCall(i int, result1 error) {
+	fake.loadCheckpointMutex.Lock()
+	defer fake.loadCheckpointMutex.Unlock()
+	fake.LoadCheckpointStub = nil
+	if fake.loadCheckpointReturnsOnCall == nil {
+		fake.loadCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.loadCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStageClient) PrepareWorkspace() error {
 	fake.prepareWorkspaceMutex.Lock()
 	ret, specificReturn := fake.prepareWorkspaceReturnsOnCall[len(fake.prepareWorkspaceArgsForCall)]
@@ -609,6 +755,67 @@ func (fake *FakeStageClient) PrepareWorkspaceReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeStageClient) SaveCheckpoint(arg1 string) error {
+	fake.saveCheckpointMutex.Lock()
+	ret, specificReturn := fake.saveCheckpointReturnsOnCall[len(fake.saveCheckpointArgsForCall)]
+	fake.saveCheckpointArgsForCall = append(fake.saveCheckpointArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SaveCheckpointStub
+	fakeReturns := fake.saveCheckpointReturns
+	fake.recordInvocation("SaveCheckpoint", []interface{}{arg1})
+	fake.saveCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageClient) SaveCheckpointCallCount() int {
+	fake.saveCheckpointMutex.RLock()
+	defer fake.saveCheckpointMutex.RUnlock()
+	return len(fake.saveCheckpointArgsForCall)
+}
+
+func (fake *FakeStageClient) SaveCheckpointCalls(stub func(string) error) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = stub
+}
+
+func (fake *FakeStageClient) SaveCheckpointArgsForCall(i int) string {
+	fake.saveCheckpointMutex.RLock()
+	defer fake.saveCheckpointMutex.RUnlock()
+	argsForCall := fake.saveCheckpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStageClient) SaveCheckpointReturns(result1 error) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = nil
+	fake.saveCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageClient) SaveCheckpointReturnsOnCall(i int, result1 error) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = nil
+	if fake.saveCheckpointReturnsOnCall == nil {
+		fake.saveCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStageClient) StageArtifacts() error {
 	fake.stageArtifactsMutex.Lock()
 	ret, specificReturn := fake.stageArtifactsReturnsOnCall[len(fake.stageArtifactsArgsForCall)]
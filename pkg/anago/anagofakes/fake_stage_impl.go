@@ -85,9 +85,21 @@ type FakeStageImpl struct {
 		result1 *spdx.Document
 		result2 error
 	}
-	CheckPrerequisitesStub        func() error
+	ChdirStub        func(string) error
+	chdirMutex       sync.RWMutex
+	chdirArgsForCall []struct {
+		arg1 string
+	}
+	chdirReturns struct {
+		result1 error
+	}
+	chdirReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CheckPrerequisitesStub        func(string) error
 	checkPrerequisitesMutex       sync.RWMutex
 	checkPrerequisitesArgsForCall []struct {
+		arg1 string
 	}
 	checkPrerequisitesReturns struct {
 		result1 error
@@ -415,6 +427,19 @@ type FakeStageImpl struct {
 	pushReleaseArtifactsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReadCheckpointStub        func(string) (*anago.Checkpoint, error)
+	readCheckpointMutex       sync.RWMutex
+	readCheckpointArgsForCall []struct {
+		arg1 string
+	}
+	readCheckpointReturns struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}
+	readCheckpointReturnsOnCall map[int]struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}
 	RevParseStub        func(*git.Repo, string) (string, error)
 	revParseMutex       sync.RWMutex
 	revParseArgsForCall []struct {
@@ -513,6 +538,30 @@ type FakeStageImpl struct {
 	verifyArtifactsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WriteCheckpointStub        func(*anago.Checkpoint, string) error
+	writeCheckpointMutex       sync.RWMutex
+	writeCheckpointArgsForCall []struct {
+		arg1 *anago.Checkpoint
+		arg2 string
+	}
+	writeCheckpointReturns struct {
+		result1 error
+	}
+	writeCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteCycloneDXBOMStub        func(*spdx.Document, string) error
+	writeCycloneDXBOMMutex       sync.RWMutex
+	writeCycloneDXBOMArgsForCall []struct {
+		arg1 *spdx.Document
+		arg2 string
+	}
+	writeCycloneDXBOMReturns struct {
+		result1 error
+	}
+	writeCycloneDXBOMReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WriteSourceBOMStub        func(*spdx.Document, string) error
 	writeSourceBOMMutex       sync.RWMutex
 	writeSourceBOMArgsForCall []struct {
@@ -783,17 +832,79 @@ func (fake *FakeStageImpl) BuildBaseArtifactsSBOMReturnsOnCall(i int, result1 *s
 	}{result1, result2}
 }
 
-func (fake *FakeStageImpl) CheckPrerequisites() error {
+func (fake *FakeStageImpl) Chdir(arg1 string) error {
+	fake.chdirMutex.Lock()
+	ret, specificReturn := fake.chdirReturnsOnCall[len(fake.chdirArgsForCall)]
+	fake.chdirArgsForCall = append(fake.chdirArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ChdirStub
+	fakeReturns := fake.chdirReturns
+	fake.recordInvocation("Chdir", []interface{}{arg1})
+	fake.chdirMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageImpl) ChdirCallCount() int {
+	fake.chdirMutex.RLock()
+	defer fake.chdirMutex.RUnlock()
+	return len(fake.chdirArgsForCall)
+}
+
+func (fake *FakeStageImpl) ChdirCalls(stub func(string) error) {
+	fake.chdirMutex.Lock()
+	defer fake.chdirMutex.Unlock()
+	fake.ChdirStub = stub
+}
+
+func (fake *FakeStageImpl) ChdirArgsForCall(i int) string {
+	fake.chdirMutex.RLock()
+	defer fake.chdirMutex.RUnlock()
+	argsForCall := fake.chdirArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStageImpl) ChdirReturns(result1 error) {
+	fake.chdirMutex.Lock()
+	defer fake.chdirMutex.Unlock()
+	fake.ChdirStub = nil
+	fake.chdirReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageImpl) ChdirReturnsOnCall(i int, result1 error) {
+	fake.chdirMutex.Lock()
+	defer fake.chdirMutex.Unlock()
+	fake.ChdirStub = nil
+	if fake.chdirReturnsOnCall == nil {
+		fake.chdirReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.chdirReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageImpl) CheckPrerequisites(arg1 string) error {
 	fake.checkPrerequisitesMutex.Lock()
 	ret, specificReturn := fake.checkPrerequisitesReturnsOnCall[len(fake.checkPrerequisitesArgsForCall)]
 	fake.checkPrerequisitesArgsForCall = append(fake.checkPrerequisitesArgsForCall, struct {
-	}{})
+		arg1 string
+	}{arg1})
 	stub := fake.CheckPrerequisitesStub
 	fakeReturns := fake.checkPrerequisitesReturns
-	fake.recordInvocation("CheckPrerequisites", []interface{}{})
+	fake.recordInvocation("CheckPrerequisites", []interface{}{arg1})
 	fake.checkPrerequisitesMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
@@ -807,12 +918,19 @@ func (fake *FakeStageImpl) CheckPrerequisitesCallCount() int {
 	return len(fake.checkPrerequisitesArgsForCall)
 }
 
-func (fake *FakeStageImpl) CheckPrerequisitesCalls(stub func() error) {
+func (fake *FakeStageImpl) CheckPrerequisitesCalls(stub func(string) error) {
 	fake.checkPrerequisitesMutex.Lock()
 	defer fake.checkPrerequisitesMutex.Unlock()
 	fake.CheckPrerequisitesStub = stub
 }
 
+func (fake *FakeStageImpl) CheckPrerequisitesArgsForCall(i int) string {
+	fake.checkPrerequisitesMutex.RLock()
+	defer fake.checkPrerequisitesMutex.RUnlock()
+	argsForCall := fake.checkPrerequisitesArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeStageImpl) CheckPrerequisitesReturns(result1 error) {
 	fake.checkPrerequisitesMutex.Lock()
 	defer fake.checkPrerequisitesMutex.Unlock()
@@ -2418,6 +2536,70 @@ func (fake *FakeStageImpl) PushReleaseArtifactsReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
+func (fake *FakeStageImpl) ReadCheckpoint(arg1 string) (*anago.Checkpoint, error) {
+	fake.readCheckpointMutex.Lock()
+	ret, specificReturn := fake.readCheckpointReturnsOnCall[len(fake.readCheckpointArgsForCall)]
+	fake.readCheckpointArgsForCall = append(fake.readCheckpointArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadCheckpointStub
+	fakeReturns := fake.readCheckpointReturns
+	fake.recordInvocation("ReadCheckpoint", []interface{}{arg1})
+	fake.readCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStageImpl) ReadCheckpointCallCount() int {
+	fake.readCheckpointMutex.RLock()
+	defer fake.readCheckpointMutex.RUnlock()
+	return len(fake.readCheckpointArgsForCall)
+}
+
+func (fake *FakeStageImpl) ReadCheckpointCalls(stub func(string) (*anago.Checkpoint, error)) {
+	fake.readCheckpointMutex.Lock()
+	defer fake.readCheckpointMutex.Unlock()
+	fake.ReadCheckpointStub = stub
+}
+
+func (fake *FakeStageImpl) ReadCheckpointArgsForCall(i int) string {
+	fake.readCheckpointMutex.RLock()
+	defer fake.readCheckpointMutex.RUnlock()
+	argsForCall := fake.readCheckpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStageImpl) ReadCheckpointReturns(result1 *anago.Checkpoint, result2 error) {
+	fake.readCheckpointMutex.Lock()
+	defer fake.readCheckpointMutex.Unlock()
+	fake.ReadCheckpointStub = nil
+	fake.readCheckpointReturns = struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStageImpl) ReadCheckpointReturnsOnCall(i int, result1 *anago.Checkpoint, result2 error) {
+	fake.readCheckpointMutex.Lock()
+	defer fake.readCheckpointMutex.Unlock()
+	fake.ReadCheckpointStub = nil
+	if fake.readCheckpointReturnsOnCall == nil {
+		fake.readCheckpointReturnsOnCall = make(map[int]struct {
+			result1 *anago.Checkpoint
+			result2 error
+		})
+	}
+	fake.readCheckpointReturnsOnCall[i] = struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStageImpl) RevParse(arg1 *git.Repo, arg2 string) (string, error) {
 	fake.revParseMutex.Lock()
 	ret, specificReturn := fake.revParseReturnsOnCall[len(fake.revParseArgsForCall)]
@@ -2923,6 +3105,130 @@ func (fake *FakeStageImpl) VerifyArtifactsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStageImpl) WriteCheckpoint(arg1 *anago.Checkpoint, arg2 string) error {
+	fake.writeCheckpointMutex.Lock()
+	ret, specificReturn := fake.writeCheckpointReturnsOnCall[len(fake.writeCheckpointArgsForCall)]
+	fake.writeCheckpointArgsForCall = append(fake.writeCheckpointArgsForCall, struct {
+		arg1 *anago.Checkpoint
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.WriteCheckpointStub
+	fakeReturns := fake.writeCheckpointReturns
+	fake.recordInvocation("WriteCheckpoint", []interface{}{arg1, arg2})
+	fake.writeCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageImpl) WriteCheckpointCallCount() int {
+	fake.writeCheckpointMutex.RLock()
+	defer fake.writeCheckpointMutex.RUnlock()
+	return len(fake.writeCheckpointArgsForCall)
+}
+
+func (fake *FakeStageImpl) WriteCheckpointCalls(stub func(*anago.Checkpoint, string) error) {
+	fake.writeCheckpointMutex.Lock()
+	defer fake.writeCheckpointMutex.Unlock()
+	fake.WriteCheckpointStub = stub
+}
+
+func (fake *FakeStageImpl) WriteCheckpointArgsForCall(i int) (*anago.Checkpoint, string) {
+	fake.writeCheckpointMutex.RLock()
+	defer fake.writeCheckpointMutex.RUnlock()
+	argsForCall := fake.writeCheckpointArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStageImpl) WriteCheckpointReturns(result1 error) {
+	fake.writeCheckpointMutex.Lock()
+	defer fake.writeCheckpointMutex.Unlock()
+	fake.WriteCheckpointStub = nil
+	fake.writeCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageImpl) WriteCheckpointReturnsOnCall(i int, result1 error) {
+	fake.writeCheckpointMutex.Lock()
+	defer fake.writeCheckpointMutex.Unlock()
+	fake.WriteCheckpointStub = nil
+	if fake.writeCheckpointReturnsOnCall == nil {
+		fake.writeCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageImpl) WriteCycloneDXBOM(arg1 *spdx.Document, arg2 string) error {
+	fake.writeCycloneDXBOMMutex.Lock()
+	ret, specificReturn := fake.writeCycloneDXBOMReturnsOnCall[len(fake.writeCycloneDXBOMArgsForCall)]
+	fake.writeCycloneDXBOMArgsForCall = append(fake.writeCycloneDXBOMArgsForCall, struct {
+		arg1 *spdx.Document
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.WriteCycloneDXBOMStub
+	fakeReturns := fake.writeCycloneDXBOMReturns
+	fake.recordInvocation("WriteCycloneDXBOM", []interface{}{arg1, arg2})
+	fake.writeCycloneDXBOMMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageImpl) WriteCycloneDXBOMCallCount() int {
+	fake.writeCycloneDXBOMMutex.RLock()
+	defer fake.writeCycloneDXBOMMutex.RUnlock()
+	return len(fake.writeCycloneDXBOMArgsForCall)
+}
+
+func (fake *FakeStageImpl) WriteCycloneDXBOMCalls(stub func(*spdx.Document, string) error) {
+	fake.writeCycloneDXBOMMutex.Lock()
+	defer fake.writeCycloneDXBOMMutex.Unlock()
+	fake.WriteCycloneDXBOMStub = stub
+}
+
+func (fake *FakeStageImpl) WriteCycloneDXBOMArgsForCall(i int) (*spdx.Document, string) {
+	fake.writeCycloneDXBOMMutex.RLock()
+	defer fake.writeCycloneDXBOMMutex.RUnlock()
+	argsForCall := fake.writeCycloneDXBOMArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStageImpl) WriteCycloneDXBOMReturns(result1 error) {
+	fake.writeCycloneDXBOMMutex.Lock()
+	defer fake.writeCycloneDXBOMMutex.Unlock()
+	fake.WriteCycloneDXBOMStub = nil
+	fake.writeCycloneDXBOMReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageImpl) WriteCycloneDXBOMReturnsOnCall(i int, result1 error) {
+	fake.writeCycloneDXBOMMutex.Lock()
+	defer fake.writeCycloneDXBOMMutex.Unlock()
+	fake.WriteCycloneDXBOMStub = nil
+	if fake.writeCycloneDXBOMReturnsOnCall == nil {
+		fake.writeCycloneDXBOMReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeCycloneDXBOMReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStageImpl) WriteSourceBOM(arg1 *spdx.Document, arg2 string) error {
 	fake.writeSourceBOMMutex.Lock()
 	ret, specificReturn := fake.writeSourceBOMReturnsOnCall[len(fake.writeSourceBOMArgsForCall)]
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anago
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"k8s.io/release/pkg/release"
+)
+
+// The names of the resumable stage steps as recorded in a `Checkpoint`.
+const (
+	StepCheckReleaseBranchState = "CheckReleaseBranchState"
+	StepGenerateReleaseVersion  = "GenerateReleaseVersion"
+	StepPrepareWorkspace        = "PrepareWorkspace"
+	StepTagRepository           = "TagRepository"
+	StepBuild                   = "Build"
+	StepGenerateChangelog       = "GenerateChangelog"
+	StepVerifyArtifactsAndBOM   = "VerifyArtifactsAndBOM"
+	StepStageArtifacts          = "StageArtifacts"
+)
+
+// checkpointFile is the default location of the stage checkpoint.
+var checkpointFile = filepath.Join(os.TempDir(), "stage-checkpoint.json")
+
+// Checkpoint is the serializable progress of a stage run. It gets written
+// after each successful step and can be used to resume a failed run.
+type Checkpoint struct {
+	// BuildVersion is the build version of the checkpointed run.
+	BuildVersion string `json:"buildVersion"`
+
+	// ReleaseType is the release type of the checkpointed run.
+	ReleaseType string `json:"releaseType"`
+
+	// ReleaseBranch is the release branch of the checkpointed run.
+	ReleaseBranch string `json:"releaseBranch"`
+
+	// NoMock indicates if the checkpointed run was a production run.
+	NoMock bool `json:"noMock"`
+
+	// CreateReleaseBranch is the result of `CheckReleaseBranchState`.
+	CreateReleaseBranch bool `json:"createReleaseBranch"`
+
+	// Versions are the release versions from `GenerateReleaseVersion`.
+	Versions *CheckpointVersions `json:"versions,omitempty"`
+
+	// WorkspaceCommit is the HEAD commit of the Kubernetes repository when
+	// the last step finished. It is empty until the workspace got prepared.
+	WorkspaceCommit string `json:"workspaceCommit,omitempty"`
+
+	// CompletedSteps contains the names of all successfully finished steps.
+	CompletedSteps []string `json:"completedSteps"`
+
+	// Updated is the time when the checkpoint was written the last time.
+	Updated time.Time `json:"updated"`
+}
+
+// CheckpointVersions is the serializable representation of
+// `release.Versions`.
+type CheckpointVersions struct {
+	Prime    string `json:"prime"`
+	Official string `json:"official,omitempty"`
+	RC       string `json:"rc,omitempty"`
+	Beta     string `json:"beta,omitempty"`
+	Alpha    string `json:"alpha,omitempty"`
+}
+
+// NewCheckpoint creates a new empty `Checkpoint` for the provided options.
+func NewCheckpoint(options *Options) *Checkpoint {
+	return &Checkpoint{
+		BuildVersion:   options.BuildVersion,
+		ReleaseType:    options.ReleaseType,
+		ReleaseBranch:  options.ReleaseBranch,
+		NoMock:         options.NoMock,
+		CompletedSteps: []string{},
+	}
+}
+
+// ReadCheckpoint reads a `Checkpoint` from the provided JSON file.
+func ReadCheckpoint(path string) (*Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading checkpoint file: %w", err)
+	}
+
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("unmarshal checkpoint: %w", err)
+	}
+
+	return checkpoint, nil
+}
+
+// Write stores the checkpoint as JSON in the provided file. The file is
+// replaced atomically to not leave a truncated checkpoint behind.
+func (c *Checkpoint) Write(path string) error {
+	c.Updated = time.Now().UTC()
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal checkpoint: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("writing checkpoint file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("moving checkpoint file into place: %w", err)
+	}
+
+	return nil
+}
+
+// Matches verifies that the checkpoint has been written for the same inputs
+// as the provided options.
+func (c *Checkpoint) Matches(options *Options) error {
+	for _, field := range []struct{ name, checkpoint, current string }{
+		{"build version", c.BuildVersion, options.BuildVersion},
+		{"release type", c.ReleaseType, options.ReleaseType},
+		{"release branch", c.ReleaseBranch, options.ReleaseBranch},
+	} {
+		if field.checkpoint != field.current {
+			return fmt.Errorf(
+				"checkpoint %s %q does not match %q",
+				field.name, field.checkpoint, field.current,
+			)
+		}
+	}
+
+	if c.NoMock != options.NoMock {
+		return fmt.Errorf(
+			"checkpoint nomock %v does not match %v", c.NoMock, options.NoMock,
+		)
+	}
+
+	return nil
+}
+
+// IsCompleted returns true if the step has already been finished.
+func (c *Checkpoint) IsCompleted(step string) bool {
+	return slices.Contains(c.CompletedSteps, step)
+}
+
+// Complete marks the step as finished.
+func (c *Checkpoint) Complete(step string) {
+	if !c.IsCompleted(step) {
+		c.CompletedSteps = append(c.CompletedSteps, step)
+	}
+}
+
+// SetVersions records the provided release versions.
+func (c *Checkpoint) SetVersions(versions *release.Versions) {
+	if versions == nil {
+		c.Versions = nil
+
+		return
+	}
+
+	c.Versions = &CheckpointVersions{
+		Prime:    versions.Prime(),
+		Official: versions.Official(),
+		RC:       versions.RC(),
+		Beta:     versions.Beta(),
+		Alpha:    versions.Alpha(),
+	}
+}
+
+// ReleaseVersions returns the recorded release versions or nil if they are
+// not part of the checkpoint.
+func (c *Checkpoint) ReleaseVersions() *release.Versions {
+	if c.Versions == nil {
+		return nil
+	}
+
+	return release.NewReleaseVersions(
+		c.Versions.Prime,
+		c.Versions.Official,
+		c.Versions.RC,
+		c.Versions.Beta,
+		c.Versions.Alpha,
+	)
+}
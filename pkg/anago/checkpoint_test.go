@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anago_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/release-sdk/git"
+
+	"k8s.io/release/pkg/anago"
+	"k8s.io/release/pkg/release"
+)
+
+func TestCheckpointWriteRead(t *testing.T) {
+	options := &anago.Options{
+		ReleaseType:   release.ReleaseTypeRC,
+		ReleaseBranch: "release-1.20",
+		BuildVersion:  "v1.20.0-rc.0.20+4628c605aadb9b",
+	}
+	versions := release.NewReleaseVersions(
+		"v1.20.0-rc.0", "", "v1.20.0-rc.0", "", "v1.21.0-alpha.0",
+	)
+
+	checkpoint := anago.NewCheckpoint(options)
+	checkpoint.SetVersions(versions)
+	checkpoint.CreateReleaseBranch = true
+	checkpoint.Complete(anago.StepGenerateReleaseVersion)
+	checkpoint.Complete(anago.StepGenerateReleaseVersion)
+
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	require.NoError(t, checkpoint.Write(path))
+
+	res, err := anago.ReadCheckpoint(path)
+	require.NoError(t, err)
+	require.NoError(t, res.Matches(options))
+	require.True(t, res.CreateReleaseBranch)
+	require.Equal(t, []string{anago.StepGenerateReleaseVersion}, res.CompletedSteps)
+	require.True(t, res.IsCompleted(anago.StepGenerateReleaseVersion))
+	require.False(t, res.IsCompleted(anago.StepBuild))
+	require.Equal(t, versions.Ordered(), res.ReleaseVersions().Ordered())
+}
+
+func TestCheckpointMatches(t *testing.T) {
+	checkpoint := anago.NewCheckpoint(&anago.Options{
+		ReleaseType:   release.ReleaseTypeAlpha,
+		ReleaseBranch: git.DefaultBranch,
+		BuildVersion:  "v1.20.0-alpha.1.20+4628c605aadb9b",
+	})
+
+	for _, tc := range []struct {
+		modify      func(*anago.Options)
+		shouldError bool
+	}{
+		{ // same options
+			modify: func(*anago.Options) {},
+		},
+		{ // different build version
+			modify: func(o *anago.Options) {
+				o.BuildVersion = "v1.20.0-alpha.1.21+5628c605aadb9b"
+			},
+			shouldError: true,
+		},
+		{ // different release type
+			modify: func(o *anago.Options) {
+				o.ReleaseType = release.ReleaseTypeBeta
+			},
+			shouldError: true,
+		},
+		{ // different release branch
+			modify: func(o *anago.Options) {
+				o.ReleaseBranch = "release-1.20"
+			},
+			shouldError: true,
+		},
+		{ // different nomock
+			modify: func(o *anago.Options) {
+				o.NoMock = true
+			},
+			shouldError: true,
+		},
+	} {
+		options := &anago.Options{
+			ReleaseType:   release.ReleaseTypeAlpha,
+			ReleaseBranch: git.DefaultBranch,
+			BuildVersion:  "v1.20.0-alpha.1.20+4628c605aadb9b",
+		}
+		tc.modify(options)
+
+		err := checkpoint.Matches(options)
+		if tc.shouldError {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+}
+
+func TestReadCheckpointFailure(t *testing.T) {
+	_, err := anago.ReadCheckpoint(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anago
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/blang/semver/v4"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/release/pkg/objectstore"
+	"k8s.io/release/pkg/release"
+)
+
+const (
+	// PlanKindStage is the `Plan` kind of a stage run.
+	PlanKindStage = "stage"
+
+	// PlanKindRelease is the `Plan` kind of a release run.
+	PlanKindRelease = "release"
+
+	// PlanFormatJSON renders the plan as JSON.
+	PlanFormatJSON = "json"
+
+	// PlanFormatYAML renders the plan as YAML.
+	PlanFormatYAML = "yaml"
+
+	// PlanActionCreate indicates that a git object gets created locally.
+	PlanActionCreate = "create"
+
+	// PlanActionPush indicates that a git object gets pushed to the remote.
+	PlanActionPush = "push"
+)
+
+// Plan is the machine-readable manifest of all changes a stage or release
+// run intends to do. It gets recorded by walking through the regular steps
+// with an implementation which does not modify anything.
+type Plan struct {
+	// Kind is either `stage` or `release`.
+	Kind string `json:"kind"`
+
+	// NoMock indicates if the planned run is a production run.
+	NoMock bool `json:"noMock"`
+
+	// ReleaseType is the release type of the planned run.
+	ReleaseType string `json:"releaseType"`
+
+	// ReleaseBranch is the release branch of the planned run.
+	ReleaseBranch string `json:"releaseBranch"`
+
+	// BuildVersion is the build version of the planned run.
+	BuildVersion string `json:"buildVersion,omitempty"`
+
+	// Bucket is the target bucket of the planned run.
+	Bucket string `json:"bucket"`
+
+	// ContainerRegistry is the target registry of the planned run.
+	ContainerRegistry string `json:"containerRegistry"`
+
+	// CreateReleaseBranch indicates if a new release branch gets created.
+	CreateReleaseBranch bool `json:"createReleaseBranch"`
+
+	// Versions are the release versions in the order they get processed.
+	Versions []string `json:"versions,omitempty"`
+
+	// Branches are the git branches which get created or pushed.
+	Branches []PlanGitObject `json:"branches,omitempty"`
+
+	// Tags are the git tags which get created or pushed.
+	Tags []PlanGitObject `json:"tags,omitempty"`
+
+	// Objects are the files which get copied into the bucket.
+	Objects []PlanObject `json:"objects,omitempty"`
+
+	// VersionMarkers are the version markers which get published.
+	VersionMarkers []PlanVersionMarker `json:"versionMarkers,omitempty"`
+
+	// Images are the container images which get pushed.
+	Images []PlanImage `json:"images,omitempty"`
+
+	// Announcements are the announcements which get created or sent.
+	Announcements []PlanAnnouncement `json:"announcements,omitempty"`
+
+	mu sync.Mutex
+}
+
+// PlanGitObject is a git branch or tag of the plan.
+type PlanGitObject struct {
+	// Name is the name of the branch or tag.
+	Name string `json:"name"`
+
+	// Action is either `create` or `push`.
+	Action string `json:"action"`
+
+	// Ref is the commit or branch the object gets created from.
+	Ref string `json:"ref,omitempty"`
+
+	// Message is the message of an annotated tag.
+	Message string `json:"message,omitempty"`
+}
+
+// PlanObject is a bucket object or directory of the plan.
+type PlanObject struct {
+	// Source is the local path or bucket URL of the object.
+	Source string `json:"source"`
+
+	// Destination is the bucket URL of the object.
+	Destination string `json:"destination"`
+}
+
+// PlanVersionMarker is a set of version markers of the plan.
+type PlanVersionMarker struct {
+	// Version is the version which gets published.
+	Version string `json:"version"`
+
+	// Path is the bucket URL containing the version markers.
+	Path string `json:"path"`
+
+	// Markers are the marker files which get updated if they do not already
+	// point to a newer version.
+	Markers []string `json:"markers"`
+}
+
+// PlanImage is a set of container images of the plan.
+type PlanImage struct {
+	// Registry is the target container registry.
+	Registry string `json:"registry"`
+
+	// Version is the tag of the images.
+	Version string `json:"version"`
+}
+
+// PlanAnnouncement is an announcement of the plan.
+type PlanAnnouncement struct {
+	// Type is the kind of announcement, for example `github-release`.
+	Type string `json:"type"`
+
+	// Target is the destination of the announcement.
+	Target string `json:"target"`
+}
+
+// planVersionClient contains the read-only lookups which are still done
+// when planning, because all further steps depend on their results.
+type planVersionClient interface {
+	BranchNeedsCreation(
+		branch, releaseType string, buildVersion semver.Version,
+	) (bool, error)
+	GenerateReleaseVersion(
+		releaseType, version, branch string, branchFromMaster bool,
+	) (*release.Versions, error)
+}
+
+// NewPlan creates a new empty `Plan` for the provided options.
+func NewPlan(kind string, options *Options) *Plan {
+	return &Plan{
+		Kind:              kind,
+		NoMock:            options.NoMock,
+		ReleaseType:       options.ReleaseType,
+		ReleaseBranch:     options.ReleaseBranch,
+		BuildVersion:      options.BuildVersion,
+		Bucket:            options.Bucket(),
+		ContainerRegistry: options.ContainerRegistry(),
+	}
+}
+
+// NewStagePlan creates a new `Stage` which does not modify anything but
+// records the intended changes into the returned `Plan`.
+func NewStagePlan(options *StageOptions) (*Stage, *Plan) {
+	plan := NewPlan(PlanKindStage, options.Options)
+	client := NewDefaultStage(options)
+	client.SetImpl(newPlanStageImpl(plan))
+
+	return &Stage{client}, plan
+}
+
+// NewReleasePlan creates a new `Release` which does not modify anything but
+// records the intended changes into the returned `Plan`.
+func NewReleasePlan(options *ReleaseOptions) (*Release, *Plan) {
+	plan := NewPlan(PlanKindRelease, options.Options)
+	client := NewDefaultRelease(options)
+	client.SetImpl(newPlanReleaseImpl(plan))
+
+	return &Release{client}, plan
+}
+
+// Write renders the plan in the provided format, which can be either
+// `json` or `yaml`.
+func (p *Plan) Write(w io.Writer, format string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var (
+		data []byte
+		err  error
+	)
+
+	switch format {
+	case PlanFormatJSON:
+		data, err = json.MarshalIndent(p, "", "  ")
+		data = append(data, '\n')
+	case PlanFormatYAML:
+		data, err = yaml.Marshal(p)
+	default:
+		return fmt.Errorf("unsupported plan format: %s", format)
+	}
+
+	if err != nil {
+		return fmt.Errorf("marshal plan: %w", err)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("write plan: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Plan) addBranch(branch PlanGitObject) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.Branches = append(p.Branches, branch)
+}
+
+func (p *Plan) addTag(tag PlanGitObject) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.Tags = append(p.Tags, tag)
+}
+
+func (p *Plan) addObject(src, dst string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.Objects = append(p.Objects, PlanObject{
+		Source:      src,
+		Destination: normalizePlanPath(dst),
+	})
+}
+
+func (p *Plan) addVersionMarker(marker PlanVersionMarker) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.VersionMarkers = append(p.VersionMarkers, marker)
+}
+
+func (p *Plan) addImage(image PlanImage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.Images = append(p.Images, image)
+}
+
+func (p *Plan) addAnnouncement(announcement PlanAnnouncement) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.Announcements = append(p.Announcements, announcement)
+}
+
+func (p *Plan) setCreateReleaseBranch(createReleaseBranch bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.CreateReleaseBranch = createReleaseBranch
+}
+
+func (p *Plan) setVersions(versions []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.Versions = versions
+}
+
+// normalizePlanPath converts bucket paths to URLs of their object store.
+func normalizePlanPath(path string) string {
+	normalized, err := objectstore.New(path).NormalizePath(path)
+	if err != nil {
+		return path
+	}
+
+	return normalized
+}
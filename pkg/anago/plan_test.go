@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anago
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/release"
+)
+
+const planTestBuildVersion = "v1.20.0-alpha.1.66+d19aec8bf1c8ca"
+
+// fakePlanVersionClient returns static release versions without any remote
+// lookups.
+type fakePlanVersionClient struct{}
+
+func (*fakePlanVersionClient) BranchNeedsCreation(
+	string, string, semver.Version,
+) (bool, error) {
+	return false, nil
+}
+
+func (*fakePlanVersionClient) GenerateReleaseVersion(
+	string, string, string, bool,
+) (*release.Versions, error) {
+	return release.NewReleaseVersions(
+		"v1.20.0-alpha.2", "", "", "", "v1.20.0-alpha.2",
+	), nil
+}
+
+func TestStagePlan(t *testing.T) {
+	options := DefaultStageOptions()
+	options.BuildVersion = planTestBuildVersion
+	options.CheckpointFile = ""
+
+	plan := NewPlan(PlanKindStage, options.Options)
+	client := NewDefaultStage(options)
+	client.SetImpl(&planStageImpl{
+		delegate: &fakePlanVersionClient{},
+		plan:     plan,
+	})
+
+	require.NoError(t, (&Stage{client}).Run())
+
+	require.Equal(t, []string{"v1.20.0-alpha.2"}, plan.Versions)
+	require.False(t, plan.CreateReleaseBranch)
+	require.Empty(t, plan.Branches)
+	require.Equal(t, []PlanGitObject{{
+		Name:    "v1.20.0-alpha.2",
+		Action:  PlanActionCreate,
+		Ref:     "d19aec8bf1c8ca",
+		Message: "Kubernetes alpha release v1.20.0-alpha.2",
+	}}, plan.Tags)
+	require.Equal(t, []PlanImage{{
+		Registry: release.GCRIOPathMock,
+		Version:  "v1.20.0-alpha.2",
+	}}, plan.Images)
+
+	stagePath := "gs://" + release.TestBucket + "/stage/" + planTestBuildVersion
+	require.Len(t, plan.Objects, 4)
+	require.Equal(t, stagePath+"/"+release.SourcesTar, plan.Objects[0].Destination)
+	require.Equal(t, stagePath+"/v1.20.0-alpha.2/gcs-stage/v1.20.0-alpha.2", plan.Objects[1].Destination)
+	require.Equal(t, stagePath+"/v1.20.0-alpha.2/release-images", plan.Objects[2].Destination)
+	require.Equal(t, stagePath+"/"+release.ProvenanceFilename, plan.Objects[3].Destination)
+}
+
+func TestReleasePlan(t *testing.T) {
+	options := DefaultReleaseOptions()
+	options.BuildVersion = planTestBuildVersion
+
+	plan := NewPlan(PlanKindRelease, options.Options)
+	client := NewDefaultRelease(options)
+	client.SetImpl(&planReleaseImpl{
+		delegate: &fakePlanVersionClient{},
+		plan:     plan,
+	})
+
+	require.NoError(t, (&Release{client}).Run())
+
+	require.Equal(t, []PlanGitObject{
+		{Name: "v1.20.0-alpha.2", Action: PlanActionPush},
+	}, plan.Tags)
+	require.Equal(t, []PlanGitObject{
+		{Name: "master", Action: PlanActionPush},
+	}, plan.Branches)
+	require.Equal(t, []PlanVersionMarker{{
+		Version: "v1.20.0-alpha.2",
+		Path:    "gs://" + release.TestBucket + "/release",
+		Markers: []string{"latest.txt", "latest-1.txt", "latest-1.20.txt"},
+	}}, plan.VersionMarkers)
+	require.Len(t, plan.Objects, 5)
+	require.Equal(t,
+		"gs://"+release.TestBucket+"/release/release-notes-index.json",
+		plan.Objects[4].Destination,
+	)
+	require.Len(t, plan.Announcements, 2)
+	require.Equal(t, "announcement", plan.Announcements[0].Type)
+	require.Equal(t,
+		"https://github.com/kubernetes/kubernetes/releases/tag/v1.20.0-alpha.2",
+		plan.Announcements[1].Target,
+	)
+}
+
+func TestPlanWrite(t *testing.T) {
+	plan := NewPlan(PlanKindStage, DefaultOptions())
+	plan.addTag(PlanGitObject{Name: "v1.20.0", Action: PlanActionCreate})
+
+	for _, tc := range []struct {
+		format      string
+		contains    string
+		shouldError bool
+	}{
+		{format: PlanFormatJSON, contains: `"name": "v1.20.0"`},
+		{format: PlanFormatYAML, contains: "- action: create\n  name: v1.20.0\n"},
+		{format: "toml", shouldError: true},
+	} {
+		buf := &bytes.Buffer{}
+
+		err := plan.Write(buf, tc.format)
+		if tc.shouldError {
+			require.Error(t, err)
+
+			continue
+		}
+
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), tc.contains)
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, plan.Write(buf, PlanFormatJSON))
+
+	res := &Plan{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), res))
+	require.Equal(t, plan.Tags, res.Tags)
+	require.Equal(t, release.TestBucket, res.Bucket)
+}
@@ -33,6 +33,7 @@ import (
 	"k8s.io/release/pkg/announce/github"
 	"k8s.io/release/pkg/build"
 	"k8s.io/release/pkg/gcp/gcb"
+	"k8s.io/release/pkg/objectstore"
 	"k8s.io/release/pkg/release"
 )
 
@@ -126,7 +127,7 @@ type defaultReleaseImpl struct{}
 type releaseImpl interface {
 	Submit(options *gcb.Options) error
 	ToFile(fileName string) error
-	CheckPrerequisites() error
+	CheckPrerequisites(bucket string) error
 	BranchNeedsCreation(
 		branch, releaseType string, buildVersion semver.Version,
 	) (bool, error)
@@ -138,7 +139,10 @@ type releaseImpl interface {
 	CopyStagedFromGCS(
 		options *build.Options, stagedBucket, buildVersion string,
 	) error
-	ValidateImages(registry, version, buildPath string) error
+	ValidateImages(
+		registry, version, buildPath string,
+		policy *release.ImageVerificationPolicy,
+	) error
 	PublishVersion(
 		buildType, version, buildDir, bucket, gcsRoot string,
 		versionMarkers []string,
@@ -169,8 +173,14 @@ func (d *defaultReleaseImpl) ToFile(fileName string) error {
 	return log.ToFile(fileName)
 }
 
-func (d *defaultReleaseImpl) CheckPrerequisites() error {
-	return release.NewPrerequisitesChecker().Run(workspaceDir)
+func (d *defaultReleaseImpl) CheckPrerequisites(bucket string) error {
+	opts := *release.DefaultPrerequisitesCheckerOptions
+	opts.Bucket = bucket
+
+	checker := release.NewPrerequisitesChecker()
+	checker.SetOptions(&opts)
+
+	return checker.Run(workspaceDir)
 }
 
 func (d *defaultReleaseImpl) BranchNeedsCreation(
@@ -216,8 +226,14 @@ func (d *defaultReleaseImpl) CopyStagedFromGCS(
 
 func (d *defaultReleaseImpl) ValidateImages(
 	registry, version, buildPath string,
+	policy *release.ImageVerificationPolicy,
 ) error {
-	return release.NewImages().Validate(registry, version, buildPath)
+	images := release.NewImages()
+	if err := images.SetVerificationPolicy(policy); err != nil {
+		return fmt.Errorf("set image verification policy: %w", err)
+	}
+
+	return images.Validate(registry, version, buildPath)
 }
 
 func (d *defaultReleaseImpl) PublishVersion(
@@ -336,7 +352,7 @@ func (d *DefaultRelease) ValidateOptions() error {
 }
 
 func (d *DefaultRelease) CheckPrerequisites() error {
-	return d.impl.CheckPrerequisites()
+	return d.impl.CheckPrerequisites(d.options.Bucket())
 }
 
 func (d *DefaultRelease) CheckReleaseBranchState() error {
@@ -421,6 +437,7 @@ func (d *DefaultRelease) PushArtifacts() error {
 		// images are available.
 		if err := d.impl.ValidateImages(
 			targetRegistry, version, buildDir,
+			d.options.ImageVerificationPolicy,
 		); err != nil {
 			return fmt.Errorf("validate container images: %w", err)
 		}
@@ -434,7 +451,7 @@ func (d *DefaultRelease) PushArtifacts() error {
 
 	logrus.Info("Publishing release notes JSON and announcement")
 
-	objStore := object.NewGCS()
+	objStore := objectstore.New(d.options.Bucket())
 	objStore.SetOptions(objStore.WithNoClobber(false))
 
 	gcsReleaseRootPath, err := d.impl.NormalizePath(
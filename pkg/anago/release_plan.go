@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anago
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/blang/semver/v4"
+
+	"sigs.k8s.io/release-sdk/git"
+	"sigs.k8s.io/release-sdk/object"
+
+	"k8s.io/release/pkg/announce"
+	"k8s.io/release/pkg/announce/github"
+	"k8s.io/release/pkg/build"
+	"k8s.io/release/pkg/gcp/gcb"
+	"k8s.io/release/pkg/release"
+)
+
+// planReleaseImpl is a release implementation which does not modify
+// anything. The release versions are looked up by the `delegate`, while all
+// other operations are recorded into the `plan`.
+type planReleaseImpl struct {
+	delegate planVersionClient
+	plan     *Plan
+}
+
+func newPlanReleaseImpl(plan *Plan) *planReleaseImpl {
+	return &planReleaseImpl{
+		delegate: &defaultReleaseImpl{},
+		plan:     plan,
+	}
+}
+
+func (p *planReleaseImpl) Submit(*gcb.Options) error {
+	return errors.New("submitting a job is not supported when planning")
+}
+
+func (p *planReleaseImpl) ToFile(string) error { return nil }
+
+func (p *planReleaseImpl) CheckPrerequisites(string) error { return nil }
+
+func (p *planReleaseImpl) BranchNeedsCreation(
+	branch, releaseType string, buildVersion semver.Version,
+) (bool, error) {
+	createReleaseBranch, err := p.delegate.BranchNeedsCreation(
+		branch, releaseType, buildVersion,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	p.plan.setCreateReleaseBranch(createReleaseBranch)
+
+	return createReleaseBranch, nil
+}
+
+func (p *planReleaseImpl) PrepareWorkspaceRelease(string, string) error {
+	return nil
+}
+
+func (p *planReleaseImpl) GenerateReleaseVersion(
+	releaseType, version, branch string, branchFromMaster bool,
+) (*release.Versions, error) {
+	versions, err := p.delegate.GenerateReleaseVersion(
+		releaseType, version, branch, branchFromMaster,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.plan.setVersions(versions.Ordered())
+
+	return versions, nil
+}
+
+func (p *planReleaseImpl) CheckReleaseBucket(*build.Options) error { return nil }
+
+func (p *planReleaseImpl) CopyStagedFromGCS(
+	options *build.Options, stagedBucket, buildVersion string,
+) error {
+	p.plan.addObject(
+		normalizePlanPath(filepath.Join(
+			stagedBucket, release.StagePath, buildVersion, options.Version,
+			release.GCSStagePath, options.Version,
+		)),
+		filepath.Join(options.Bucket, "release", options.Version),
+	)
+
+	return nil
+}
+
+func (p *planReleaseImpl) ValidateImages(
+	string, string, string, *release.ImageVerificationPolicy,
+) error {
+	return nil
+}
+
+func (p *planReleaseImpl) PublishVersion(
+	buildType, version, _, bucket, gcsRoot string,
+	versionMarkers []string,
+	_, fast bool,
+) error {
+	markers, err := release.VersionMarkers(
+		buildType, version, versionMarkers, fast,
+	)
+	if err != nil {
+		return fmt.Errorf("get version markers: %w", err)
+	}
+
+	for i := range markers {
+		markers[i] += ".txt"
+	}
+
+	markerPath := filepath.Join(bucket, gcsRoot)
+	if fast {
+		markerPath = filepath.Join(markerPath, "fast")
+	}
+
+	p.plan.addVersionMarker(PlanVersionMarker{
+		Version: version,
+		Path:    normalizePlanPath(markerPath),
+		Markers: markers,
+	})
+
+	return nil
+}
+
+func (p *planReleaseImpl) CreateAnnouncement(*announce.Options) error {
+	p.plan.addAnnouncement(PlanAnnouncement{
+		Type:   "announcement",
+		Target: announcementHTMLFile,
+	})
+
+	return nil
+}
+
+func (p *planReleaseImpl) UpdateGitHubPage(options *github.Options) error {
+	p.plan.addAnnouncement(PlanAnnouncement{
+		Type: "github-release",
+		Target: fmt.Sprintf(
+			"https://github.com/%s/%s/releases/tag/%s",
+			options.Owner, options.Repo, options.Tag,
+		),
+	})
+
+	return nil
+}
+
+func (p *planReleaseImpl) PushTags(_ *release.GitObjectPusher, tagList []string) error {
+	for _, tag := range tagList {
+		p.plan.addTag(PlanGitObject{Name: tag, Action: PlanActionPush})
+	}
+
+	return nil
+}
+
+func (p *planReleaseImpl) PushBranches(_ *release.GitObjectPusher, branchList []string) error {
+	for _, branch := range branchList {
+		p.plan.addBranch(PlanGitObject{Name: branch, Action: PlanActionPush})
+	}
+
+	return nil
+}
+
+func (p *planReleaseImpl) PushMainBranch(*release.GitObjectPusher) error {
+	p.plan.addBranch(PlanGitObject{
+		Name:   git.DefaultBranch,
+		Action: PlanActionPush,
+	})
+
+	return nil
+}
+
+func (p *planReleaseImpl) NewGitPusher(
+	*release.GitObjectPusherOptions,
+) (*release.GitObjectPusher, error) {
+	return nil, nil //nolint:nilnil // the pusher is never used
+}
+
+func (p *planReleaseImpl) NormalizePath(
+	store object.Store, pathParts ...string,
+) (string, error) {
+	return store.NormalizePath(pathParts...)
+}
+
+func (p *planReleaseImpl) CopyToRemote(_ object.Store, src, gcsPath string) error {
+	p.plan.addObject(src, gcsPath)
+
+	return nil
+}
+
+func (p *planReleaseImpl) PublishReleaseNotesIndex(
+	gcsIndexRootPath, gcsReleaseNotesPath, _ string,
+) error {
+	p.plan.addObject(
+		gcsReleaseNotesPath,
+		gcsIndexRootPath+"/release-notes-index.json",
+	)
+
+	return nil
+}
+
+func (p *planReleaseImpl) CreatePubBotBranchIssue(branchName string) error {
+	p.plan.addAnnouncement(PlanAnnouncement{
+		Type:   "publishing-bot-issue",
+		Target: branchName,
+	})
+
+	return nil
+}
+
+func (p *planReleaseImpl) CheckStageProvenance(string, string, *release.Versions) error {
+	return nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package anago
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -35,6 +36,7 @@ import (
 	"sigs.k8s.io/release-utils/command"
 	"sigs.k8s.io/release-utils/log"
 
+	"k8s.io/release/pkg/announce/sbom"
 	"k8s.io/release/pkg/build"
 	"k8s.io/release/pkg/changelog"
 	"k8s.io/release/pkg/gcp/auth"
@@ -59,6 +61,19 @@ type stageClient interface {
 	// Validate if the provided `StageOptions` are correctly set.
 	ValidateOptions() error
 
+	// LoadCheckpoint initializes the checkpoint of the run. If resuming, it
+	// restores the state of a previous run after verifying that its inputs
+	// still match.
+	LoadCheckpoint() error
+
+	// IsStepCompleted returns true if the provided step has already been
+	// finished by a previous run.
+	IsStepCompleted(step string) bool
+
+	// SaveCheckpoint marks the provided step as completed and writes the
+	// checkpoint to disk.
+	SaveCheckpoint(step string) error
+
 	// CheckPrerequisites verifies that a valid GITHUB_TOKEN environment
 	// variable is set. It also checks for the existence and version of
 	// required packages and if the correct Google Cloud project is set. A
@@ -140,7 +155,7 @@ type defaultStageImpl struct{}
 type stageImpl interface {
 	Submit(options *gcb.Options) error
 	ToFile(fileName string) error
-	CheckPrerequisites() error
+	CheckPrerequisites(bucket string) error
 	BranchNeedsCreation(
 		branch, releaseType string, buildVersion semver.Version,
 	) (bool, error)
@@ -180,11 +195,15 @@ type stageImpl interface {
 	BuildBaseArtifactsSBOM(*spdx.DocGenerateOptions) (*spdx.Document, error)
 	AddBinariesToSBOM(*spdx.Document, string) error
 	AddTarfilesToSBOM(*spdx.Document, string) error
+	WriteCycloneDXBOM(*spdx.Document, string) error
 	VerifyArtifacts([]string) error
 	GenerateAttestation(*StageState, *StageOptions) (*provenance.Statement, error)
 	PushAttestation(*provenance.Statement, *StageOptions) error
 	GetProvenanceSubjects(*StageOptions, string) ([]intoto.Subject, error)
 	GetOutputDirSubjects(*StageOptions, string, string) ([]intoto.Subject, error)
+	Chdir(dir string) error
+	ReadCheckpoint(path string) (*Checkpoint, error)
+	WriteCheckpoint(checkpoint *Checkpoint, path string) error
 }
 
 func (d *defaultStageImpl) Submit(options *gcb.Options) error {
@@ -195,8 +214,14 @@ func (d *defaultStageImpl) ToFile(fileName string) error {
 	return log.ToFile(fileName)
 }
 
-func (d *defaultStageImpl) CheckPrerequisites() error {
-	return release.NewPrerequisitesChecker().Run(workspaceDir)
+func (d *defaultStageImpl) CheckPrerequisites(bucket string) error {
+	opts := *release.DefaultPrerequisitesCheckerOptions
+	opts.Bucket = bucket
+
+	checker := release.NewPrerequisitesChecker()
+	checker.SetOptions(&opts)
+
+	return checker.Run(workspaceDir)
 }
 
 func (d *defaultStageImpl) BranchNeedsCreation(
@@ -305,6 +330,18 @@ func (d *defaultStageImpl) PushContainerImages(
 	return build.NewInstance(options).PushContainerImages()
 }
 
+func (d *defaultStageImpl) Chdir(dir string) error {
+	return os.Chdir(dir)
+}
+
+func (d *defaultStageImpl) ReadCheckpoint(path string) (*Checkpoint, error) {
+	return ReadCheckpoint(path)
+}
+
+func (d *defaultStageImpl) WriteCheckpoint(checkpoint *Checkpoint, path string) error {
+	return checkpoint.Write(path)
+}
+
 func (d *DefaultStage) Submit(stream bool) error {
 	options := gcb.NewDefaultOptions()
 	options.Stream = stream
@@ -369,7 +406,7 @@ func (d *DefaultStage) InitLogFile() error {
 }
 
 func (d *DefaultStage) InitState() {
-	d.state = &StageState{DefaultState()}
+	d.state = &StageState{State: DefaultState()}
 }
 
 func (d *DefaultStage) ValidateOptions() error {
@@ -380,8 +417,129 @@ func (d *DefaultStage) ValidateOptions() error {
 	return nil
 }
 
+func (d *DefaultStage) LoadCheckpoint() error {
+	if !d.options.Resume {
+		d.state.checkpoint = NewCheckpoint(d.options.Options)
+
+		return nil
+	}
+
+	logrus.Infof("Resuming stage from checkpoint %s", d.options.CheckpointFile)
+
+	checkpoint, err := d.impl.ReadCheckpoint(d.options.CheckpointFile)
+	if err != nil {
+		return fmt.Errorf("read checkpoint: %w", err)
+	}
+
+	if err := checkpoint.Matches(d.options.Options); err != nil {
+		return fmt.Errorf("checkpoint inputs differ: %w", err)
+	}
+
+	if checkpoint.WorkspaceCommit != "" {
+		commit, err := d.workspaceCommit()
+		if err != nil {
+			return fmt.Errorf("get workspace commit: %w", err)
+		}
+
+		if commit != checkpoint.WorkspaceCommit {
+			return fmt.Errorf(
+				"workspace commit %s does not match checkpoint commit %s",
+				commit, checkpoint.WorkspaceCommit,
+			)
+		}
+	}
+
+	// Subsequent steps expect to run within the prepared repository.
+	if checkpoint.IsCompleted(StepPrepareWorkspace) {
+		if err := d.impl.Chdir(gitRoot); err != nil {
+			return fmt.Errorf("change into workspace: %w", err)
+		}
+	}
+
+	if checkpoint.IsCompleted(StepGenerateReleaseVersion) {
+		versions := checkpoint.ReleaseVersions()
+		if versions == nil {
+			return errors.New("checkpoint does not contain the release versions")
+		}
+
+		// The release versions are derived from the inputs only, so they
+		// have to be the same when generating them again.
+		currentVersions, err := d.impl.GenerateReleaseVersion(
+			d.options.ReleaseType,
+			d.options.BuildVersion,
+			d.options.ReleaseBranch,
+			checkpoint.CreateReleaseBranch,
+		)
+		if err != nil {
+			return fmt.Errorf("generating release versions for comparison: %w", err)
+		}
+
+		if currentVersions.String() != versions.String() {
+			return fmt.Errorf(
+				"release versions (%s) do not match checkpoint versions (%s)",
+				currentVersions, versions,
+			)
+		}
+
+		d.state.versions = versions
+	}
+
+	d.state.createReleaseBranch = checkpoint.CreateReleaseBranch
+	d.state.checkpoint = checkpoint
+
+	logrus.Infof(
+		"Restored checkpoint with completed steps: %s",
+		strings.Join(checkpoint.CompletedSteps, ", "),
+	)
+
+	return nil
+}
+
+func (d *DefaultStage) IsStepCompleted(step string) bool {
+	return d.state.checkpoint != nil && d.state.checkpoint.IsCompleted(step)
+}
+
+func (d *DefaultStage) SaveCheckpoint(step string) error {
+	checkpoint := d.state.checkpoint
+	if checkpoint == nil {
+		checkpoint = NewCheckpoint(d.options.Options)
+		d.state.checkpoint = checkpoint
+	}
+
+	checkpoint.Complete(step)
+	checkpoint.CreateReleaseBranch = d.state.createReleaseBranch
+	checkpoint.SetVersions(d.state.versions)
+
+	// The repository only exists after the workspace got prepared.
+	if checkpoint.IsCompleted(StepPrepareWorkspace) {
+		commit, err := d.workspaceCommit()
+		if err != nil {
+			return fmt.Errorf("get workspace commit: %w", err)
+		}
+
+		checkpoint.WorkspaceCommit = commit
+	}
+
+	if err := d.impl.WriteCheckpoint(checkpoint, d.options.CheckpointFile); err != nil {
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+// workspaceCommit returns the current HEAD commit of the Kubernetes
+// repository.
+func (d *DefaultStage) workspaceCommit() (string, error) {
+	repo, err := d.impl.OpenRepo(gitRoot)
+	if err != nil {
+		return "", fmt.Errorf("open Kubernetes repository: %w", err)
+	}
+
+	return d.impl.RevParse(repo, "HEAD")
+}
+
 func (d *DefaultStage) CheckPrerequisites() error {
-	return d.impl.CheckPrerequisites()
+	return d.impl.CheckPrerequisites(d.options.Bucket())
 }
 
 func (d *DefaultStage) CheckReleaseBranchState() error {
@@ -687,6 +845,7 @@ func (d *DefaultStage) GenerateChangelog() error {
 		JSONFile:     releaseNotesJSONFile,
 		Dependencies: true,
 		CloneCVEMaps: true,
+		MapProviders: d.options.MapProviders,
 		Tars:         filepath.Join(buildDir, release.ReleaseTarsPath),
 		Images:       buildDir,
 	})
@@ -826,6 +985,38 @@ func (d *defaultStageImpl) GenerateVersionArtifactsBOM(version string) error {
 		return fmt.Errorf("writing artifacts SBOM for %s: %w", version, err)
 	}
 
+	// Write the same SBOM in CycloneDX format for consumers not
+	// supporting SPDX
+	if err := d.WriteCycloneDXBOM(doc, version); err != nil {
+		return fmt.Errorf("writing CycloneDX artifacts SBOM for %s: %w", version, err)
+	}
+
+	return nil
+}
+
+// WriteCycloneDXBOM converts the release artifacts SBOM to CycloneDX, writes
+// it to disk and checks that the written document describes the same
+// artifacts as the SPDX one.
+func (d *defaultStageImpl) WriteCycloneDXBOM(doc *spdx.Document, version string) error {
+	cdx, err := sbom.NewCycloneDXFromSPDX(doc)
+	if err != nil {
+		return fmt.Errorf("converting SBOM to CycloneDX: %w", err)
+	}
+
+	path := filepath.Join(os.TempDir(), fmt.Sprintf("release-bom-%s.cdx.json", version))
+	if err := cdx.Write(path); err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading CycloneDX SBOM: %w", err)
+	}
+
+	if err := sbom.VerifyCycloneDXConsistency(doc, data); err != nil {
+		return fmt.Errorf("verifying CycloneDX SBOM consistency: %w", err)
+	}
+
 	return nil
 }
 
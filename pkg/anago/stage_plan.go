@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anago
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/blang/semver/v4"
+	intoto "github.com/in-toto/in-toto-golang/in_toto"
+
+	"sigs.k8s.io/bom/pkg/provenance"
+	"sigs.k8s.io/bom/pkg/spdx"
+	"sigs.k8s.io/release-sdk/git"
+
+	"k8s.io/release/pkg/build"
+	"k8s.io/release/pkg/changelog"
+	"k8s.io/release/pkg/gcp/gcb"
+	"k8s.io/release/pkg/release"
+)
+
+// planStageImpl is a stage implementation which does not modify anything.
+// The release versions are looked up by the `delegate`, while all other
+// operations are recorded into the `plan`.
+type planStageImpl struct {
+	delegate planVersionClient
+	plan     *Plan
+
+	// currentRef is the last checked out revision of the repository.
+	currentRef string
+}
+
+func newPlanStageImpl(plan *Plan) *planStageImpl {
+	return &planStageImpl{
+		delegate: &defaultStageImpl{},
+		plan:     plan,
+	}
+}
+
+func (p *planStageImpl) Submit(*gcb.Options) error {
+	return errors.New("submitting a job is not supported when planning")
+}
+
+func (p *planStageImpl) ToFile(string) error { return nil }
+
+func (p *planStageImpl) CheckPrerequisites(string) error { return nil }
+
+func (p *planStageImpl) BranchNeedsCreation(
+	branch, releaseType string, buildVersion semver.Version,
+) (bool, error) {
+	createReleaseBranch, err := p.delegate.BranchNeedsCreation(
+		branch, releaseType, buildVersion,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	p.plan.setCreateReleaseBranch(createReleaseBranch)
+
+	return createReleaseBranch, nil
+}
+
+func (p *planStageImpl) PrepareWorkspaceStage(bool) error { return nil }
+
+func (p *planStageImpl) GenerateReleaseVersion(
+	releaseType, version, branch string, branchFromMaster bool,
+) (*release.Versions, error) {
+	versions, err := p.delegate.GenerateReleaseVersion(
+		releaseType, version, branch, branchFromMaster,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.plan.setVersions(versions.Ordered())
+
+	return versions, nil
+}
+
+func (p *planStageImpl) OpenRepo(string) (*git.Repo, error) {
+	return nil, nil //nolint:nilnil // the repository is never accessed
+}
+
+func (p *planStageImpl) RevParse(_ *git.Repo, rev string) (string, error) {
+	return rev, nil
+}
+
+// RevParseTag always fails because the plan assumes that no tags of
+// previous runs exist.
+func (p *planStageImpl) RevParseTag(_ *git.Repo, rev string) (string, error) {
+	return "", errors.New("tag " + rev + " does not exist")
+}
+
+func (p *planStageImpl) Checkout(_ *git.Repo, rev string, args ...string) error {
+	// `git checkout -B <branch> <commit>` creates the release branch
+	if rev == "-B" && len(args) == 2 {
+		p.plan.addBranch(PlanGitObject{
+			Name:   args[0],
+			Action: PlanActionCreate,
+			Ref:    args[1],
+		})
+		p.currentRef = args[0]
+
+		return nil
+	}
+
+	p.currentRef = rev
+
+	return nil
+}
+
+func (p *planStageImpl) CurrentBranch(*git.Repo) (string, error) {
+	return p.currentRef, nil
+}
+
+func (p *planStageImpl) CommitEmpty(*git.Repo, string) error { return nil }
+
+func (p *planStageImpl) Tag(_ *git.Repo, name, message string) error {
+	p.plan.addTag(PlanGitObject{
+		Name:    name,
+		Action:  PlanActionCreate,
+		Ref:     p.currentRef,
+		Message: message,
+	})
+
+	return nil
+}
+
+func (p *planStageImpl) Merge(*git.Repo, string) error { return nil }
+
+func (p *planStageImpl) CheckReleaseBucket(*build.Options) error { return nil }
+
+func (p *planStageImpl) DockerHubLogin() error { return nil }
+
+func (p *planStageImpl) ConfigureDocker() error { return nil }
+
+func (p *planStageImpl) MakeCross(string) error { return nil }
+
+func (p *planStageImpl) GenerateChangelog(*changelog.Options) error { return nil }
+
+func (p *planStageImpl) StageLocalSourceTree(
+	options *build.Options, workDir, buildVersion string,
+) error {
+	p.plan.addObject(
+		filepath.Join(workDir, release.SourcesTar),
+		filepath.Join(
+			options.Bucket, release.StagePath, buildVersion, release.SourcesTar,
+		),
+	)
+
+	return nil
+}
+
+func (p *planStageImpl) DeleteLocalSourceTarball(*build.Options, string) error {
+	return nil
+}
+
+func (p *planStageImpl) StageLocalArtifacts(*build.Options) error { return nil }
+
+func (p *planStageImpl) PushReleaseArtifacts(
+	_ *build.Options, srcPath, gcsPath string,
+) error {
+	p.plan.addObject(srcPath, gcsPath)
+
+	return nil
+}
+
+func (p *planStageImpl) PushContainerImages(options *build.Options) error {
+	p.plan.addImage(PlanImage{
+		Registry: options.Registry,
+		Version:  options.Version,
+	})
+
+	return nil
+}
+
+func (p *planStageImpl) GoModDownload(string) error { return nil }
+
+func (p *planStageImpl) GenerateVersionArtifactsBOM(string) error { return nil }
+
+func (p *planStageImpl) GenerateSourceTreeBOM(
+	*spdx.DocGenerateOptions,
+) (*spdx.Document, error) {
+	return spdx.NewDocument(), nil
+}
+
+func (p *planStageImpl) WriteSourceBOM(*spdx.Document, string) error {
+	return nil
+}
+
+func (p *planStageImpl) ListBinaries(string) ([]struct{ Path, Platform, Arch string }, error) {
+	return nil, nil
+}
+
+func (p *planStageImpl) ListImageArchives(string) ([]string, error) {
+	return nil, nil
+}
+
+func (p *planStageImpl) ListTarballs(string) ([]string, error) {
+	return nil, nil
+}
+
+func (p *planStageImpl) BuildBaseArtifactsSBOM(
+	*spdx.DocGenerateOptions,
+) (*spdx.Document, error) {
+	return spdx.NewDocument(), nil
+}
+
+func (p *planStageImpl) AddBinariesToSBOM(*spdx.Document, string) error {
+	return nil
+}
+
+func (p *planStageImpl) AddTarfilesToSBOM(*spdx.Document, string) error {
+	return nil
+}
+
+func (p *planStageImpl) WriteCycloneDXBOM(*spdx.Document, string) error {
+	return nil
+}
+
+func (p *planStageImpl) VerifyArtifacts([]string) error { return nil }
+
+func (p *planStageImpl) GenerateAttestation(
+	*StageState, *StageOptions,
+) (*provenance.Statement, error) {
+	return provenance.NewSLSAStatement(), nil
+}
+
+func (p *planStageImpl) PushAttestation(
+	_ *provenance.Statement, options *StageOptions,
+) error {
+	p.plan.addObject(
+		release.ProvenanceFilename,
+		filepath.Join(
+			options.Bucket(), release.StagePath, options.BuildVersion,
+			release.ProvenanceFilename,
+		),
+	)
+
+	return nil
+}
+
+func (p *planStageImpl) GetProvenanceSubjects(
+	*StageOptions, string,
+) ([]intoto.Subject, error) {
+	return nil, nil
+}
+
+func (p *planStageImpl) GetOutputDirSubjects(
+	*StageOptions, string, string,
+) ([]intoto.Subject, error) {
+	return nil, nil
+}
+
+func (p *planStageImpl) Chdir(string) error { return nil }
+
+func (p *planStageImpl) ReadCheckpoint(string) (*Checkpoint, error) {
+	return nil, errors.New("checkpoints are not supported when planning")
+}
+
+func (p *planStageImpl) WriteCheckpoint(*Checkpoint, string) error { return nil }
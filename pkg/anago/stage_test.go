@@ -395,7 +395,7 @@ func TestTagRepository(t *testing.T) {
 
 		state.SetVersions(tc.versions)
 		state.SetCreateReleaseBranch(tc.createReleaseBranch)
-		sut.SetState(&anago.StageState{state})
+		sut.SetState(&anago.StageState{State: state})
 
 		mock := &anagofakes.FakeStageImpl{}
 		tc.prepare(mock)
@@ -489,6 +489,24 @@ func TestGenerateChangelog(t *testing.T) {
 	}
 }
 
+func TestGenerateChangelogMapProviders(t *testing.T) {
+	opts := anago.DefaultStageOptions()
+	opts.MapProviders = []string{"gs://bucket/maps", "https://example.com/maps"}
+	sut := anago.NewDefaultStage(opts)
+
+	etag := ""
+	sut.SetState(generateTestingStageState(&testStateParameters{
+		versionsTag: &etag,
+	}))
+
+	mock := &anagofakes.FakeStageImpl{}
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.GenerateChangelog())
+	require.Equal(t, 1, mock.GenerateChangelogCallCount())
+	require.Equal(t, opts.MapProviders, mock.GenerateChangelogArgsForCall(0).MapProviders)
+}
+
 func TestStageArtifacts(t *testing.T) {
 	for _, tc := range []struct {
 		prepare     func(*anagofakes.FakeStageImpl)
@@ -806,7 +824,7 @@ func TestTagRepositoryIdempotent(t *testing.T) {
 
 			state.SetVersions(tc.versions)
 			state.SetCreateReleaseBranch(tc.createReleaseBranch)
-			sut.SetState(&anago.StageState{state})
+			sut.SetState(&anago.StageState{State: state})
 
 			mock := &anagofakes.FakeStageImpl{}
 			tc.prepare(mock)
@@ -857,3 +875,185 @@ func TestVerifyArtifactsImpl(t *testing.T) {
 		}
 	}
 }
+
+func TestLoadCheckpoint(t *testing.T) {
+	const buildVersion = "v1.20.0-beta.1.358+4628c605aadb9b"
+
+	versions := release.NewReleaseVersions(
+		"v1.20.0-beta.1", "", "", "v1.20.0-beta.1", "",
+	)
+
+	newCheckpoint := func(steps ...string) *anago.Checkpoint {
+		checkpoint := anago.NewCheckpoint(&anago.Options{
+			ReleaseType:   release.ReleaseTypeBeta,
+			ReleaseBranch: git.DefaultBranch,
+			BuildVersion:  buildVersion,
+		})
+		checkpoint.SetVersions(versions)
+		checkpoint.WorkspaceCommit = "1234567890abcdef"
+
+		for _, step := range steps {
+			checkpoint.Complete(step)
+		}
+
+		return checkpoint
+	}
+
+	for _, tc := range []struct {
+		name        string
+		resume      bool
+		prepare     func(*anagofakes.FakeStageImpl)
+		assert      func(*testing.T, *anago.DefaultStage, *anagofakes.FakeStageImpl)
+		shouldError bool
+	}{
+		{
+			name:    "no resume creates empty checkpoint",
+			prepare: func(*anagofakes.FakeStageImpl) {},
+			assert: func(t *testing.T, sut *anago.DefaultStage, mock *anagofakes.FakeStageImpl) {
+				t.Helper()
+				require.Zero(t, mock.ReadCheckpointCallCount())
+				require.False(t, sut.IsStepCompleted(anago.StepBuild))
+			},
+		},
+		{
+			name:   "resume restores completed steps",
+			resume: true,
+			prepare: func(mock *anagofakes.FakeStageImpl) {
+				mock.ReadCheckpointReturns(newCheckpoint(
+					anago.StepGenerateReleaseVersion,
+					anago.StepPrepareWorkspace,
+					anago.StepBuild,
+				), nil)
+				mock.GenerateReleaseVersionReturns(versions, nil)
+				mock.RevParseReturns("1234567890abcdef", nil)
+			},
+			assert: func(t *testing.T, sut *anago.DefaultStage, mock *anagofakes.FakeStageImpl) {
+				t.Helper()
+				require.Equal(t, 1, mock.ChdirCallCount())
+				require.True(t, sut.IsStepCompleted(anago.StepBuild))
+				require.False(t, sut.IsStepCompleted(anago.StepStageArtifacts))
+			},
+		},
+		{
+			name:   "ReadCheckpoint fails",
+			resume: true,
+			prepare: func(mock *anagofakes.FakeStageImpl) {
+				mock.ReadCheckpointReturns(nil, err)
+			},
+			shouldError: true,
+		},
+		{
+			name:   "build version differs",
+			resume: true,
+			prepare: func(mock *anagofakes.FakeStageImpl) {
+				checkpoint := newCheckpoint()
+				checkpoint.BuildVersion = "v1.20.0-beta.1.359+5628c605aadb9b"
+				mock.ReadCheckpointReturns(checkpoint, nil)
+			},
+			shouldError: true,
+		},
+		{
+			name:   "workspace commit differs",
+			resume: true,
+			prepare: func(mock *anagofakes.FakeStageImpl) {
+				mock.ReadCheckpointReturns(newCheckpoint(anago.StepPrepareWorkspace), nil)
+				mock.RevParseReturns("fedcba0987654321", nil)
+			},
+			shouldError: true,
+		},
+		{
+			name:   "release versions differ",
+			resume: true,
+			prepare: func(mock *anagofakes.FakeStageImpl) {
+				mock.ReadCheckpointReturns(newCheckpoint(anago.StepGenerateReleaseVersion), nil)
+				mock.RevParseReturns("1234567890abcdef", nil)
+				mock.GenerateReleaseVersionReturns(release.NewReleaseVersions(
+					"v1.20.0-beta.2", "", "", "v1.20.0-beta.2", "",
+				), nil)
+			},
+			shouldError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := anago.DefaultStageOptions()
+			opts.ReleaseType = release.ReleaseTypeBeta
+			opts.BuildVersion = buildVersion
+			opts.Resume = tc.resume
+
+			sut := anago.NewDefaultStage(opts)
+			sut.SetState(anago.DefaultStageState())
+
+			mock := &anagofakes.FakeStageImpl{}
+			tc.prepare(mock)
+			sut.SetImpl(mock)
+
+			err := sut.LoadCheckpoint()
+			if tc.shouldError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			tc.assert(t, sut, mock)
+		})
+	}
+}
+
+func TestSaveCheckpoint(t *testing.T) {
+	for _, tc := range []struct {
+		prepare     func(*anagofakes.FakeStageImpl)
+		step        string
+		shouldError bool
+	}{
+		{ // success without workspace
+			prepare: func(*anagofakes.FakeStageImpl) {},
+			step:    anago.StepGenerateReleaseVersion,
+		},
+		{ // success with workspace
+			prepare: func(mock *anagofakes.FakeStageImpl) {
+				mock.RevParseReturns("1234567890abcdef", nil)
+			},
+			step: anago.StepPrepareWorkspace,
+		},
+		{ // RevParse fails
+			prepare: func(mock *anagofakes.FakeStageImpl) {
+				mock.RevParseReturns("", err)
+			},
+			step:        anago.StepPrepareWorkspace,
+			shouldError: true,
+		},
+		{ // WriteCheckpoint fails
+			prepare: func(mock *anagofakes.FakeStageImpl) {
+				mock.WriteCheckpointReturns(err)
+			},
+			step:        anago.StepGenerateReleaseVersion,
+			shouldError: true,
+		},
+	} {
+		opts := anago.DefaultStageOptions()
+		sut := anago.NewDefaultStage(opts)
+
+		sut.SetState(
+			generateTestingStageState(&testStateParameters{versionsTag: &testVersionTag}),
+		)
+
+		mock := &anagofakes.FakeStageImpl{}
+		tc.prepare(mock)
+		sut.SetImpl(mock)
+
+		err := sut.SaveCheckpoint(tc.step)
+		if tc.shouldError {
+			require.Error(t, err)
+
+			continue
+		}
+
+		require.NoError(t, err)
+		require.True(t, sut.IsStepCompleted(tc.step))
+		require.Equal(t, 1, mock.WriteCheckpointCallCount())
+
+		checkpoint, _ := mock.WriteCheckpointArgsForCall(0)
+		require.Equal(t, testVersionTag, checkpoint.Versions.Official)
+	}
+}
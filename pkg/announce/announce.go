@@ -125,11 +125,16 @@ func (a *Announce) CreateForRelease() error {
 		changelog = a.options.changelogHTML
 	}
 
-	logrus.Infof("Trying to get the Go version used to build %s...", a.options.tag)
+	goVersion := a.options.goVersion
+	if goVersion == "" {
+		logrus.Infof("Trying to get the Go version used to build %s...", a.options.tag)
+
+		var err error
 
-	goVersion, err := a.GetGoVersion(a.options.tag)
-	if err != nil {
-		return err
+		goVersion, err = a.GetGoVersion(a.options.tag)
+		if err != nil {
+			return err
+		}
 	}
 
 	if goVersion == "" {
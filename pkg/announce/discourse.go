@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package announce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// DiscourseNotifier creates a new topic for every announcement on a
+// Discourse forum.
+type DiscourseNotifier struct {
+	baseURL     string
+	apiKey      string
+	apiUsername string
+	categoryID  int
+	client      *http.Client
+}
+
+// NewDiscourseNotifier creates a new DiscourseNotifier for the forum at
+// baseURL. The API key needs permissions to create topics as apiUsername
+// within the category.
+func NewDiscourseNotifier(baseURL, apiKey, apiUsername string, categoryID int) (*DiscourseNotifier, error) {
+	if baseURL == "" {
+		return nil, errors.New("no Discourse URL provided")
+	}
+
+	if apiKey == "" || apiUsername == "" {
+		return nil, errors.New("an API key and username are required for Discourse")
+	}
+
+	return &DiscourseNotifier{
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		apiKey:      apiKey,
+		apiUsername: apiUsername,
+		categoryID:  categoryID,
+		client:      &http.Client{Timeout: notifierTimeout},
+	}, nil
+}
+
+// Name returns the channel name used for reporting.
+func (d *DiscourseNotifier) Name() string {
+	return "Discourse"
+}
+
+// Notify creates a new topic containing the markdown announcement.
+func (d *DiscourseNotifier) Notify(ctx context.Context, msg *Message) error {
+	payload := map[string]any{
+		"title": msg.Subject(),
+		"raw":   d.Render(msg),
+	}
+
+	if d.categoryID != 0 {
+		payload["category"] = d.categoryID
+	}
+
+	return postJSON(ctx, d.client, d.baseURL+"/posts.json", map[string]string{
+		"Api-Key":      d.apiKey,
+		"Api-Username": d.apiUsername,
+	}, payload)
+}
+
+// Render returns the markdown body of the announcement topic.
+func (d *DiscourseNotifier) Render(msg *Message) string {
+	return msg.Markdown()
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package announce
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+)
+
+// FeedFormat is the format of a static announcement feed.
+type FeedFormat string
+
+const (
+	FeedFormatRSS  FeedFormat = "rss"
+	FeedFormatAtom FeedFormat = "atom"
+
+	feedTitle    = "Kubernetes Releases"
+	feedLink     = "https://github.com/kubernetes/kubernetes/releases"
+	feedMaxItems = 50
+)
+
+// FeedNotifier adds announcements to a static RSS 2.0 or Atom feed file,
+// which can be served by any web server. Existing entries are preserved
+// and the feed is capped to the latest 50 announcements.
+type FeedNotifier struct {
+	path   string
+	format FeedFormat
+}
+
+// NewFeedNotifier creates a new FeedNotifier writing to path.
+func NewFeedNotifier(path string, format FeedFormat) (*FeedNotifier, error) {
+	if path == "" {
+		return nil, errors.New("no feed file path provided")
+	}
+
+	if !slices.Contains(FeedFormats(), format) {
+		return nil, fmt.Errorf("unsupported feed format %q", format)
+	}
+
+	return &FeedNotifier{path: path, format: format}, nil
+}
+
+// FeedFormats returns all supported feed formats.
+func FeedFormats() []FeedFormat {
+	return []FeedFormat{FeedFormatRSS, FeedFormatAtom}
+}
+
+// Name returns the channel name used for reporting.
+func (f *FeedNotifier) Name() string {
+	if f.format == FeedFormatAtom {
+		return "Atom feed"
+	}
+
+	return "RSS feed"
+}
+
+// Notify adds the announcement to the feed file, replacing an existing entry
+// for the same release.
+func (f *FeedNotifier) Notify(_ context.Context, msg *Message) error {
+	existing, err := os.ReadFile(f.path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("reading feed file: %w", err)
+	}
+
+	var feed interface{ add(*Message) }
+
+	switch f.format {
+	case FeedFormatAtom:
+		feed = &atomFeed{}
+	default:
+		feed = &rssFeed{}
+	}
+
+	if len(existing) > 0 {
+		if err := xml.Unmarshal(existing, feed); err != nil {
+			return fmt.Errorf("parsing existing %s: %w", f.Name(), err)
+		}
+	}
+
+	feed.add(msg)
+
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling %s: %w", f.Name(), err)
+	}
+
+	//nolint:gosec // the feed is meant to be public
+	if err := os.WriteFile(f.path, append([]byte(xml.Header), append(data, '\n')...), 0o644); err != nil {
+		return fmt.Errorf("writing feed file: %w", err)
+	}
+
+	return nil
+}
+
+// Render returns the HTML content of the feed entry.
+func (f *FeedNotifier) Render(msg *Message) string {
+	return msg.HTML()
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	Description string `xml:"description"`
+	GUID        string `xml:"guid"`
+	PubDate     string `xml:"pubDate"`
+}
+
+func (r *rssFeed) add(msg *Message) {
+	r.Version = "2.0"
+	r.Channel.Title = feedTitle
+	r.Channel.Link = feedLink
+	r.Channel.Description = "Release announcements of the Kubernetes project"
+	r.Channel.LastBuildDate = msg.Date.Format(time.RFC1123Z)
+
+	items := slices.DeleteFunc(r.Channel.Items, func(i rssItem) bool {
+		return i.GUID == msg.ReleaseURL()
+	})
+
+	r.Channel.Items = append([]rssItem{{
+		Title:       msg.Subject(),
+		Link:        msg.ReleaseURL(),
+		Description: msg.HTML(),
+		GUID:        msg.ReleaseURL(),
+		PubDate:     msg.Date.Format(time.RFC1123Z),
+	}}, items...)
+	r.Channel.Items = r.Channel.Items[:min(len(r.Channel.Items), feedMaxItems)]
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Content atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+func (a *atomFeed) add(msg *Message) {
+	a.Title = feedTitle
+	a.ID = feedLink
+	a.Link = atomLink{Href: feedLink}
+	a.Updated = msg.Date.Format(time.RFC3339)
+
+	entries := slices.DeleteFunc(a.Entries, func(e atomEntry) bool {
+		return e.ID == msg.ReleaseURL()
+	})
+
+	a.Entries = append([]atomEntry{{
+		Title:   msg.Subject(),
+		ID:      msg.ReleaseURL(),
+		Updated: msg.Date.Format(time.RFC3339),
+		Link:    atomLink{Href: msg.ReleaseURL()},
+		Content: atomContent{Type: "html", Body: msg.HTML()},
+	}}, entries...)
+	a.Entries = a.Entries[:min(len(a.Entries), feedMaxItems)]
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package announce
+
+import (
+	"context"
+	"net/http"
+)
+
+// MatrixNotifier posts announcements to a Matrix room using an incoming
+// webhook, like the generic webhooks of matrix-hookshot.
+type MatrixNotifier struct {
+	webhookURL string
+	client     *http.Client
+}
+
+// NewMatrixNotifier creates a new MatrixNotifier for the webhook URL.
+func NewMatrixNotifier(webhookURL string) *MatrixNotifier {
+	return &MatrixNotifier{
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: notifierTimeout},
+	}
+}
+
+// Name returns the channel name used for reporting.
+func (m *MatrixNotifier) Name() string {
+	return "Matrix"
+}
+
+// Notify posts the announcement as HTML with a markdown fallback.
+func (m *MatrixNotifier) Notify(ctx context.Context, msg *Message) error {
+	return postJSON(ctx, m.client, m.webhookURL, nil, map[string]string{
+		"text": msg.Markdown(),
+		"html": m.Render(msg),
+	})
+}
+
+// Render returns the HTML body of the announcement.
+func (m *MatrixNotifier) Render(msg *Message) string {
+	return msg.HTML()
+}
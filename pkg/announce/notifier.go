@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package announce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	changelogBaseURL = "https://git.k8s.io/kubernetes/"
+	releaseBaseURL   = "https://github.com/kubernetes/kubernetes/releases/tag/"
+	releaseManagers  = "https://git.k8s.io/website/content/en/releases/release-managers.md"
+
+	// notifierTimeout is the default timeout for HTTP based notifiers.
+	notifierTimeout = 30 * time.Second
+)
+
+// goVersionRegex matches the Go version in a release announcement.
+var goVersionRegex = regexp.MustCompile(`Golang version <b>([^<]+)</b>`)
+
+//counterfeiter:generate . Notifier
+//go:generate /usr/bin/env bash -c "cat ../../hack/boilerplate/boilerplate.generatego.txt announcefakes/fake_notifier.go > announcefakes/_fake_notifier.go && mv announcefakes/_fake_notifier.go announcefakes/fake_notifier.go"
+
+// Notifier publishes a release announcement to a single channel, like a
+// chat room, forum or feed.
+type Notifier interface {
+	// Name returns the channel name used for reporting.
+	Name() string
+
+	// Notify publishes the announcement message to the channel.
+	Notify(ctx context.Context, msg *Message) error
+
+	// Render returns the body the channel would publish for the message,
+	// which is used to preview the announcement in mock mode.
+	Render(msg *Message) string
+}
+
+// Message is the channel independent content of a release announcement.
+// Notifiers use it to render channel appropriate bodies.
+type Message struct {
+	// Tag is the released version, like v1.30.1.
+	Tag string
+
+	// GoVersion is the Go version used to build the release.
+	GoVersion string
+
+	// ChangelogPath is the path of the changelog in the k/k repository,
+	// for example CHANGELOG/CHANGELOG-1.30.md.
+	ChangelogPath string
+
+	// Date is the publication date of the announcement.
+	Date time.Time
+}
+
+// NotificationResult is the outcome of notifying a single channel.
+type NotificationResult struct {
+	Channel string
+	Error   error
+}
+
+// Success returns true if the channel got notified.
+func (r *NotificationResult) Success() bool {
+	return r.Error == nil
+}
+
+// NotificationErrors combines the errors of all failed channels.
+func NotificationErrors(results []NotificationResult) error {
+	errs := []error{}
+
+	for _, r := range results {
+		if !r.Success() {
+			errs = append(errs, fmt.Errorf("%s: %w", r.Channel, r.Error))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Message returns the announcement message for the configured release. The
+// Go version is looked up if not set in the options.
+func (a *Announce) Message() (*Message, error) {
+	if a.options.tag == "" {
+		return nil, errors.New("no release tag set")
+	}
+
+	goVersion := a.options.goVersion
+	if goVersion == "" {
+		var err error
+
+		goVersion, err = a.GetGoVersion(a.options.tag)
+		if err != nil {
+			return nil, fmt.Errorf("getting Go version: %w", err)
+		}
+	}
+
+	return &Message{
+		Tag:           a.options.tag,
+		GoVersion:     goVersion,
+		ChangelogPath: a.options.changelogPath,
+		Date:          time.Now().UTC(),
+	}, nil
+}
+
+// Notify publishes the release announcement to all notifiers. A failing
+// channel does not prevent notifying the remaining ones, the outcome for
+// every channel is part of the returned results.
+func (a *Announce) Notify(ctx context.Context, notifiers ...Notifier) ([]NotificationResult, error) {
+	msg, err := a.Message()
+	if err != nil {
+		return nil, fmt.Errorf("building announcement message: %w", err)
+	}
+
+	results := make([]NotificationResult, 0, len(notifiers))
+
+	for _, n := range notifiers {
+		logrus.Infof("Announcing %s on %s", msg.Tag, n.Name())
+
+		err := n.Notify(ctx, msg)
+		if err != nil {
+			logrus.Errorf("Announcing %s on %s failed: %v", msg.Tag, n.Name(), err)
+		} else {
+			logrus.Infof("Successfully announced %s on %s", msg.Tag, n.Name())
+		}
+
+		results = append(results, NotificationResult{Channel: n.Name(), Error: err})
+	}
+
+	return results, nil
+}
+
+// ParseGoVersion returns the Go version from a rendered release
+// announcement or an empty string if it cannot be found.
+func ParseGoVersion(announcement string) string {
+	match := goVersionRegex.FindStringSubmatch(announcement)
+	if len(match) < 2 {
+		return ""
+	}
+
+	return html.UnescapeString(match[1])
+}
+
+// Subject returns the announcement title.
+func (m *Message) Subject() string {
+	return fmt.Sprintf("Kubernetes %s is live!", m.Tag)
+}
+
+// ChangelogURL returns the link to the changelog.
+func (m *Message) ChangelogURL() string {
+	return changelogBaseURL + m.ChangelogPath
+}
+
+// ReleaseURL returns the link to the GitHub release page.
+func (m *Message) ReleaseURL() string {
+	return releaseBaseURL + m.Tag
+}
+
+// Markdown renders the announcement as markdown.
+func (m *Message) Markdown() string {
+	return fmt.Sprintf(
+		"Kubernetes **%s** has been built and pushed using Golang version **%s**.\n\n"+
+			"The release notes have been updated in [%s](%s), with a pointer to them on [GitHub](%s).\n\n"+
+			"Published by your [Kubernetes Release Managers](%s).\n",
+		m.Tag, m.GoVersion, filepath.Base(m.ChangelogPath), m.ChangelogURL(), m.ReleaseURL(), releaseManagers,
+	)
+}
+
+// HTML renders the announcement as HTML fragment.
+func (m *Message) HTML() string {
+	return fmt.Sprintf(
+		"<p>Kubernetes <b>%s</b> has been built and pushed using Golang version <b>%s</b>.</p>\n"+
+			`<p>The release notes have been updated in <a href="%s">%s</a>, with a pointer to them on `+
+			`<a href="%s">GitHub</a>.</p>`+"\n"+
+			`<p>Published by your <a href="%s">Kubernetes Release Managers</a>.</p>`+"\n",
+		html.EscapeString(m.Tag), html.EscapeString(m.GoVersion),
+		html.EscapeString(m.ChangelogURL()), html.EscapeString(filepath.Base(m.ChangelogPath)),
+		html.EscapeString(m.ReleaseURL()), releaseManagers,
+	)
+}
+
+// Text renders the announcement as plain text.
+func (m *Message) Text() string {
+	return fmt.Sprintf(
+		"Kubernetes %s has been built and pushed using Golang version %s.\n\n"+
+			"Release notes: %s\nGitHub release: %s\n",
+		m.Tag, m.GoVersion, m.ChangelogURL(), m.ReleaseURL(),
+	)
+}
+
+// postJSON sends the payload as JSON to the URL and verifies that the
+// server accepted it.
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshaling payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		if err != nil {
+			return fmt.Errorf("reading response body: %w", err)
+		}
+
+		return fmt.Errorf("unexpected response status %s: %s", resp.Status, bytes.TrimSpace(respBody))
+	}
+
+	return nil
+}
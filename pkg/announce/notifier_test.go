@@ -0,0 +1,304 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package announce_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/announce"
+	"k8s.io/release/pkg/announce/announcefakes"
+)
+
+func newTestMessage(tag string) *announce.Message {
+	return &announce.Message{
+		Tag:           tag,
+		GoVersion:     "1.22.5",
+		ChangelogPath: "CHANGELOG/CHANGELOG-1.30.md",
+		Date:          time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC),
+	}
+}
+
+func TestNotify(t *testing.T) {
+	ok := &announcefakes.FakeNotifier{}
+	ok.NameReturns("ok")
+
+	failing := &announcefakes.FakeNotifier{}
+	failing.NameReturns("failing")
+	failing.NotifyReturns(err)
+
+	an := announce.NewAnnounce(announce.NewOptions().
+		WithTag("v1.30.1").
+		WithGoVersion("1.22.5").
+		WithChangelogPath("CHANGELOG/CHANGELOG-1.30.md"))
+	mock := &announcefakes.FakeImpl{}
+	an.SetImplementation(mock)
+
+	results, notifyErr := an.Notify(t.Context(), failing, ok)
+	require.NoError(t, notifyErr)
+	require.Len(t, results, 2)
+	require.Equal(t, "failing", results[0].Channel)
+	require.False(t, results[0].Success())
+	require.Equal(t, "ok", results[1].Channel)
+	require.True(t, results[1].Success())
+	require.EqualError(t, announce.NotificationErrors(results), "failing: error")
+	require.Zero(t, mock.GetGoVersionCallCount())
+
+	// Both channels got the same message
+	require.Equal(t, 1, ok.NotifyCallCount())
+	_, msg := ok.NotifyArgsForCall(0)
+	require.Equal(t, "v1.30.1", msg.Tag)
+	require.Equal(t, "1.22.5", msg.GoVersion)
+
+	_, failingMsg := failing.NotifyArgsForCall(0)
+	require.Same(t, msg, failingMsg)
+}
+
+func TestNotifyGoVersion(t *testing.T) {
+	notifier := &announcefakes.FakeNotifier{}
+
+	an := announce.NewAnnounce(announce.NewOptions().WithTag("v1.30.1"))
+	mock := &announcefakes.FakeImpl{}
+	mock.GetGoVersionReturns("1.22.0", nil)
+	an.SetImplementation(mock)
+
+	results, notifyErr := an.Notify(t.Context(), notifier)
+	require.NoError(t, notifyErr)
+	require.NoError(t, announce.NotificationErrors(results))
+
+	_, msg := notifier.NotifyArgsForCall(0)
+	require.Equal(t, "1.22.0", msg.GoVersion)
+
+	mock.GetGoVersionReturns("", err)
+	_, notifyErr = an.Notify(t.Context(), notifier)
+	require.Error(t, notifyErr)
+
+	_, notifyErr = announce.NewAnnounce(announce.NewOptions()).Notify(t.Context(), notifier)
+	require.Error(t, notifyErr)
+}
+
+func TestMessageRendering(t *testing.T) {
+	msg := newTestMessage("v1.30.1")
+
+	require.Equal(t, "Kubernetes v1.30.1 is live!", msg.Subject())
+	require.Equal(t, "https://git.k8s.io/kubernetes/CHANGELOG/CHANGELOG-1.30.md", msg.ChangelogURL())
+	require.Equal(t, "https://github.com/kubernetes/kubernetes/releases/tag/v1.30.1", msg.ReleaseURL())
+	require.Contains(t, msg.Markdown(), "Kubernetes **v1.30.1** has been built and pushed using Golang version **1.22.5**.")
+	require.Contains(t, msg.Markdown(), "[CHANGELOG-1.30.md](https://git.k8s.io/kubernetes/CHANGELOG/CHANGELOG-1.30.md)")
+	require.Contains(t, msg.HTML(), "<b>v1.30.1</b>")
+	require.Contains(t, msg.HTML(), `<a href="https://github.com/kubernetes/kubernetes/releases/tag/v1.30.1">GitHub</a>`)
+	require.NotContains(t, msg.Text(), "<")
+	require.Equal(t,
+		":kubernetes: *Kubernetes v1.30.1 is live!*\n"+
+			"It has been built and pushed using Golang version *1.22.5*.\n"+
+			"Release notes: <https://git.k8s.io/kubernetes/CHANGELOG/CHANGELOG-1.30.md|CHANGELOG-1.30.md> | "+
+			"<https://github.com/kubernetes/kubernetes/releases/tag/v1.30.1|GitHub release>",
+		announce.SlackText(msg),
+	)
+}
+
+func TestWebhookNotifiers(t *testing.T) {
+	type request struct {
+		path    string
+		headers http.Header
+		payload map[string]any
+	}
+
+	for _, tc := range []struct {
+		name     string
+		notifier func(url string) (announce.Notifier, error)
+		rendered func(*announce.Message) string
+		assert   func(*request)
+	}{
+		{
+			name: "Slack",
+			notifier: func(url string) (announce.Notifier, error) {
+				return announce.NewSlackNotifier(url + "/services/T/B/X"), nil
+			},
+			rendered: announce.SlackText,
+			assert: func(r *request) {
+				require.Equal(t, "/services/T/B/X", r.path)
+				require.Equal(t, announce.SlackText(newTestMessage("v1.30.1")), r.payload["text"])
+			},
+		},
+		{
+			name: "Matrix",
+			notifier: func(url string) (announce.Notifier, error) {
+				return announce.NewMatrixNotifier(url + "/webhook/abc"), nil
+			},
+			rendered: (*announce.Message).HTML,
+			assert: func(r *request) {
+				require.Equal(t, "/webhook/abc", r.path)
+				require.Equal(t, newTestMessage("v1.30.1").Markdown(), r.payload["text"])
+				require.Equal(t, newTestMessage("v1.30.1").HTML(), r.payload["html"])
+			},
+		},
+		{
+			name: "Discourse",
+			notifier: func(url string) (announce.Notifier, error) {
+				return announce.NewDiscourseNotifier(url+"/", "key", "release-bot", 7)
+			},
+			rendered: (*announce.Message).Markdown,
+			assert: func(r *request) {
+				require.Equal(t, "/posts.json", r.path)
+				require.Equal(t, "key", r.headers.Get("Api-Key"))
+				require.Equal(t, "release-bot", r.headers.Get("Api-Username"))
+				require.Equal(t, "Kubernetes v1.30.1 is live!", r.payload["title"])
+				require.Equal(t, newTestMessage("v1.30.1").Markdown(), r.payload["raw"])
+				require.InDelta(t, 7, r.payload["category"], 0)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var received *request
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				if strings.Contains(string(body), "v9.9.9") {
+					http.Error(w, "invalid payload", http.StatusUnprocessableEntity)
+
+					return
+				}
+
+				received = &request{path: r.URL.Path, headers: r.Header}
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				require.NoError(t, json.Unmarshal(body, &received.payload))
+			}))
+			defer server.Close()
+
+			notifier, err := tc.notifier(server.URL)
+			require.NoError(t, err)
+			require.Equal(t, tc.name, notifier.Name())
+			require.Equal(t, tc.rendered(newTestMessage("v1.30.1")), notifier.Render(newTestMessage("v1.30.1")))
+
+			require.NoError(t, notifier.Notify(t.Context(), newTestMessage("v1.30.1")))
+			require.NotNil(t, received)
+			tc.assert(received)
+
+			err = notifier.Notify(t.Context(), newTestMessage("v9.9.9"))
+			require.ErrorContains(t, err, "422 Unprocessable Entity: invalid payload")
+		})
+	}
+}
+
+func TestNewDiscourseNotifierFailure(t *testing.T) {
+	_, err := announce.NewDiscourseNotifier("", "key", "user", 0)
+	require.Error(t, err)
+
+	_, err = announce.NewDiscourseNotifier("https://discuss.kubernetes.io", "", "user", 0)
+	require.Error(t, err)
+}
+
+func TestFeedNotifier(t *testing.T) {
+	for _, tc := range []struct {
+		format   announce.FeedFormat
+		expected []string
+	}{
+		{
+			format: announce.FeedFormatRSS,
+			expected: []string{
+				`<rss version="2.0">`,
+				"<title>Kubernetes v1.30.2 is live!</title>",
+				"<guid>https://github.com/kubernetes/kubernetes/releases/tag/v1.30.2</guid>",
+				"<pubDate>Wed, 04 Mar 2026 05:06:07 +0000</pubDate>",
+				"&lt;b&gt;v1.30.2&lt;/b&gt;",
+			},
+		},
+		{
+			format: announce.FeedFormatAtom,
+			expected: []string{
+				`<feed xmlns="http://www.w3.org/2005/Atom">`,
+				"<title>Kubernetes v1.30.2 is live!</title>",
+				"<id>https://github.com/kubernetes/kubernetes/releases/tag/v1.30.2</id>",
+				"<updated>2026-03-04T05:06:07Z</updated>",
+				`<content type="html">`,
+			},
+		},
+	} {
+		t.Run(string(tc.format), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "feed.xml")
+
+			notifier, err := announce.NewFeedNotifier(path, tc.format)
+			require.NoError(t, err)
+			require.Equal(t, newTestMessage("v1.30.2").HTML(), notifier.Render(newTestMessage("v1.30.2")))
+
+			// Notifying the same release twice does not duplicate the entry
+			for _, tag := range []string{"v1.30.1", "v1.30.2", "v1.30.2"} {
+				require.NoError(t, notifier.Notify(t.Context(), newTestMessage(tag)))
+			}
+
+			content, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			feed := string(content)
+			for _, expected := range tc.expected {
+				require.Contains(t, feed, expected)
+			}
+
+			require.Equal(t, 1, strings.Count(feed, "<title>Kubernetes v1.30.2 is live!</title>"))
+			require.Less(t,
+				strings.Index(feed, "Kubernetes v1.30.2 is live!"),
+				strings.Index(feed, "Kubernetes v1.30.1 is live!"),
+			)
+
+			require.NoError(t, os.WriteFile(path, []byte("invalid"), 0o600))
+			require.Error(t, notifier.Notify(t.Context(), newTestMessage("v1.30.3")))
+		})
+	}
+
+	_, err := announce.NewFeedNotifier("", announce.FeedFormatRSS)
+	require.Error(t, err)
+
+	_, err = announce.NewFeedNotifier("feed.json", "json")
+	require.Error(t, err)
+}
+
+func TestFeedNotifierMaxItems(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "feed.xml")
+
+	notifier, err := announce.NewFeedNotifier(path, announce.FeedFormatRSS)
+	require.NoError(t, err)
+
+	for i := range 55 {
+		require.NoError(t, notifier.Notify(t.Context(), newTestMessage(fmt.Sprintf("v1.30.%d", i))))
+	}
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 50, strings.Count(string(content), "<item>"))
+	require.Contains(t, string(content), "v1.30.54")
+	require.NotContains(t, string(content), "v1.30.4 is live")
+}
+
+func TestParseGoVersion(t *testing.T) {
+	require.Equal(t, "1.22.5", announce.ParseGoVersion(
+		"Kubernetes <b>v1.30.1</b> has been built and pushed using Golang version <b>1.22.5</b>.",
+	))
+	require.Empty(t, announce.ParseGoVersion("<p>no version</p>"))
+}
@@ -33,6 +33,9 @@ type Options struct {
 	// changelogFile is the path to an HTML file containing the changelog
 	// which will be embedded in the announcement template
 	changelogFile string
+	// goVersion is the Go version used to build the release. It will be
+	// retrieved from the kube-cross image if not set.
+	goVersion string
 }
 
 // NewOptions can be used to create a new Options instance.
@@ -75,3 +78,9 @@ func (o *Options) WithChangelogFile(changelogFile string) *Options {
 
 	return o
 }
+
+func (o *Options) WithGoVersion(goVersion string) *Options {
+	o.goVersion = goVersion
+
+	return o
+}
@@ -0,0 +1,620 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/bom/pkg/spdx"
+)
+
+const (
+	// CycloneDXSpecVersion is the CycloneDX specification version of the
+	// generated documents.
+	CycloneDXSpecVersion = "1.5"
+
+	cycloneDXBOMFormat   = "CycloneDX"
+	spdxNoAssertion      = "NOASSERTION"
+	cycloneDXTypeFile    = "file"
+	cycloneDXTypeLibrary = "library"
+	cycloneDXRefBOM      = "bom"
+	cycloneDXRefDownload = "distribution"
+)
+
+// CycloneDXDocument is a CycloneDX 1.5 bill of materials in its JSON form.
+type CycloneDXDocument struct {
+	BOMFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	SerialNumber string                `json:"serialNumber,omitempty"`
+	Version      int                   `json:"version"`
+	Metadata     *CycloneDXMetadata    `json:"metadata,omitempty"`
+	Components   []CycloneDXComponent  `json:"components,omitempty"`
+	Dependencies []CycloneDXDependency `json:"dependencies,omitempty"`
+}
+
+// CycloneDXMetadata describes the subject and origin of the document.
+type CycloneDXMetadata struct {
+	Timestamp string              `json:"timestamp,omitempty"`
+	Tools     *CycloneDXTools     `json:"tools,omitempty"`
+	Component *CycloneDXComponent `json:"component,omitempty"`
+}
+
+// CycloneDXTools lists the tools which created the document.
+type CycloneDXTools struct {
+	Components []CycloneDXComponent `json:"components,omitempty"`
+}
+
+// CycloneDXComponent is a package, image or file listed in the document.
+type CycloneDXComponent struct {
+	BOMRef             string                       `json:"bom-ref,omitempty"`
+	Type               string                       `json:"type"`
+	Name               string                       `json:"name"`
+	Version            string                       `json:"version,omitempty"`
+	Supplier           *CycloneDXOrganization       `json:"supplier,omitempty"`
+	Purl               string                       `json:"purl,omitempty"`
+	Hashes             []CycloneDXHash              `json:"hashes,omitempty"`
+	Licenses           []CycloneDXLicense           `json:"licenses,omitempty"`
+	ExternalReferences []CycloneDXExternalReference `json:"externalReferences,omitempty"`
+}
+
+// CycloneDXOrganization is the supplier of a component.
+type CycloneDXOrganization struct {
+	Name string `json:"name"`
+}
+
+// CycloneDXHash is a checksum of a component.
+type CycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+// CycloneDXLicense holds the SPDX license expression of a component.
+type CycloneDXLicense struct {
+	Expression string `json:"expression"`
+}
+
+// CycloneDXExternalReference points to a resource outside of the document.
+type CycloneDXExternalReference struct {
+	Type    string `json:"type"`
+	URL     string `json:"url"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// CycloneDXDependency lists the components a component depends on.
+type CycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// cycloneDXHashAlgorithms maps the SPDX checksum algorithms to their
+// CycloneDX names.
+var cycloneDXHashAlgorithms = map[string]string{
+	"MD5":    "MD5",
+	"SHA1":   "SHA-1",
+	"SHA256": "SHA-256",
+	"SHA384": "SHA-384",
+	"SHA512": "SHA-512",
+}
+
+// cycloneDXComponentTypes maps the SPDX primary package purposes to
+// CycloneDX component types. Packages without a known purpose are libraries.
+var cycloneDXComponentTypes = map[string]string{
+	"APPLICATION":      "application",
+	"FRAMEWORK":        "framework",
+	"LIBRARY":          cycloneDXTypeLibrary,
+	"CONTAINER":        "container",
+	"OPERATING-SYSTEM": "operating-system",
+	"DEVICE":           "device",
+	"FIRMWARE":         "firmware",
+	"FILE":             cycloneDXTypeFile,
+	"ARCHIVE":          cycloneDXTypeFile,
+	"SOURCE":           cycloneDXTypeFile,
+	"INSTALL":          cycloneDXTypeFile,
+}
+
+// NewCycloneDXFromSPDX converts an SPDX document into a CycloneDX document.
+// Every SPDX package and file becomes a component, using its SPDX ID as
+// bom-ref. Containment and dependency relationships are converted into
+// dependencies, while relationships to external SPDX documents are kept as
+// external BOM references of the component.
+func NewCycloneDXFromSPDX(doc *spdx.Document) (*CycloneDXDocument, error) {
+	if doc == nil {
+		return nil, errors.New("no SPDX document to convert")
+	}
+
+	externalDocs := map[string]string{}
+	for _, ref := range doc.ExternalDocRefs {
+		externalDocs[ref.ID] = ref.URI
+	}
+
+	timestamp := ""
+	if !doc.Created.IsZero() {
+		timestamp = doc.Created.UTC().Format(time.RFC3339)
+	}
+
+	cdx := &CycloneDXDocument{
+		BOMFormat:   cycloneDXBOMFormat,
+		SpecVersion: CycloneDXSpecVersion,
+		Version:     1,
+		Metadata: &CycloneDXMetadata{
+			Timestamp: timestamp,
+			Component: &CycloneDXComponent{
+				BOMRef: doc.ID,
+				Type:   "application",
+				Name:   doc.Name,
+			},
+		},
+		Components:   []CycloneDXComponent{},
+		Dependencies: []CycloneDXDependency{},
+	}
+
+	if doc.Namespace != "" {
+		cdx.SerialNumber = "urn:uuid:" + uuid.NewSHA1(uuid.NameSpaceURL, []byte(doc.Namespace)).String()
+	}
+
+	if len(doc.Creator.Tool) > 0 {
+		cdx.Metadata.Tools = &CycloneDXTools{}
+		for _, tool := range doc.Creator.Tool {
+			cdx.Metadata.Tools.Components = append(cdx.Metadata.Tools.Components, CycloneDXComponent{
+				Type: "application", Name: tool,
+			})
+		}
+	}
+
+	objects := spdxObjects(doc)
+	dependencies := map[string][]string{doc.ID: topLevelSPDXIDs(doc)}
+
+	for _, o := range objects {
+		component, err := cycloneDXComponent(o)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rel := range *o.GetRelationships() {
+			peerID := spdxPeerID(rel)
+			if peerID == "" {
+				continue
+			}
+
+			if rel.PeerExtReference != "" {
+				uri, ok := externalDocs[rel.PeerExtReference]
+				if !ok {
+					return nil, fmt.Errorf(
+						"%s references unknown external document %s", o.SPDXID(), rel.PeerExtReference,
+					)
+				}
+
+				component.ExternalReferences = append(component.ExternalReferences, CycloneDXExternalReference{
+					Type:    cycloneDXRefBOM,
+					URL:     uri + "#" + peerID,
+					Comment: strings.TrimSpace(fmt.Sprintf("%s %s", rel.Type, rel.Comment)),
+				})
+
+				continue
+			}
+
+			ref, dependsOn, ok := spdxDependency(o, rel)
+			if !ok {
+				logrus.Debugf("Skipping %s relationship of %s in CycloneDX conversion", rel.Type, o.SPDXID())
+
+				continue
+			}
+
+			dependencies[ref] = append(dependencies[ref], dependsOn)
+		}
+
+		cdx.Components = append(cdx.Components, *component)
+	}
+
+	known := map[string]struct{}{doc.ID: {}}
+	for _, o := range objects {
+		known[o.SPDXID()] = struct{}{}
+	}
+
+	for _, ref := range sortedKeys(dependencies) {
+		dependsOn := slices.Compact(slices.Sorted(slices.Values(dependencies[ref])))
+		for _, dep := range append([]string{ref}, dependsOn...) {
+			if _, ok := known[dep]; !ok {
+				return nil, fmt.Errorf("relationship references unknown SPDX element %s", dep)
+			}
+		}
+
+		cdx.Dependencies = append(cdx.Dependencies, CycloneDXDependency{Ref: ref, DependsOn: dependsOn})
+	}
+
+	return cdx, nil
+}
+
+// Write serializes the CycloneDX document as JSON into path.
+func (d *CycloneDXDocument) Write(path string) error {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling CycloneDX document: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil { //nolint:gosec // SBOMs are public
+		return fmt.Errorf("writing CycloneDX document: %w", err)
+	}
+
+	return nil
+}
+
+// cycloneDXDependencyTypes are the SPDX relationship types which express a
+// dependency. The value is true if the peer depends on the element instead
+// of the element on the peer.
+var cycloneDXDependencyTypes = map[spdx.RelationshipType]bool{
+	spdx.CONTAINS:       false,
+	spdx.DEPENDS_ON:     false,
+	spdx.GENERATED_FROM: false,
+	spdx.DYNAMIC_LINK:   false,
+	spdx.STATIC_LINK:    false,
+	spdx.CONTAINED_BY:   true,
+	spdx.DEPENDENCY_OF:  true,
+}
+
+// VerifyCycloneDXConsistency checks the serialized CycloneDX document in
+// data against the SPDX document it has been converted from. Every SPDX
+// package and file has to be a component with the same name, version, purl
+// and checksums, every component has to be an SPDX element and the
+// dependencies have to match the relationships between the SPDX elements.
+func VerifyCycloneDXConsistency(doc *spdx.Document, data []byte) error {
+	if doc == nil {
+		return errors.New("no SPDX document to compare")
+	}
+
+	cdx := &CycloneDXDocument{}
+	if err := json.Unmarshal(data, cdx); err != nil {
+		return fmt.Errorf("decoding CycloneDX document: %w", err)
+	}
+
+	errs := []error{}
+
+	components := map[string]*CycloneDXComponent{}
+	for i := range cdx.Components {
+		component := &cdx.Components[i]
+		if _, ok := components[component.BOMRef]; ok {
+			errs = append(errs, fmt.Errorf("component %s is listed more than once", component.BOMRef))
+		}
+
+		components[component.BOMRef] = component
+	}
+
+	elements, relationships := spdxRelationships(doc)
+
+	for _, id := range sortedKeys(elements) {
+		component, ok := components[id]
+		if !ok {
+			errs = append(errs, fmt.Errorf("SPDX element %s (%s) is missing", id, spdxName(elements[id])))
+		} else if err := verifyCycloneDXComponent(elements[id], component); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for i := range cdx.Components {
+		if _, ok := elements[cdx.Components[i].BOMRef]; !ok {
+			errs = append(errs, fmt.Errorf(
+				"component %s (%s) has no SPDX element", cdx.Components[i].BOMRef, cdx.Components[i].Name,
+			))
+		}
+	}
+
+	dependencies := map[string][]string{}
+	for _, dep := range cdx.Dependencies {
+		dependencies[dep.Ref] = append(dependencies[dep.Ref], dep.DependsOn...)
+	}
+
+	refs := slices.Concat(sortedKeys(relationships), sortedKeys(dependencies))
+	for _, ref := range slices.Compact(slices.Sorted(slices.Values(refs))) {
+		want := slices.Compact(slices.Sorted(slices.Values(relationships[ref])))
+		if got := slices.Compact(slices.Sorted(slices.Values(dependencies[ref]))); !slices.Equal(got, want) {
+			errs = append(errs, fmt.Errorf(
+				"dependencies of %s are %v but SPDX relationships are %v", ref, got, want,
+			))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// spdxRelationships walks the SPDX document and returns its packages and
+// files by ID together with the dependencies expressed by their
+// relationships. It does not share any code with the conversion, so that a
+// mapping error cannot show up on both sides of the consistency check.
+func spdxRelationships(doc *spdx.Document) (elements map[string]spdx.Object, dependencies map[string][]string) {
+	elements = map[string]spdx.Object{}
+	dependencies = map[string][]string{}
+
+	var visit func(spdx.Object)
+
+	visit = func(o spdx.Object) {
+		if o == nil || o.SPDXID() == "" {
+			return
+		}
+
+		if _, ok := elements[o.SPDXID()]; ok {
+			return
+		}
+
+		elements[o.SPDXID()] = o
+
+		for _, rel := range *o.GetRelationships() {
+			if rel.PeerExtReference != "" {
+				continue
+			}
+
+			visit(rel.Peer)
+
+			peerID := rel.PeerReference
+			if peerID == "" && rel.Peer != nil {
+				peerID = rel.Peer.SPDXID()
+			}
+
+			inverse, ok := cycloneDXDependencyTypes[rel.Type]
+			if !ok || peerID == "" {
+				continue
+			}
+
+			if inverse {
+				dependencies[peerID] = append(dependencies[peerID], o.SPDXID())
+			} else {
+				dependencies[o.SPDXID()] = append(dependencies[o.SPDXID()], peerID)
+			}
+		}
+	}
+
+	for _, p := range doc.Packages {
+		dependencies[doc.ID] = append(dependencies[doc.ID], p.SPDXID())
+		visit(p)
+	}
+
+	for _, f := range doc.Files {
+		dependencies[doc.ID] = append(dependencies[doc.ID], f.SPDXID())
+		visit(f)
+	}
+
+	return elements, dependencies
+}
+
+// verifyCycloneDXComponent compares the identity and checksums of the
+// component with the SPDX package or file.
+func verifyCycloneDXComponent(o spdx.Object, component *CycloneDXComponent) error {
+	var (
+		entity        *spdx.Entity
+		version, purl string
+	)
+
+	switch obj := o.(type) {
+	case *spdx.Package:
+		entity = &obj.Entity
+
+		version = obj.Version
+		if p := obj.Purl(); p != nil {
+			purl = p.ToString()
+		}
+	case *spdx.File:
+		entity = &obj.Entity
+	default:
+		return fmt.Errorf("unsupported SPDX element %s of type %T", o.SPDXID(), o)
+	}
+
+	if name := spdxName(o); component.Name != name || component.Version != version || component.Purl != purl {
+		return fmt.Errorf(
+			"component %s is %s@%s (%s) but SPDX element is %s@%s (%s)",
+			o.SPDXID(), component.Name, component.Version, component.Purl, name, version, purl,
+		)
+	}
+
+	hashes := map[string]string{}
+	for _, hash := range component.Hashes {
+		hashes[hash.Alg] = hash.Content
+	}
+
+	checksums := map[string]string{}
+	for alg, value := range entity.Checksum {
+		if cdxAlg, ok := cycloneDXHashAlgorithms[alg]; ok {
+			checksums[cdxAlg] = value
+		}
+	}
+
+	if !maps.Equal(hashes, checksums) {
+		return fmt.Errorf("checksums of component %s do not match", o.SPDXID())
+	}
+
+	return nil
+}
+
+// spdxDependency returns the dependency expressed by an SPDX relationship
+// within the document, pointing from the depending to the required element.
+// Relationships to external documents and unsupported types are skipped.
+func spdxDependency(o spdx.Object, rel *spdx.Relationship) (ref, dependsOn string, ok bool) {
+	peerID := spdxPeerID(rel)
+	if peerID == "" || rel.PeerExtReference != "" {
+		return "", "", false
+	}
+
+	switch rel.Type {
+	case spdx.CONTAINS, spdx.DEPENDS_ON, spdx.GENERATED_FROM, spdx.DYNAMIC_LINK, spdx.STATIC_LINK:
+		return o.SPDXID(), peerID, true
+	case spdx.CONTAINED_BY, spdx.DEPENDENCY_OF:
+		return peerID, o.SPDXID(), true
+	default:
+		return "", "", false
+	}
+}
+
+// spdxName returns the name of the SPDX package or file, falling back to the
+// file name.
+func spdxName(o spdx.Object) string {
+	var entity *spdx.Entity
+
+	switch obj := o.(type) {
+	case *spdx.Package:
+		entity = &obj.Entity
+	case *spdx.File:
+		entity = &obj.Entity
+	default:
+		return ""
+	}
+
+	if entity.Name != "" {
+		return entity.Name
+	}
+
+	return entity.FileName
+}
+
+// cycloneDXComponent converts a single SPDX package or file.
+func cycloneDXComponent(o spdx.Object) (*CycloneDXComponent, error) {
+	component := &CycloneDXComponent{BOMRef: o.SPDXID()}
+
+	var entity *spdx.Entity
+
+	switch obj := o.(type) {
+	case *spdx.Package:
+		entity = &obj.Entity
+
+		component.Type = cycloneDXTypeLibrary
+		if t, ok := cycloneDXComponentTypes[obj.PrimaryPurpose]; ok {
+			component.Type = t
+		}
+
+		component.Version = obj.Version
+		if p := obj.Purl(); p != nil {
+			component.Purl = p.ToString()
+
+			if p.Type == "oci" && obj.PrimaryPurpose == "" {
+				component.Type = "container"
+			}
+		}
+
+		if obj.Supplier.Organization != "" {
+			component.Supplier = &CycloneDXOrganization{Name: obj.Supplier.Organization}
+		}
+	case *spdx.File:
+		entity = &obj.Entity
+		component.Type = cycloneDXTypeFile
+	default:
+		return nil, fmt.Errorf("unsupported SPDX element %s of type %T", o.SPDXID(), o)
+	}
+
+	component.Name = spdxName(o)
+
+	for _, alg := range sortedKeys(entity.Checksum) {
+		cdxAlg, ok := cycloneDXHashAlgorithms[alg]
+		if !ok {
+			logrus.Debugf("Skipping unsupported %s checksum of %s", alg, o.SPDXID())
+
+			continue
+		}
+
+		component.Hashes = append(component.Hashes, CycloneDXHash{Alg: cdxAlg, Content: entity.Checksum[alg]})
+	}
+
+	if entity.LicenseConcluded != "" && entity.LicenseConcluded != spdxNoAssertion {
+		component.Licenses = []CycloneDXLicense{{Expression: entity.LicenseConcluded}}
+	}
+
+	if entity.DownloadLocation != "" && entity.DownloadLocation != spdxNoAssertion {
+		component.ExternalReferences = append(component.ExternalReferences, CycloneDXExternalReference{
+			Type: cycloneDXRefDownload, URL: entity.DownloadLocation,
+		})
+	}
+
+	return component, nil
+}
+
+// spdxObjects returns all packages and files of the document, including the
+// ones only reachable through relationships, sorted by their SPDX ID.
+func spdxObjects(doc *spdx.Document) []spdx.Object {
+	seen := map[string]spdx.Object{}
+
+	var walk func(spdx.Object)
+
+	walk = func(o spdx.Object) {
+		if o == nil || o.SPDXID() == "" {
+			return
+		}
+
+		if _, ok := seen[o.SPDXID()]; ok {
+			return
+		}
+
+		seen[o.SPDXID()] = o
+
+		for _, rel := range *o.GetRelationships() {
+			if rel.PeerExtReference == "" {
+				walk(rel.Peer)
+			}
+		}
+	}
+
+	for _, id := range sortedKeys(doc.Packages) {
+		walk(doc.Packages[id])
+	}
+
+	for _, id := range sortedKeys(doc.Files) {
+		walk(doc.Files[id])
+	}
+
+	objects := make([]spdx.Object, 0, len(seen))
+	for _, id := range sortedKeys(seen) {
+		objects = append(objects, seen[id])
+	}
+
+	return objects
+}
+
+// topLevelSPDXIDs returns the IDs of the elements described by the document.
+func topLevelSPDXIDs(doc *spdx.Document) []string {
+	ids := sortedKeys(doc.Packages)
+
+	return append(ids, sortedKeys(doc.Files)...)
+}
+
+func spdxPeerID(rel *spdx.Relationship) string {
+	if rel.PeerReference != "" {
+		return rel.PeerReference
+	}
+
+	if rel.Peer != nil {
+		return rel.Peer.SPDXID()
+	}
+
+	return ""
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
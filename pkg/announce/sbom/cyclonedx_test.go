@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/bom/pkg/spdx"
+)
+
+func newTestSPDXDocument(t *testing.T) *spdx.Document {
+	t.Helper()
+
+	doc := spdx.NewDocument()
+	doc.Name = "Kubernetes Release v1.30.0"
+	doc.Namespace = "https://sbom.k8s.io/v1.30.0/release"
+	doc.Created = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	doc.Creator.Tool = []string{"bom-v0.7.1"}
+	doc.ExternalDocRefs = []spdx.ExternalDocumentRef{{
+		ID: "kubernetes-v1.30.0", URI: "https://sbom.k8s.io/v1.30.0/source",
+	}}
+
+	layer := spdx.NewPackage()
+	layer.ID = "SPDXRef-Package-layer"
+	layer.Name = "layer.tar"
+	layer.Checksum = map[string]string{"SHA256": "bbb"}
+
+	image := spdx.NewPackage()
+	image.ID = "SPDXRef-Package-kube-apiserver"
+	image.Name = "registry.k8s.io/kube-apiserver"
+	image.Version = "v1.30.0"
+	image.LicenseConcluded = "Apache-2.0"
+	image.PrimaryPurpose = "CONTAINER"
+	image.ExternalRefs = []spdx.ExternalRef{{
+		Category: "PACKAGE-MANAGER",
+		Type:     "purl",
+		Locator:  "pkg:oci/kube-apiserver@sha256%3Aaaa?repository_url=registry.k8s.io",
+	}}
+	image.AddRelationship(&spdx.Relationship{Type: spdx.CONTAINS, Peer: layer})
+	image.AddRelationship(&spdx.Relationship{
+		PeerReference:    "SPDXRef-Package-kubernetes",
+		PeerExtReference: "kubernetes-v1.30.0",
+		Comment:          "Source code",
+		Type:             spdx.GENERATED_FROM,
+	})
+	require.NoError(t, doc.AddPackage(image))
+
+	file := spdx.NewFile()
+	file.ID = "SPDXRef-File-kubectl"
+	file.Name = "bin/linux/amd64/kubectl"
+	file.LicenseConcluded = "Apache-2.0"
+	file.Checksum = map[string]string{"SHA1": "111", "SHA256": "256", "SHA512": "512"}
+	file.AddRelationship(&spdx.Relationship{Type: spdx.DEPENDENCY_OF, PeerReference: image.ID})
+	require.NoError(t, doc.AddFile(file))
+
+	return doc
+}
+
+func TestNewCycloneDXFromSPDX(t *testing.T) {
+	t.Parallel()
+
+	cdx, err := NewCycloneDXFromSPDX(newTestSPDXDocument(t))
+	require.NoError(t, err)
+
+	require.Equal(t, "CycloneDX", cdx.BOMFormat)
+	require.Equal(t, "1.5", cdx.SpecVersion)
+	require.Equal(t, 1, cdx.Version)
+	require.Regexp(t, `^urn:uuid:[0-9a-f-]{36}$`, cdx.SerialNumber)
+	require.Equal(t, "2026-01-02T03:04:05Z", cdx.Metadata.Timestamp)
+	require.Equal(t, "Kubernetes Release v1.30.0", cdx.Metadata.Component.Name)
+	require.Equal(t, "bom-v0.7.1", cdx.Metadata.Tools.Components[0].Name)
+
+	require.Equal(t, []CycloneDXComponent{
+		{
+			BOMRef: "SPDXRef-File-kubectl",
+			Type:   "file",
+			Name:   "bin/linux/amd64/kubectl",
+			Hashes: []CycloneDXHash{
+				{Alg: "SHA-1", Content: "111"},
+				{Alg: "SHA-256", Content: "256"},
+				{Alg: "SHA-512", Content: "512"},
+			},
+			Licenses: []CycloneDXLicense{{Expression: "Apache-2.0"}},
+		},
+		{
+			BOMRef:   "SPDXRef-Package-kube-apiserver",
+			Type:     "container",
+			Name:     "registry.k8s.io/kube-apiserver",
+			Version:  "v1.30.0",
+			Purl:     "pkg:oci/kube-apiserver@sha256%3Aaaa?repository_url=registry.k8s.io",
+			Licenses: []CycloneDXLicense{{Expression: "Apache-2.0"}},
+			ExternalReferences: []CycloneDXExternalReference{{
+				Type:    "bom",
+				URL:     "https://sbom.k8s.io/v1.30.0/source#SPDXRef-Package-kubernetes",
+				Comment: "GENERATED_FROM Source code",
+			}},
+		},
+		{
+			BOMRef: "SPDXRef-Package-layer",
+			Type:   "library",
+			Name:   "layer.tar",
+			Hashes: []CycloneDXHash{{Alg: "SHA-256", Content: "bbb"}},
+		},
+	}, cdx.Components)
+
+	require.Equal(t, []CycloneDXDependency{
+		{Ref: "SPDXRef-DOCUMENT", DependsOn: []string{"SPDXRef-File-kubectl", "SPDXRef-Package-kube-apiserver"}},
+		{Ref: "SPDXRef-Package-kube-apiserver", DependsOn: []string{"SPDXRef-File-kubectl", "SPDXRef-Package-layer"}},
+	}, cdx.Dependencies)
+}
+
+func TestNewCycloneDXFromSPDXFailure(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewCycloneDXFromSPDX(nil)
+	require.Error(t, err)
+
+	doc := newTestSPDXDocument(t)
+	doc.ExternalDocRefs = nil
+	_, err = NewCycloneDXFromSPDX(doc)
+	require.ErrorContains(t, err, "unknown external document")
+
+	doc = newTestSPDXDocument(t)
+	doc.Files["SPDXRef-File-kubectl"].AddRelationship(&spdx.Relationship{
+		Type: spdx.DEPENDS_ON, PeerReference: "SPDXRef-missing",
+	})
+	_, err = NewCycloneDXFromSPDX(doc)
+	require.ErrorContains(t, err, "unknown SPDX element")
+}
+
+func TestVerifyCycloneDXConsistency(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		modify   func(*CycloneDXDocument)
+		errorMsg string
+	}{
+		{
+			name:   "consistent",
+			modify: func(*CycloneDXDocument) {},
+		},
+		{
+			name: "missing component",
+			modify: func(cdx *CycloneDXDocument) {
+				cdx.Components = cdx.Components[1:]
+			},
+			errorMsg: "SPDXRef-File-kubectl (bin/linux/amd64/kubectl) is missing",
+		},
+		{
+			name: "changed checksum",
+			modify: func(cdx *CycloneDXDocument) {
+				cdx.Components[0].Hashes[0].Content = "000"
+			},
+			errorMsg: "checksums of component SPDXRef-File-kubectl do not match",
+		},
+		{
+			name: "changed version",
+			modify: func(cdx *CycloneDXDocument) {
+				cdx.Components[1].Version = "v1.30.1"
+			},
+			errorMsg: "component SPDXRef-Package-kube-apiserver is",
+		},
+		{
+			name: "missing dependency",
+			modify: func(cdx *CycloneDXDocument) {
+				cdx.Dependencies[1].DependsOn = cdx.Dependencies[1].DependsOn[1:]
+			},
+			errorMsg: "dependencies of SPDXRef-Package-kube-apiserver",
+		},
+		{
+			name: "inverted dependency",
+			modify: func(cdx *CycloneDXDocument) {
+				cdx.Dependencies[1] = CycloneDXDependency{
+					Ref: "SPDXRef-File-kubectl", DependsOn: []string{"SPDXRef-Package-kube-apiserver"},
+				}
+				cdx.Dependencies = append(cdx.Dependencies, CycloneDXDependency{
+					Ref: "SPDXRef-Package-kube-apiserver", DependsOn: []string{"SPDXRef-Package-layer"},
+				})
+			},
+			errorMsg: "dependencies of SPDXRef-File-kubectl are [SPDXRef-Package-kube-apiserver] but SPDX relationships are []",
+		},
+		{
+			name: "additional dependency",
+			modify: func(cdx *CycloneDXDocument) {
+				cdx.Dependencies = append(cdx.Dependencies, CycloneDXDependency{
+					Ref: "SPDXRef-Package-layer", DependsOn: []string{"SPDXRef-File-kubectl"},
+				})
+			},
+			errorMsg: "dependencies of SPDXRef-Package-layer are [SPDXRef-File-kubectl] but SPDX relationships are []",
+		},
+		{
+			name: "unknown component",
+			modify: func(cdx *CycloneDXDocument) {
+				cdx.Components = append(cdx.Components, CycloneDXComponent{
+					BOMRef: "SPDXRef-Package-unknown", Type: "library", Name: "unknown",
+				})
+			},
+			errorMsg: "component SPDXRef-Package-unknown (unknown) has no SPDX element",
+		},
+		{
+			name: "broken document",
+			modify: func(cdx *CycloneDXDocument) {
+				*cdx = CycloneDXDocument{
+					BOMFormat:   "CycloneDX",
+					SpecVersion: "1.5",
+					Version:     1,
+					Components: []CycloneDXComponent{
+						{BOMRef: "SPDXRef-File-kubectl", Type: "file", Name: "kubectl"},
+						{BOMRef: "SPDXRef-Package-layer", Type: "library", Name: "layer.tar"},
+					},
+					Dependencies: []CycloneDXDependency{
+						{Ref: "SPDXRef-DOCUMENT", DependsOn: []string{"SPDXRef-Package-layer"}},
+					},
+				}
+			},
+			errorMsg: "SPDX element SPDXRef-Package-kube-apiserver (registry.k8s.io/kube-apiserver) is missing",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := newTestSPDXDocument(t)
+			cdx, err := NewCycloneDXFromSPDX(doc)
+			require.NoError(t, err)
+
+			tc.modify(cdx)
+
+			data, err := json.Marshal(cdx)
+			require.NoError(t, err)
+
+			err = VerifyCycloneDXConsistency(doc, data)
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorContains(t, err, tc.errorMsg)
+		})
+	}
+}
+
+func TestVerifyCycloneDXConsistencyInvalidDocument(t *testing.T) {
+	t.Parallel()
+
+	err := VerifyCycloneDXConsistency(newTestSPDXDocument(t), []byte(`{"components": {}}`))
+	require.ErrorContains(t, err, "decoding CycloneDX document")
+}
+
+func TestCycloneDXDocumentWrite(t *testing.T) {
+	t.Parallel()
+
+	doc := newTestSPDXDocument(t)
+	cdx, err := NewCycloneDXFromSPDX(doc)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "sbom.cdx.json")
+	require.NoError(t, cdx.Write(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	res := &CycloneDXDocument{}
+	require.NoError(t, json.Unmarshal(data, res))
+	require.Equal(t, cdx, res)
+	require.Contains(t, string(data), `"bom-ref": "SPDXRef-Package-kube-apiserver"`)
+	require.NoError(t, VerifyCycloneDXConsistency(doc, data))
+}
@@ -35,6 +35,7 @@ type impl interface {
 	docBuilder() *spdx.DocBuilder
 	spdxClient() *spdx.SPDX
 	writeFile(file string, data []byte) error
+	readFile(file string) ([]byte, error)
 }
 
 func (i *defaultImpl) tmpFile() (string, error) {
@@ -58,3 +59,7 @@ func (i *defaultImpl) spdxClient() *spdx.SPDX {
 func (i *defaultImpl) writeFile(file string, data []byte) error {
 	return os.WriteFile(file, data, 0o600)
 }
+
+func (i *defaultImpl) readFile(file string) ([]byte, error) {
+	return os.ReadFile(file)
+}
@@ -18,6 +18,7 @@ package sbom
 
 const (
 	sbomFileName      = "sbom.spdx"
+	cycloneDXFileName = "sbom.cdx.json"
 	assetDownloadPath = "/releases/download/"
 )
 
@@ -17,6 +17,7 @@ limitations under the License.
 package sbom
 
 import (
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 
@@ -48,10 +49,22 @@ func (s *SBOM) SetImplementation(i impl) {
 
 // Generate creates an SBOM describing the release.
 func (s *SBOM) Generate() (string, error) {
+	sbomFile, _, err := s.generate(false)
+
+	return sbomFile, err
+}
+
+// GenerateWithCycloneDX creates an SPDX SBOM describing the release and
+// converts it into a CycloneDX SBOM. It returns the paths to both files.
+func (s *SBOM) GenerateWithCycloneDX() (spdxFile, cycloneDXFile string, err error) {
+	return s.generate(true)
+}
+
+func (s *SBOM) generate(withCycloneDX bool) (sbomFile, cycloneDXFile string, err error) {
 	// Create a temporary file to write the sbom
-	sbomFile, err := s.tmpFile()
+	sbomFile, err = s.tmpFile()
 	if err != nil {
-		return "", fmt.Errorf("setting up temporary file for SBOM: %w", err)
+		return "", "", fmt.Errorf("setting up temporary file for SBOM: %w", err)
 	}
 
 	logrus.Infof("SBOM will be temporarily written to %s", sbomFile)
@@ -67,7 +80,7 @@ func (s *SBOM) Generate() (string, error) {
 
 	doc, err := builder.Generate(builderOpts)
 	if err != nil {
-		return "", fmt.Errorf("generating initial SBOM: %w", err)
+		return "", "", fmt.Errorf("generating initial SBOM: %w", err)
 	}
 
 	// Add the download location and version to the first
@@ -87,7 +100,7 @@ func (s *SBOM) Generate() (string, error) {
 
 		spdxFile, err := spdxClient.FileFromPath(f.ReadFrom)
 		if err != nil {
-			return "", fmt.Errorf("adding %s to SBOM: %w", f.ReadFrom, err)
+			return "", "", fmt.Errorf("adding %s to SBOM: %w", f.ReadFrom, err)
 		}
 
 		spdxFile.Name = f.Path
@@ -97,7 +110,7 @@ func (s *SBOM) Generate() (string, error) {
 			s.options.Repo, assetDownloadPath, s.options.Tag, f.Path,
 		)
 		if err := doc.AddFile(spdxFile); err != nil {
-			return "", fmt.Errorf("adding %s as SPDX file to SBOM: %w", f.ReadFrom, err)
+			return "", "", fmt.Errorf("adding %s as SPDX file to SBOM: %w", f.ReadFrom, err)
 		}
 	}
 
@@ -109,17 +122,46 @@ func (s *SBOM) Generate() (string, error) {
 	case FormatTagValue:
 		renderer = &serialize.TagValue{}
 	default:
-		return "", fmt.Errorf("invalid SBOM format, must be one of %s, %s", FormatJSON, FormatTagValue)
+		return "", "", fmt.Errorf("invalid SBOM format, must be one of %s, %s", FormatJSON, FormatTagValue)
 	}
 
 	markup, err := renderer.Serialize(doc)
 	if err != nil {
-		return "", fmt.Errorf("serializing sbom: %w", err)
+		return "", "", fmt.Errorf("serializing sbom: %w", err)
 	}
 
 	if err := s.writeFile(sbomFile, []byte(markup)); err != nil {
-		return "", fmt.Errorf("writing sbom to disk: %w", err)
+		return "", "", fmt.Errorf("writing sbom to disk: %w", err)
+	}
+
+	if !withCycloneDX {
+		return sbomFile, "", nil
+	}
+
+	cdx, err := NewCycloneDXFromSPDX(doc)
+	if err != nil {
+		return "", "", fmt.Errorf("converting SBOM to CycloneDX: %w", err)
+	}
+
+	cdxMarkup, err := json.MarshalIndent(cdx, "", "  ")
+	if err != nil {
+		return "", "", fmt.Errorf("serializing CycloneDX sbom: %w", err)
+	}
+
+	cycloneDXFile = filepath.Join(filepath.Dir(sbomFile), cycloneDXFileName)
+	if err := s.writeFile(cycloneDXFile, cdxMarkup); err != nil {
+		return "", "", fmt.Errorf("writing CycloneDX sbom to disk: %w", err)
+	}
+
+	// Verify the document as it has been written to disk
+	written, err := s.readFile(cycloneDXFile)
+	if err != nil {
+		return "", "", fmt.Errorf("reading CycloneDX sbom from disk: %w", err)
+	}
+
+	if err := VerifyCycloneDXConsistency(doc, written); err != nil {
+		return "", "", fmt.Errorf("verifying CycloneDX SBOM: %w", err)
 	}
 
-	return sbomFile, nil
+	return sbomFile, cycloneDXFile, nil
 }
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package announce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+)
+
+// SlackNotifier posts announcements to a Slack incoming webhook.
+type SlackNotifier struct {
+	webhookURL string
+	client     *http.Client
+}
+
+// NewSlackNotifier creates a new SlackNotifier for the webhook URL.
+func NewSlackNotifier(webhookURL string) *SlackNotifier {
+	return &SlackNotifier{
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: notifierTimeout},
+	}
+}
+
+// Name returns the channel name used for reporting.
+func (s *SlackNotifier) Name() string {
+	return "Slack"
+}
+
+// Notify posts the announcement using Slack mrkdwn formatting.
+func (s *SlackNotifier) Notify(ctx context.Context, msg *Message) error {
+	return postJSON(ctx, s.client, s.webhookURL, nil, map[string]string{
+		"text": s.Render(msg),
+	})
+}
+
+// Render returns the announcement in Slack mrkdwn formatting.
+func (s *SlackNotifier) Render(msg *Message) string {
+	return SlackText(msg)
+}
+
+// SlackText renders the announcement using Slack mrkdwn formatting.
+func SlackText(msg *Message) string {
+	return fmt.Sprintf(
+		":kubernetes: *Kubernetes %s is live!*\n"+
+			"It has been built and pushed using Golang version *%s*.\n"+
+			"Release notes: <%s|%s> | <%s|GitHub release>",
+		msg.Tag, msg.GoVersion,
+		msg.ChangelogURL(), filepath.Base(msg.ChangelogPath), msg.ReleaseURL(),
+	)
+}
@@ -21,7 +21,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
-	"sigs.k8s.io/release-sdk/object"
+	"k8s.io/release/pkg/objectstore"
 )
 
 var DefaultExtraVersionMarkers = []string{}
@@ -29,7 +29,7 @@ var DefaultExtraVersionMarkers = []string{}
 // Instance is the main structure for creating and pushing builds.
 type Instance struct {
 	opts     *Options
-	objStore object.GCS
+	objStore objectstore.Store
 }
 
 // NewInstance can be used to create a new build `Instance`.
@@ -37,7 +37,7 @@ type Instance struct {
 func NewInstance(opts *Options) *Instance {
 	instance := &Instance{
 		opts:     opts,
-		objStore: *object.NewGCS(),
+		objStore: objectstore.New(opts.Bucket),
 	}
 
 	instance.setBuildType()
@@ -50,6 +50,10 @@ func NewInstance(opts *Options) *Instance {
 // Options are the main options to pass to `Instance`.
 type Options struct {
 	// Specify an alternate bucket for pushes (normally 'devel' or 'ci').
+	// The storage backend is selected by the URL scheme of the bucket:
+	// `file:///path/to/dir` uses a local directory, `s3://bucket` an S3
+	// compatible object storage like MinIO and everything else Google Cloud
+	// Storage.
 	Bucket string
 
 	// Specify an alternate build directory (relative to RepoRoot). Will be automatically determined
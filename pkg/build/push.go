@@ -17,7 +17,6 @@ limitations under the License.
 package build
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -25,7 +24,6 @@ import (
 	"regexp"
 	"strings"
 
-	"cloud.google.com/go/storage"
 	"github.com/sirupsen/logrus"
 
 	"sigs.k8s.io/release-utils/helpers"
@@ -251,42 +249,10 @@ func setupBuildDir(bi *Instance) {
 }
 
 // CheckReleaseBucket verifies that a release bucket exists and the current
-// authenticated GCP user has write permissions to it.
+// user has write permissions to it.
 func (bi *Instance) CheckReleaseBucket() error {
-	logrus.Infof("Checking bucket %s for write permissions", bi.opts.Bucket)
-
-	client, err := storage.NewClient(context.Background())
-	if err != nil {
-		return fmt.Errorf(
-			"fetching gcloud credentials, try running "+
-				`"gcloud auth application-default login"`+
-				": %w",
-			err,
-		)
-	}
-
-	bucket := client.Bucket(bi.opts.Bucket)
-	if bucket == nil {
-		return fmt.Errorf(
-			"identify specified bucket for artifacts: %s", bi.opts.Bucket,
-		)
-	}
-
-	// Check if bucket exists and user has permissions
-	requiredGCSPerms := []string{"storage.objects.create"}
-
-	perms, err := bucket.IAM().TestPermissions(
-		context.Background(), requiredGCSPerms,
-	)
-	if err != nil {
-		return fmt.Errorf("find release artifact bucket, try running `gcloud auth application-default login`: %w", err)
-	}
-
-	if len(perms) != 1 {
-		return fmt.Errorf(
-			"GCP user must have at least %s permissions on bucket %s",
-			requiredGCSPerms, bi.opts.Bucket,
-		)
+	if err := bi.objStore.CheckWriteAccess(bi.opts.Bucket); err != nil {
+		return fmt.Errorf("check write access to bucket: %w", err)
 	}
 
 	return nil
@@ -349,8 +315,9 @@ func (bi *Instance) StageLocalArtifacts() error {
 
 	// Write the bill of materials manifests
 	for filename, sbom := range map[string]string{
-		"kubernetes-source.spdx":  filepath.Join(os.TempDir(), fmt.Sprintf("source-bom-%s.spdx", bi.opts.Version)),
-		"kubernetes-release.spdx": filepath.Join(os.TempDir(), fmt.Sprintf("release-bom-%s.spdx", bi.opts.Version)),
+		"kubernetes-source.spdx":      filepath.Join(os.TempDir(), fmt.Sprintf("source-bom-%s.spdx", bi.opts.Version)),
+		"kubernetes-release.spdx":     filepath.Join(os.TempDir(), fmt.Sprintf("release-bom-%s.spdx", bi.opts.Version)),
+		"kubernetes-release.cdx.json": filepath.Join(os.TempDir(), fmt.Sprintf("release-bom-%s.cdx.json", bi.opts.Version)),
 	} {
 		if err := helpers.CopyFileLocal(
 			sbom, filepath.Join(stageDir, filename), false,
@@ -49,6 +49,7 @@ type Options struct {
 	RecordDir     string
 	ReplayDir     string
 	CVEDataDir    string
+	MapProviders  []string
 	CloneCVEMaps  bool
 	Dependencies  bool
 	IncludeLabels []string
@@ -282,6 +283,9 @@ func (c *Changelog) generateReleaseNotes(
 	notesOptions.Pull = false
 	notesOptions.AddMarkdownLinks = true
 	notesOptions.IncludeLabels = c.options.IncludeLabels
+	notesOptions.MapProviderStrings = append(
+		notesOptions.MapProviderStrings, c.options.MapProviders...,
+	)
 
 	if c.options.CVEDataDir != "" {
 		notesOptions.MapProviderStrings = append(
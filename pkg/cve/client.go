@@ -17,9 +17,11 @@ limitations under the License.
 package cve
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"sigs.k8s.io/release-sdk/object"
 
@@ -47,11 +49,18 @@ type Client struct {
 type ClientOptions struct {
 	Bucket    string
 	Directory string
+
+	// OSVAPIURL and CVEAPIURL are the endpoints to fetch vulnerability
+	// records from.
+	OSVAPIURL string
+	CVEAPIURL string
 }
 
 var cveDefaultOpts = ClientOptions{
 	Bucket:    Bucket,
 	Directory: Directory,
+	OSVAPIURL: OSVAPIURL,
+	CVEAPIURL: CVEAPIURL,
 }
 
 func NewClient() *Client {
@@ -118,3 +127,24 @@ func (c *Client) CreateEmptyMap(cve string) (file *os.File, err error) {
 func (c *Client) EntryExists(cveID string) (bool, error) {
 	return c.impl.EntryExists(cveID, &c.options)
 }
+
+// Import converts a vulnerability record into CVE data. The source is either
+// the path to a local OSV or CVE JSON 5.0 record or the ID of the record to
+// download.
+func (c *Client) Import(source string) (*CVE, error) {
+	data, err := os.ReadFile(source)
+	if errors.Is(err, os.ErrNotExist) && !strings.ContainsRune(source, os.PathSeparator) {
+		data, err = c.impl.FetchRecord(source, &c.options)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading record %s: %w", source, err)
+	}
+
+	cve, err := ParseRecord(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing record %s: %w", source, err)
+	}
+
+	return cve, nil
+}
@@ -40,38 +40,51 @@ type CVE struct {
 // ReadRawInterface populates the CVE data struct from the raw array
 // as returned by the YAML parser.
 func (cve *CVE) ReadRawInterface(cvedata any) error {
-	if val, ok := cvedata.(map[any]any)["id"].(string); ok {
+	data, err := rawMap(cvedata)
+	if err != nil {
+		return err
+	}
+
+	if val, ok := data["id"].(string); ok {
 		cve.ID = val
 	}
 
-	if val, ok := cvedata.(map[any]any)["title"].(string); ok {
+	if val, ok := data["title"].(string); ok {
 		cve.Title = val
 	}
 
-	if val, ok := cvedata.(map[any]any)["issue"].(string); ok {
+	if val, ok := data["issue"].(string); ok {
 		cve.TrackingIssue = val
 	}
 
-	if val, ok := cvedata.(map[any]any)["vector"].(string); ok {
+	if val, ok := data["vector"].(string); ok {
 		cve.CVSSVector = val
 	}
 
-	if val, ok := cvedata.(map[any]any)["score"].(float64); ok {
+	switch val := data["score"].(type) {
+	case float64:
+		cve.CVSSScore = float32(val)
+	case int:
 		cve.CVSSScore = float32(val)
 	}
 
-	if val, ok := cvedata.(map[any]any)["rating"].(string); ok {
+	if val, ok := data["rating"].(string); ok {
 		cve.CVSSRating = val
 	}
 
-	if val, ok := cvedata.(map[any]any)["description"].(string); ok {
+	if val, ok := data["description"].(string); ok {
 		cve.Description = val
 	}
 	// Linked PRs is a list of the PR IDs
-	if val, ok := cvedata.(map[any]any)["linkedPRs"].([]any); ok {
+	prs, ok := data["pullrequests"].([]any)
+	if !ok {
+		prs, ok = data["linkedPRs"].([]any)
+	}
+
+	if ok {
 		cve.LinkedPRs = []int{}
 
-		for _, prid := range val {
+		for _, prid := range prs {
 			if prid, ok := prid.(int); ok {
 				cve.LinkedPRs = append(cve.LinkedPRs, prid)
 			}
@@ -81,6 +94,69 @@ func (cve *CVE) ReadRawInterface(cvedata any) error {
 	return nil
 }
 
+// rawMap converts the raw CVE data to a map, independently of the key type
+// returned by the YAML parser.
+func rawMap(cvedata any) (map[string]any, error) {
+	switch data := cvedata.(type) {
+	case map[string]any:
+		return data, nil
+	case map[any]any:
+		res := make(map[string]any, len(data))
+
+		for k, v := range data {
+			if key, ok := k.(string); ok {
+				res[key] = v
+			}
+		}
+
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unexpected CVE data type %T", cvedata)
+	}
+}
+
+// scoredMetrics are CVSS metrics which provide a score.
+type scoredMetrics interface {
+	cvss.Metrics
+	Score() float64
+	Severity() cvss.Severity
+}
+
+// parseVector parses a CVSS base or temporal vector string.
+func parseVector(vector string) (scoredMetrics, error) {
+	// Parse the vector string to make sure it is well formed
+	if len(vector) == 44 {
+		return cvss.NewBase().Decode(vector)
+	}
+
+	return cvss.NewTemporal().Decode(vector)
+}
+
+// calcLink returns the link to the CVSS calculator of the vector.
+func calcLink(bm cvss.Metrics, vector string) string {
+	return fmt.Sprintf(
+		"https://www.first.org/cvss/calculator/%s#%s", bm.BaseMetrics().Ver.String(), vector,
+	)
+}
+
+// CalculateScore sets the CVSS score and rating computed from the vector.
+func (cve *CVE) CalculateScore() error {
+	if cve.CVSSVector == "" {
+		return errors.New("string CVSS vector missing from CVE data")
+	}
+
+	bm, err := parseVector(cve.CVSSVector)
+	if err != nil {
+		return fmt.Errorf("parsing CVSS vector string: %w", err)
+	}
+
+	cve.CVSSScore = float32(bm.Score())
+	cve.CVSSRating = bm.Severity().String()
+	cve.CalcLink = calcLink(bm, cve.CVSSVector)
+
+	return nil
+}
+
 // Validate checks the data defined in a CVE map is complete and valid.
 func (cve *CVE) Validate() (err error) {
 	// Verify that rating is defined and a known string
@@ -100,21 +176,12 @@ func (cve *CVE) Validate() (err error) {
 		return errors.New("string CVSS vector missing from CVE data")
 	}
 
-	var bm cvss.Metrics
-	// Parse the vector string to make sure it is well formed
-	if len(cve.CVSSVector) == 44 {
-		bm, err = cvss.NewBase().Decode(cve.CVSSVector)
-	} else {
-		bm, err = cvss.NewTemporal().Decode(cve.CVSSVector)
-	}
-
+	bm, err := parseVector(cve.CVSSVector)
 	if err != nil {
 		return fmt.Errorf("parsing CVSS vector string: %w", err)
 	}
 
-	cve.CalcLink = fmt.Sprintf(
-		"https://www.first.org/cvss/calculator/%s#%s", bm.BaseMetrics().Ver.String(), cve.CVSSVector,
-	)
+	cve.CalcLink = calcLink(bm, cve.CVSSVector)
 
 	if cve.CVSSScore == 0 {
 		return errors.New("missing CVSS score from CVE data")
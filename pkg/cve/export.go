@@ -0,0 +1,484 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cve
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/sirupsen/logrus"
+
+	khttp "sigs.k8s.io/release-utils/http"
+
+	"k8s.io/release/pkg/notes"
+)
+
+const (
+	// ReleaseNotesIndexURL is the index of the published release notes JSON
+	// files by release tag.
+	ReleaseNotesIndexURL = "https://dl.k8s.io/release/release-notes-index.json"
+
+	// FormatOSV is the OSV JSON advisory format.
+	FormatOSV = "osv"
+
+	// FormatCSAF is the CSAF 2.0 advisory format.
+	FormatCSAF = "csaf"
+
+	osvSchemaVersion = "1.6.0"
+	osvEcosystem     = "Go"
+	osvPackageName   = "k8s.io/kubernetes"
+	osvPackagePURL   = "pkg:golang/k8s.io/kubernetes"
+
+	csafProductName   = "Kubernetes"
+	csafPublisherName = "Kubernetes Security Response Committee"
+	csafNamespace     = "https://kubernetes.io"
+
+	githubPRURL = "https://github.com/" + githubRepo + "/pull/"
+)
+
+// AffectedRange is a range of affected versions, which is fixed in the
+// release Fixed. Introduced is "0" for the oldest range.
+type AffectedRange struct {
+	Introduced string `json:"introduced"`
+	Fixed      string `json:"fixed"`
+}
+
+// ReleaseNotesForTags is the release notes of every release tag.
+type ReleaseNotesForTags map[string]*notes.ReleaseNotes
+
+// LoadReleaseNotes reads the release notes of all tags listed in the release
+// notes index, which maps every tag to the location of its release notes
+// JSON. Locations can be URLs or paths relative to a local index. Tags below
+// minVersion are skipped if it is set.
+func LoadReleaseNotes(index string, minVersion *semver.Version) (ReleaseNotesForTags, error) {
+	data, err := readLocation(index)
+	if err != nil {
+		return nil, fmt.Errorf("reading release notes index: %w", err)
+	}
+
+	locations := map[string]string{}
+	if err := json.Unmarshal(data, &locations); err != nil {
+		return nil, fmt.Errorf("unmarshal release notes index: %w", err)
+	}
+
+	releases := ReleaseNotesForTags{}
+
+	for tag, location := range locations {
+		version, err := semver.ParseTolerant(tag)
+		if err != nil {
+			logrus.Warnf("Skipping release notes of invalid tag %s: %v", tag, err)
+
+			continue
+		}
+
+		if minVersion != nil && version.LT(*minVersion) {
+			continue
+		}
+
+		if !isURL(location) && !filepath.IsAbs(location) && !isURL(index) {
+			location = filepath.Join(filepath.Dir(index), location)
+		}
+
+		logrus.Debugf("Reading release notes of %s from %s", tag, location)
+
+		data, err := readLocation(location)
+		if err != nil {
+			return nil, fmt.Errorf("reading release notes of %s: %w", tag, err)
+		}
+
+		releaseNotes, err := notes.ParseReleaseNotesJSON(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("parsing release notes of %s: %w", tag, err)
+		}
+
+		releases[tag] = releaseNotes
+	}
+
+	return releases, nil
+}
+
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "https://") || strings.HasPrefix(location, "http://")
+}
+
+// readLocation reads a local file or downloads the URL.
+func readLocation(location string) ([]byte, error) {
+	if isURL(location) {
+		return khttp.NewAgent().WithTimeout(fetchTimeout).Get(location)
+	}
+
+	return os.ReadFile(location)
+}
+
+// FixedVersions returns the first release of every minor version whose
+// release notes contain one of the linked PRs of the CVE, in ascending
+// order.
+func (r ReleaseNotesForTags) FixedVersions(cve *CVE) []semver.Version {
+	firstFixed := map[string]semver.Version{}
+
+	for tag, releaseNotes := range r {
+		version, err := semver.ParseTolerant(tag)
+		if err != nil {
+			continue
+		}
+
+		if !slices.ContainsFunc(cve.LinkedPRs, func(pr int) bool {
+			return releaseNotes.Get(pr) != nil
+		}) {
+			continue
+		}
+
+		minor := fmt.Sprintf("%d.%d", version.Major, version.Minor)
+		if fixed, ok := firstFixed[minor]; !ok || version.LT(fixed) {
+			firstFixed[minor] = version
+		}
+	}
+
+	versions := make([]semver.Version, 0, len(firstFixed))
+	for _, v := range firstFixed {
+		versions = append(versions, v)
+	}
+
+	slices.SortFunc(versions, func(a, b semver.Version) int { return a.Compare(b) })
+
+	return versions
+}
+
+// AffectedRanges returns the affected version ranges for the fixed
+// versions. All versions before the oldest fix are affected, as well as
+// every minor version up to its first fixed release.
+func AffectedRanges(fixed []semver.Version) []AffectedRange {
+	ranges := make([]AffectedRange, 0, len(fixed))
+
+	for i, v := range fixed {
+		introduced := "0"
+		if i > 0 {
+			// The lowest version of the minor, including its pre-releases
+			introduced = fmt.Sprintf("%d.%d.0-0", v.Major, v.Minor)
+		}
+
+		ranges = append(ranges, AffectedRange{Introduced: introduced, Fixed: v.String()})
+	}
+
+	return ranges
+}
+
+// cveReferences returns the tracking issue and linked pull request URLs.
+func cveReferences(cve *CVE) (issue string, prs []string) {
+	prs = make([]string, 0, len(cve.LinkedPRs))
+	for _, pr := range cve.LinkedPRs {
+		prs = append(prs, fmt.Sprintf("%s%d", githubPRURL, pr))
+	}
+
+	return cve.TrackingIssue, prs
+}
+
+type osvAdvisory struct {
+	SchemaVersion string         `json:"schema_version"`
+	ID            string         `json:"id"`
+	Modified      string         `json:"modified"`
+	Published     string         `json:"published"`
+	Summary       string         `json:"summary"`
+	Details       string         `json:"details"`
+	Severity      []osvSeverity  `json:"severity"`
+	Affected      []osvAffected  `json:"affected"`
+	References    []osvReference `json:"references"`
+}
+
+type osvSeverity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+type osvAffected struct {
+	Package struct {
+		Ecosystem string `json:"ecosystem"`
+		Name      string `json:"name"`
+		PURL      string `json:"purl"`
+	} `json:"package"`
+	Ranges []osvRange `json:"ranges"`
+}
+
+type osvRange struct {
+	Type   string              `json:"type"`
+	Events []map[string]string `json:"events"`
+}
+
+type osvReference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// ExportOSV returns the CVE as OSV advisory.
+func ExportOSV(cve *CVE, ranges []AffectedRange, date time.Time) ([]byte, error) {
+	timestamp := date.UTC().Format(time.RFC3339)
+	advisory := osvAdvisory{
+		SchemaVersion: osvSchemaVersion,
+		ID:            cve.ID,
+		Modified:      timestamp,
+		Published:     timestamp,
+		Summary:       cve.Title,
+		Details:       cve.Description,
+		Severity:      []osvSeverity{{Type: osvSeverityCVSSV3, Score: cve.CVSSVector}},
+		Affected:      []osvAffected{},
+		References:    []osvReference{},
+	}
+
+	if len(ranges) > 0 {
+		semverRange := osvRange{Type: "SEMVER"}
+		for _, r := range ranges {
+			semverRange.Events = append(semverRange.Events,
+				map[string]string{"introduced": r.Introduced},
+				map[string]string{"fixed": r.Fixed},
+			)
+		}
+
+		affected := osvAffected{Ranges: []osvRange{semverRange}}
+		affected.Package.Ecosystem = osvEcosystem
+		affected.Package.Name = osvPackageName
+		affected.Package.PURL = osvPackagePURL
+		advisory.Affected = append(advisory.Affected, affected)
+	}
+
+	issue, prs := cveReferences(cve)
+	if issue != "" {
+		advisory.References = append(advisory.References, osvReference{Type: "REPORT", URL: issue})
+	}
+
+	for _, pr := range prs {
+		advisory.References = append(advisory.References, osvReference{Type: "FIX", URL: pr})
+	}
+
+	return marshalAdvisory(advisory)
+}
+
+type csafAdvisory struct {
+	Document        csafDocument        `json:"document"`
+	ProductTree     csafProductTree     `json:"product_tree"`
+	Vulnerabilities []csafVulnerability `json:"vulnerabilities"`
+}
+
+type csafDocument struct {
+	Category    string            `json:"category"`
+	CSAFVersion string            `json:"csaf_version"`
+	Publisher   csafPublisherInfo `json:"publisher"`
+	Title       string            `json:"title"`
+	Tracking    csafTracking      `json:"tracking"`
+}
+
+type csafPublisherInfo struct {
+	Category  string `json:"category"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+type csafTracking struct {
+	ID                 string         `json:"id"`
+	Status             string         `json:"status"`
+	Version            string         `json:"version"`
+	InitialReleaseDate string         `json:"initial_release_date"`
+	CurrentReleaseDate string         `json:"current_release_date"`
+	RevisionHistory    []csafRevision `json:"revision_history"`
+}
+
+type csafRevision struct {
+	Date    string `json:"date"`
+	Number  string `json:"number"`
+	Summary string `json:"summary"`
+}
+
+type csafReference struct {
+	Category string `json:"category"`
+	Summary  string `json:"summary"`
+	URL      string `json:"url"`
+}
+
+type csafProductTree struct {
+	Branches []csafBranch `json:"branches"`
+}
+
+type csafBranch struct {
+	Category string       `json:"category"`
+	Name     string       `json:"name"`
+	Branches []csafBranch `json:"branches,omitempty"`
+	Product  *csafProduct `json:"product,omitempty"`
+}
+
+type csafProduct struct {
+	Name      string `json:"name"`
+	ProductID string `json:"product_id"`
+}
+
+type csafVulnerability struct {
+	CVE           string              `json:"cve"`
+	Title         string              `json:"title"`
+	Notes         []csafNote          `json:"notes"`
+	ProductStatus map[string][]string `json:"product_status"`
+	Scores        []csafScore         `json:"scores"`
+	Remediations  []csafRemediation   `json:"remediations,omitempty"`
+	References    []csafReference     `json:"references,omitempty"`
+}
+
+type csafNote struct {
+	Category string `json:"category"`
+	Text     string `json:"text"`
+}
+
+type csafScore struct {
+	Products []string `json:"products"`
+	CVSSV3   struct {
+		Version      string  `json:"version"`
+		VectorString string  `json:"vectorString"`
+		BaseScore    float64 `json:"baseScore"`
+		BaseSeverity string  `json:"baseSeverity"`
+	} `json:"cvss_v3"`
+}
+
+type csafRemediation struct {
+	Category   string   `json:"category"`
+	Details    string   `json:"details"`
+	ProductIDs []string `json:"product_ids"`
+}
+
+// ExportCSAF returns the CVE as CSAF 2.0 security advisory.
+func ExportCSAF(cve *CVE, ranges []AffectedRange, date time.Time) ([]byte, error) {
+	timestamp := date.UTC().Format(time.RFC3339)
+	advisory := csafAdvisory{
+		Document: csafDocument{
+			Category:    "csaf_security_advisory",
+			CSAFVersion: "2.0",
+			Publisher: csafPublisherInfo{
+				Category:  "vendor",
+				Name:      csafPublisherName,
+				Namespace: csafNamespace,
+			},
+			Title: cve.Title,
+			Tracking: csafTracking{
+				ID:                 cve.ID,
+				Status:             "final",
+				Version:            "1",
+				InitialReleaseDate: timestamp,
+				CurrentReleaseDate: timestamp,
+				RevisionHistory: []csafRevision{
+					{Date: timestamp, Number: "1", Summary: "Initial release"},
+				},
+			},
+		},
+	}
+
+	versions := []csafBranch{}
+	affectedIDs := []string{}
+	fixedIDs := []string{}
+
+	for _, r := range ranges {
+		affectedID := "kubernetes-affected-" + r.Fixed
+		fixedID := "kubernetes-" + r.Fixed
+
+		vers := "vers:semver/<" + r.Fixed
+		if r.Introduced != "0" {
+			vers = fmt.Sprintf("vers:semver/>=%s|<%s", r.Introduced, r.Fixed)
+		}
+
+		versions = append(versions,
+			csafBranch{
+				Category: "product_version_range",
+				Name:     vers,
+				Product:  &csafProduct{Name: csafProductName + " " + vers, ProductID: affectedID},
+			},
+			csafBranch{
+				Category: "product_version",
+				Name:     r.Fixed,
+				Product:  &csafProduct{Name: csafProductName + " " + r.Fixed, ProductID: fixedID},
+			},
+		)
+		affectedIDs = append(affectedIDs, affectedID)
+		fixedIDs = append(fixedIDs, fixedID)
+	}
+
+	advisory.ProductTree.Branches = []csafBranch{{
+		Category: "vendor",
+		Name:     csafProductName,
+		Branches: []csafBranch{{
+			Category: "product_name",
+			Name:     csafProductName,
+			Branches: versions,
+		}},
+	}}
+
+	bm, err := parseVector(cve.CVSSVector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CVSS vector string: %w", err)
+	}
+
+	score := csafScore{Products: affectedIDs}
+	score.CVSSV3.Version = bm.BaseMetrics().Ver.String()
+	score.CVSSV3.VectorString = cve.CVSSVector
+	score.CVSSV3.BaseScore = bm.BaseMetrics().Score()
+	score.CVSSV3.BaseSeverity = strings.ToUpper(bm.BaseMetrics().Severity().String())
+
+	vulnerability := csafVulnerability{
+		CVE:   cve.ID,
+		Title: cve.Title,
+		Notes: []csafNote{{Category: "description", Text: cve.Description}},
+		ProductStatus: map[string][]string{
+			"known_affected": affectedIDs,
+			"fixed":          fixedIDs,
+		},
+		Scores: []csafScore{score},
+	}
+
+	if len(fixedIDs) > 0 {
+		vulnerability.Remediations = []csafRemediation{{
+			Category:   "vendor_fix",
+			Details:    "Upgrade to a fixed release of the minor version",
+			ProductIDs: affectedIDs,
+		}}
+	}
+
+	issue, prs := cveReferences(cve)
+	if issue != "" {
+		vulnerability.References = append(vulnerability.References, csafReference{
+			Category: "external", Summary: "Tracking issue", URL: issue,
+		})
+	}
+
+	for _, pr := range prs {
+		vulnerability.References = append(vulnerability.References, csafReference{
+			Category: "external", Summary: "Fix", URL: pr,
+		})
+	}
+
+	advisory.Vulnerabilities = []csafVulnerability{vulnerability}
+
+	return marshalAdvisory(advisory)
+}
+
+func marshalAdvisory(advisory any) ([]byte, error) {
+	data, err := json.MarshalIndent(advisory, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal advisory: %w", err)
+	}
+
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cve
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// writeReleaseNotes writes a release notes index and the release notes JSON
+// of every tag, which contains the notes of the PRs.
+func writeReleaseNotes(t *testing.T, tags map[string][]int) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	index := map[string]string{}
+
+	for tag, prs := range tags {
+		byPR := map[string]any{}
+		for _, pr := range prs {
+			byPR[fmt.Sprint(pr)] = map[string]any{"pr_number": pr, "text": fmt.Sprintf("Fix %d", pr)}
+		}
+
+		data, err := json.Marshal(byPR)
+		require.NoError(t, err)
+
+		name := filepath.Join("release-notes", tag+".json")
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "release-notes"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0o600))
+		index[tag] = name
+	}
+
+	data, err := json.Marshal(index)
+	require.NoError(t, err)
+
+	indexPath := filepath.Join(dir, "release-notes-index.json")
+	require.NoError(t, os.WriteFile(indexPath, data, 0o600))
+
+	return indexPath
+}
+
+func testExportCVE(t *testing.T) *CVE {
+	t.Helper()
+
+	cve, err := ParseRecord([]byte(testOSVRecord))
+	require.NoError(t, err)
+
+	return cve
+}
+
+func TestFixedVersions(t *testing.T) {
+	index := writeReleaseNotes(t, map[string][]int{
+		"v1.26.11": {121881},
+		"v1.27.7":  {1},
+		"v1.27.8":  {121882},
+		"v1.27.9":  {121882},
+		"v1.28.4":  {121881, 2},
+		"v1.29.0":  {121881},
+		"v1.25.16": {3},
+	})
+
+	releases, err := LoadReleaseNotes(index, nil)
+	require.NoError(t, err)
+	require.Len(t, releases, 7)
+
+	fixed := releases.FixedVersions(testExportCVE(t))
+	require.Equal(t, []semver.Version{
+		semver.MustParse("1.26.11"),
+		semver.MustParse("1.27.8"),
+		semver.MustParse("1.28.4"),
+		semver.MustParse("1.29.0"),
+	}, fixed)
+
+	require.Equal(t, []AffectedRange{
+		{Introduced: "0", Fixed: "1.26.11"},
+		{Introduced: "1.27.0-0", Fixed: "1.27.8"},
+		{Introduced: "1.28.0-0", Fixed: "1.28.4"},
+		{Introduced: "1.29.0-0", Fixed: "1.29.0"},
+	}, AffectedRanges(fixed))
+
+	minVersion := semver.MustParse("1.28.0")
+	releases, err = LoadReleaseNotes(index, &minVersion)
+	require.NoError(t, err)
+	require.Len(t, releases, 2)
+	require.Empty(t, releases.FixedVersions(&CVE{LinkedPRs: []int{1}}))
+
+	_, err = LoadReleaseNotes(filepath.Join(t.TempDir(), "missing.json"), nil)
+	require.Error(t, err)
+}
+
+func TestExportOSV(t *testing.T) {
+	date := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	ranges := []AffectedRange{
+		{Introduced: "0", Fixed: "1.27.8"},
+		{Introduced: "1.28.0-0", Fixed: "1.28.4"},
+	}
+
+	data, err := ExportOSV(testExportCVE(t), ranges, date)
+	require.NoError(t, err)
+
+	advisory := map[string]any{}
+	require.NoError(t, json.Unmarshal(data, &advisory))
+
+	require.Equal(t, "CVE-2023-5528", advisory["id"])
+	require.Equal(t, "2026-01-02T03:04:05Z", advisory["modified"])
+	require.Equal(t, "Insufficient input sanitization on Windows nodes", advisory["summary"])
+	require.Equal(t, []any{map[string]any{
+		"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H",
+	}}, advisory["severity"])
+	require.Equal(t, []any{map[string]any{
+		"package": map[string]any{
+			"ecosystem": "Go",
+			"name":      "k8s.io/kubernetes",
+			"purl":      "pkg:golang/k8s.io/kubernetes",
+		},
+		"ranges": []any{map[string]any{
+			"type": "SEMVER",
+			"events": []any{
+				map[string]any{"introduced": "0"},
+				map[string]any{"fixed": "1.27.8"},
+				map[string]any{"introduced": "1.28.0-0"},
+				map[string]any{"fixed": "1.28.4"},
+			},
+		}},
+	}}, advisory["affected"])
+	require.Equal(t, []any{
+		map[string]any{"type": "REPORT", "url": "https://github.com/kubernetes/kubernetes/issues/121879"},
+		map[string]any{"type": "FIX", "url": "https://github.com/kubernetes/kubernetes/pull/121881"},
+		map[string]any{"type": "FIX", "url": "https://github.com/kubernetes/kubernetes/pull/121882"},
+	}, advisory["references"])
+
+	// No fixed release found
+	data, err = ExportOSV(testExportCVE(t), nil, date)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"affected": []`)
+}
+
+func TestExportCSAF(t *testing.T) {
+	date := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	ranges := []AffectedRange{
+		{Introduced: "0", Fixed: "1.27.8"},
+		{Introduced: "1.28.0-0", Fixed: "1.28.4"},
+	}
+
+	data, err := ExportCSAF(testExportCVE(t), ranges, date)
+	require.NoError(t, err)
+
+	advisory := csafAdvisory{}
+	require.NoError(t, json.Unmarshal(data, &advisory))
+
+	require.Equal(t, "2.0", advisory.Document.CSAFVersion)
+	require.Equal(t, "CVE-2023-5528", advisory.Document.Tracking.ID)
+	require.Equal(t, "2026-01-02T03:04:05Z", advisory.Document.Tracking.InitialReleaseDate)
+
+	versions := advisory.ProductTree.Branches[0].Branches[0].Branches
+	require.Len(t, versions, 4)
+	require.Equal(t, "vers:semver/<1.27.8", versions[0].Name)
+	require.Equal(t, "kubernetes-affected-1.27.8", versions[0].Product.ProductID)
+	require.Equal(t, "1.27.8", versions[1].Name)
+	require.Equal(t, "vers:semver/>=1.28.0-0|<1.28.4", versions[2].Name)
+	require.Equal(t, "kubernetes-1.28.4", versions[3].Product.ProductID)
+
+	require.Len(t, advisory.Vulnerabilities, 1)
+	vulnerability := advisory.Vulnerabilities[0]
+	require.Equal(t, "CVE-2023-5528", vulnerability.CVE)
+	require.Equal(t, map[string][]string{
+		"known_affected": {"kubernetes-affected-1.27.8", "kubernetes-affected-1.28.4"},
+		"fixed":          {"kubernetes-1.27.8", "kubernetes-1.28.4"},
+	}, vulnerability.ProductStatus)
+	require.InDelta(t, 8.8, vulnerability.Scores[0].CVSSV3.BaseScore, 0.01)
+	require.Equal(t, "HIGH", vulnerability.Scores[0].CVSSV3.BaseSeverity)
+	require.Equal(t, "3.1", vulnerability.Scores[0].CVSSV3.Version)
+	require.Len(t, vulnerability.Remediations, 1)
+	require.Len(t, vulnerability.References, 3)
+
+	_, err = ExportCSAF(&CVE{ID: "CVE-2023-5528", CVSSVector: "invalid"}, ranges, date)
+	require.Error(t, err)
+}
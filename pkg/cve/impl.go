@@ -20,16 +20,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/sirupsen/logrus"
 
 	"sigs.k8s.io/release-sdk/object"
-	"sigs.k8s.io/yaml"
 
 	"k8s.io/release/pkg/notes"
 )
@@ -45,8 +48,12 @@ type ClientImplementation interface {
 	ValidateCVEMap(string, string, *ClientOptions) error
 	CreateEmptyFile(string, *ClientOptions) (*os.File, error)
 	EntryExists(string, *ClientOptions) (bool, error)
+	FetchRecord(string, *ClientOptions) ([]byte, error)
 }
 
+// fetchTimeout is the timeout to download a vulnerability record.
+const fetchTimeout = time.Minute
+
 // defaultClientImplementation.
 type defaultClientImplementation struct{}
 
@@ -210,24 +217,13 @@ func (impl *defaultClientImplementation) CheckID(cveID string) error {
 func (impl *defaultClientImplementation) ValidateCVEMap(
 	cveID, path string, _ *ClientOptions,
 ) (err error) {
-	// Parse the data map
-	maps, err := notes.ParseReleaseNotesMap(path)
+	cves, err := ReadMapFile(path)
 	if err != nil {
-		return fmt.Errorf("parsing CVE data map: %w", err)
+		return err
 	}
 
 	// Cycle all data maps in file
-	for i, dataMap := range *maps {
-		// Check if map has other the CVE field
-		if _, ok := dataMap.DataFields["cve"]; !ok {
-			return fmt.Errorf("data map #%d in file %s has no CVE data", i, path)
-		}
-		// Cast the datafield as CVE data
-		cvedata := CVE{}
-		if err := cvedata.ReadRawInterface(dataMap.DataFields["cve"]); err != nil {
-			return fmt.Errorf("reading CVE data from YAML file: %w", err)
-		}
-
+	for i, cvedata := range cves {
 		if err := cvedata.Validate(); err != nil {
 			return fmt.Errorf("validating map #%d in file %s: %w", i, path, err)
 		}
@@ -245,6 +241,33 @@ func (impl *defaultClientImplementation) ValidateCVEMap(
 	return nil
 }
 
+// ReadMapFile returns the CVE data of all maps in the file.
+func ReadMapFile(path string) ([]*CVE, error) {
+	// Parse the data map
+	maps, err := notes.ParseReleaseNotesMap(path)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CVE data map: %w", err)
+	}
+
+	cves := make([]*CVE, 0, len(*maps))
+
+	for i, dataMap := range *maps {
+		// Check if map has other the CVE field
+		if _, ok := dataMap.DataFields["cve"]; !ok {
+			return nil, fmt.Errorf("data map #%d in file %s has no CVE data", i, path)
+		}
+		// Cast the datafield as CVE data
+		cvedata := &CVE{}
+		if err := cvedata.ReadRawInterface(dataMap.DataFields["cve"]); err != nil {
+			return nil, fmt.Errorf("reading CVE data from YAML file: %w", err)
+		}
+
+		cves = append(cves, cvedata)
+	}
+
+	return cves, nil
+}
+
 // CreateEmptyFile creates an empty CVE map.
 func (impl *defaultClientImplementation) CreateEmptyFile(cve string, _ *ClientOptions) (
 	file *os.File, err error,
@@ -254,22 +277,9 @@ func (impl *defaultClientImplementation) CreateEmptyFile(cve string, _ *ClientOp
 	}
 
 	// Add a relnote-compatible struct with only the CVE data
-	noteMap := struct {
-		PR         int
-		DataFields map[string]*CVE
-	}{
-		PR: 0,
-		DataFields: map[string]*CVE{
-			"cve": {
-				ID: cve,
-			},
-		},
-	}
-
-	// Marshall the data struct into yaml
-	yamlCode, err := yaml.Marshal(noteMap)
+	yamlCode, err := MarshalMap(&CVE{ID: cve})
 	if err != nil {
-		return nil, fmt.Errorf("marshalling CVE data map: %w", err)
+		return nil, err
 	}
 
 	file, err = os.CreateTemp(os.TempDir(), "cve-data-*.yaml")
@@ -311,3 +321,42 @@ func (impl *defaultClientImplementation) EntryExists(
 
 	return gcs.PathExists(path)
 }
+
+// FetchRecord downloads the vulnerability record with the ID. CVE IDs are
+// fetched as CVE JSON 5.0 record from the CVE services, all other IDs as
+// OSV record.
+func (impl *defaultClientImplementation) FetchRecord(
+	id string, opts *ClientOptions,
+) ([]byte, error) {
+	endpoint := opts.OSVAPIURL
+	if ValidateID(id) == nil {
+		endpoint = opts.CVEAPIURL
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+url.PathEscape(id), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching record %s: %w", id, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching record %s: unexpected status %s", id, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading record %s: %w", id, err)
+	}
+
+	return data, nil
+}
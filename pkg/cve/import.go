@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cve
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// OSVAPIURL is the endpoint to fetch OSV records by ID.
+	OSVAPIURL = "https://api.osv.dev/v1/vulns/"
+
+	// CVEAPIURL is the endpoint of the CVE services to fetch CVE JSON 5.0
+	// records by ID.
+	CVEAPIURL = "https://cveawg.mitre.org/api/cve/"
+
+	cveRecordDataType = "CVE_RECORD"
+	osvSeverityCVSSV3 = "CVSS_V3"
+
+	// githubRepo is the repository whose pull requests get linked to the
+	// CVE.
+	githubRepo = "kubernetes/kubernetes"
+)
+
+// githubRefRegExp matches links to GitHub issues and pull requests.
+var githubRefRegExp = regexp.MustCompile(`^/([^/]+/[^/]+)/(pull|issues)/(\d+)`)
+
+// osvRecord is the subset of an OSV record used to create a CVE map, see
+// https://ossf.github.io/osv-schema/
+type osvRecord struct {
+	ID       string   `json:"id"`
+	Aliases  []string `json:"aliases"`
+	Summary  string   `json:"summary"`
+	Details  string   `json:"details"`
+	Severity []struct {
+		Type  string `json:"type"`
+		Score string `json:"score"`
+	} `json:"severity"`
+	References []struct {
+		Type string `json:"type"`
+		URL  string `json:"url"`
+	} `json:"references"`
+}
+
+// cveRecord is the subset of a CVE JSON 5.0 record used to create a CVE map,
+// see https://github.com/CVEProject/cve-schema
+type cveRecord struct {
+	DataType    string `json:"dataType"`
+	CVEMetadata struct {
+		CVEID string `json:"cveId"`
+	} `json:"cveMetadata"`
+	Containers struct {
+		CNA struct {
+			Title        string `json:"title"`
+			Descriptions []struct {
+				Lang  string `json:"lang"`
+				Value string `json:"value"`
+			} `json:"descriptions"`
+			Metrics []struct {
+				CVSSV31 *cvssMetric `json:"cvssV3_1"`
+				CVSSV30 *cvssMetric `json:"cvssV3_0"`
+			} `json:"metrics"`
+			References []struct {
+				URL string `json:"url"`
+			} `json:"references"`
+		} `json:"cna"`
+	} `json:"containers"`
+}
+
+type cvssMetric struct {
+	VectorString string `json:"vectorString"`
+}
+
+// ParseRecord converts an OSV or CVE JSON 5.0 record into CVE data. The
+// score and rating are computed from the CVSS vector, linked pull requests
+// and the tracking issue are taken from the referenced GitHub URLs.
+func ParseRecord(data []byte) (*CVE, error) {
+	probe := struct {
+		DataType string `json:"dataType"`
+		ID       string `json:"id"`
+	}{}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("unmarshal record: %w", err)
+	}
+
+	var (
+		cve  *CVE
+		refs []string
+		err  error
+	)
+
+	switch {
+	case probe.DataType == cveRecordDataType:
+		cve, refs, err = parseCVERecord(data)
+	case probe.ID != "":
+		cve, refs, err = parseOSVRecord(data)
+	default:
+		return nil, errors.New("record is neither an OSV nor a CVE JSON 5.0 record")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	linkReferences(cve, refs)
+
+	if err := cve.CalculateScore(); err != nil {
+		return nil, fmt.Errorf("calculating CVSS score: %w", err)
+	}
+
+	return cve, nil
+}
+
+func parseOSVRecord(data []byte) (cve *CVE, refs []string, err error) {
+	record := &osvRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal OSV record: %w", err)
+	}
+
+	cve = &CVE{
+		ID:          cveIDFromAliases(append([]string{record.ID}, record.Aliases...)),
+		Title:       strings.TrimSpace(record.Summary),
+		Description: strings.TrimSpace(record.Details),
+	}
+
+	if cve.ID == "" {
+		return nil, nil, fmt.Errorf("OSV record %s has no CVE alias", record.ID)
+	}
+
+	for _, severity := range record.Severity {
+		if severity.Type == osvSeverityCVSSV3 {
+			cve.CVSSVector = severity.Score
+
+			break
+		}
+	}
+
+	if cve.CVSSVector == "" {
+		return nil, nil, fmt.Errorf("OSV record %s has no %s severity", record.ID, osvSeverityCVSSV3)
+	}
+
+	for _, ref := range record.References {
+		refs = append(refs, ref.URL)
+	}
+
+	return cve, refs, nil
+}
+
+func parseCVERecord(data []byte) (cve *CVE, refs []string, err error) {
+	record := &cveRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal CVE record: %w", err)
+	}
+
+	cna := &record.Containers.CNA
+	cve = &CVE{
+		ID:    record.CVEMetadata.CVEID,
+		Title: strings.TrimSpace(cna.Title),
+	}
+
+	for _, description := range cna.Descriptions {
+		if strings.HasPrefix(description.Lang, "en") {
+			cve.Description = strings.TrimSpace(description.Value)
+
+			break
+		}
+	}
+
+	for _, metric := range cna.Metrics {
+		if m := firstMetric(metric.CVSSV31, metric.CVSSV30); m != nil {
+			cve.CVSSVector = m.VectorString
+
+			break
+		}
+	}
+
+	if cve.CVSSVector == "" {
+		return nil, nil, fmt.Errorf("CVE record %s has no CVSS v3 metric", cve.ID)
+	}
+
+	for _, ref := range cna.References {
+		refs = append(refs, ref.URL)
+	}
+
+	return cve, refs, nil
+}
+
+func firstMetric(metrics ...*cvssMetric) *cvssMetric {
+	for _, m := range metrics {
+		if m != nil && m.VectorString != "" {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// cveIDFromAliases returns the first CVE ID of the identifiers.
+func cveIDFromAliases(ids []string) string {
+	for _, id := range ids {
+		if ValidateID(id) == nil {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// linkReferences adds the pull requests and the first issue of the
+// kubernetes repository referenced by the URLs to the CVE.
+func linkReferences(cve *CVE, refs []string) {
+	cve.LinkedPRs = []int{}
+
+	for _, ref := range refs {
+		u, err := url.Parse(ref)
+		if err != nil || u.Host != "github.com" {
+			continue
+		}
+
+		m := githubRefRegExp.FindStringSubmatch(u.Path)
+		if m == nil || m[1] != githubRepo {
+			continue
+		}
+
+		num, err := strconv.Atoi(m[3])
+		if err != nil {
+			continue
+		}
+
+		switch m[2] {
+		case "pull":
+			if !slices.Contains(cve.LinkedPRs, num) {
+				cve.LinkedPRs = append(cve.LinkedPRs, num)
+			}
+		case "issues":
+			if cve.TrackingIssue == "" {
+				cve.TrackingIssue = fmt.Sprintf("https://github.com/%s/issues/%d", githubRepo, num)
+			}
+		}
+	}
+
+	slices.Sort(cve.LinkedPRs)
+}
+
+// MarshalMap returns the CVE as release notes data map, which can be
+// written to the bucket.
+func MarshalMap(cve *CVE) ([]byte, error) {
+	noteMap := struct {
+		PR         int             `json:"pr"`
+		DataFields map[string]*CVE `json:"datafields"`
+	}{
+		PR: 0,
+		DataFields: map[string]*CVE{
+			"cve": cve,
+		},
+	}
+
+	yamlCode, err := yaml.Marshal(noteMap)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling CVE data map: %w", err)
+	}
+
+	return yamlCode, nil
+}
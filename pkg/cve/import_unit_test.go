@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cve
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testOSVRecord = `{
+  "schema_version": "1.6.0",
+  "id": "GHSA-hq6q-c2x6-hmch",
+  "aliases": ["CVE-2023-5528"],
+  "summary": "Insufficient input sanitization on Windows nodes",
+  "details": "A security issue was discovered in Kubernetes where a user that can create pods\nmay be able to escalate to admin privileges on Windows nodes.",
+  "severity": [
+    {"type": "CVSS_V4", "score": "CVSS:4.0/AV:N/AC:L/AT:N/PR:L/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N"},
+    {"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H"}
+  ],
+  "references": [
+    {"type": "WEB", "url": "https://github.com/kubernetes/kubernetes/pull/121882"},
+    {"type": "WEB", "url": "https://github.com/kubernetes/kubernetes/issues/121879"},
+    {"type": "WEB", "url": "https://github.com/kubernetes/kubernetes/pull/121881"},
+    {"type": "WEB", "url": "https://github.com/kubernetes/kubernetes/pull/121882/files"},
+    {"type": "WEB", "url": "https://github.com/kubernetes-sigs/other/pull/1"},
+    {"type": "ADVISORY", "url": "https://nvd.nist.gov/vuln/detail/CVE-2023-5528"}
+  ]
+}`
+
+const testCVERecord = `{
+  "dataType": "CVE_RECORD",
+  "dataVersion": "5.0",
+  "cveMetadata": {"cveId": "CVE-2020-8559", "state": "PUBLISHED"},
+  "containers": {
+    "cna": {
+      "title": "Privilege escalation from compromised node to cluster",
+      "descriptions": [
+        {"lang": "de", "value": "Rechteausweitung"},
+        {"lang": "en", "value": "If an attacker is able to intercept certain requests to the Kubelet."}
+      ],
+      "metrics": [
+        {"format": "CVSS", "other": {}},
+        {"cvssV3_0": {"vectorString": "CVSS:3.0/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H"}}
+      ],
+      "references": [
+        {"url": "https://github.com/kubernetes/kubernetes/issues/92914"}
+      ]
+    }
+  }
+}`
+
+func TestParseRecord(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		record      string
+		expected    *CVE
+		shouldError bool
+	}{
+		{
+			name:   "OSV",
+			record: testOSVRecord,
+			expected: &CVE{
+				ID:            "CVE-2023-5528",
+				Title:         "Insufficient input sanitization on Windows nodes",
+				Description:   "A security issue was discovered in Kubernetes where a user that can create pods\nmay be able to escalate to admin privileges on Windows nodes.",
+				TrackingIssue: "https://github.com/kubernetes/kubernetes/issues/121879",
+				CVSSVector:    "CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H",
+				CVSSScore:     8.8,
+				CVSSRating:    "High",
+				CalcLink:      "https://www.first.org/cvss/calculator/3.1#CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H",
+				LinkedPRs:     []int{121881, 121882},
+			},
+		},
+		{
+			name:   "CVE JSON 5.0",
+			record: testCVERecord,
+			expected: &CVE{
+				ID:            "CVE-2020-8559",
+				Title:         "Privilege escalation from compromised node to cluster",
+				Description:   "If an attacker is able to intercept certain requests to the Kubelet.",
+				TrackingIssue: "https://github.com/kubernetes/kubernetes/issues/92914",
+				CVSSVector:    "CVSS:3.0/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H",
+				CVSSScore:     6.4,
+				CVSSRating:    "Medium",
+				CalcLink:      "https://www.first.org/cvss/calculator/3.0#CVSS:3.0/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H",
+				LinkedPRs:     []int{},
+			},
+		},
+		{
+			name:        "OSV without CVE alias",
+			record:      `{"id": "GHSA-xxxx-xxxx-xxxx", "severity": [{"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H"}]}`,
+			shouldError: true,
+		},
+		{
+			name:        "OSV without CVSS v3 vector",
+			record:      `{"id": "CVE-2023-5528"}`,
+			shouldError: true,
+		},
+		{
+			name:        "CVE JSON 5.0 without metrics",
+			record:      `{"dataType": "CVE_RECORD", "cveMetadata": {"cveId": "CVE-2020-8559"}}`,
+			shouldError: true,
+		},
+		{
+			name:        "invalid vector",
+			record:      `{"id": "CVE-2023-5528", "severity": [{"type": "CVSS_V3", "score": "CVSS:3.1/AV:X"}]}`,
+			shouldError: true,
+		},
+		{
+			name:        "unknown format",
+			record:      `{"vulnerabilities": []}`,
+			shouldError: true,
+		},
+		{
+			name:        "invalid JSON",
+			record:      `{`,
+			shouldError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cve, err := ParseRecord([]byte(tc.record))
+			if tc.shouldError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, cve)
+			require.NoError(t, cve.Validate())
+		})
+	}
+}
+
+func TestMarshalMapRoundTrip(t *testing.T) {
+	cve, err := ParseRecord([]byte(testOSVRecord))
+	require.NoError(t, err)
+
+	yamlCode, err := MarshalMap(cve)
+	require.NoError(t, err)
+
+	mapPath := filepath.Join(t.TempDir(), "map.yaml")
+	require.NoError(t, os.WriteFile(mapPath, yamlCode, 0o600))
+
+	impl := &defaultClientImplementation{}
+	require.NoError(t, impl.ValidateCVEMap(cve.ID, mapPath, &cveDefaultOpts))
+	require.Error(t, impl.ValidateCVEMap("CVE-2020-8559", mapPath, &cveDefaultOpts))
+}
+
+func TestReadRawInterface(t *testing.T) {
+	for _, raw := range []any{
+		map[string]any{"id": "CVE-2023-5528", "score": 8, "pullrequests": []any{1, 2}},
+		map[any]any{"id": "CVE-2023-5528", "score": 8.0, "linkedPRs": []any{1, 2}},
+	} {
+		cve := CVE{}
+		require.NoError(t, cve.ReadRawInterface(raw))
+		require.Equal(t, CVE{ID: "CVE-2023-5528", CVSSScore: 8, LinkedPRs: []int{1, 2}}, cve)
+	}
+
+	require.Error(t, (&CVE{}).ReadRawInterface("invalid"))
+}
+
+func TestClientImport(t *testing.T) {
+	requested := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+
+		switch r.URL.Path {
+		case "/osv/GHSA-hq6q-c2x6-hmch":
+			_, _ = w.Write([]byte(testOSVRecord))
+		case "/cve/CVE-2020-8559":
+			_, _ = w.Write([]byte(testCVERecord))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		impl: &defaultClientImplementation{},
+		options: ClientOptions{
+			OSVAPIURL: srv.URL + "/osv/",
+			CVEAPIURL: srv.URL + "/cve/",
+		},
+	}
+
+	cve, err := client.Import("GHSA-hq6q-c2x6-hmch")
+	require.NoError(t, err)
+	require.Equal(t, "CVE-2023-5528", cve.ID)
+
+	cve, err = client.Import("CVE-2020-8559")
+	require.NoError(t, err)
+	require.Equal(t, "CVE-2020-8559", cve.ID)
+
+	_, err = client.Import("CVE-2020-1")
+	require.Error(t, err)
+
+	recordPath := filepath.Join(t.TempDir(), "record.json")
+	require.NoError(t, os.WriteFile(recordPath, []byte(testOSVRecord), 0o600))
+
+	cve, err = client.Import(recordPath)
+	require.NoError(t, err)
+	require.Equal(t, "CVE-2023-5528", cve.ID)
+
+	_, err = client.Import(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+
+	require.Equal(t, []string{"/osv/GHSA-hq6q-c2x6-hmch", "/cve/CVE-2020-8559", "/cve/CVE-2020-1"}, requested)
+}
@@ -29,6 +29,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	gogit "github.com/go-git/go-git/v5"
@@ -121,6 +122,9 @@ type Options struct {
 	OBSProject       string
 	PackageSource    string
 	OBSWait          bool
+	OBSWaitInterval  time.Duration
+	OBSWaitTimeout   time.Duration
+	OBSWaitSummary   string
 }
 
 // NewDefaultOptions returns a new default `*Options` instance.
@@ -430,6 +434,17 @@ func (g *GCB) SetGCBSubstitutions(toolOrg, toolRepo, toolRef, gcsBucket, forceBu
 		gcbSubs["OBS_PROJECT_TAG"] = strings.ReplaceAll(g.options.OBSProject, ":", "-")
 		gcbSubs["PACKAGE_SOURCE"] = g.options.PackageSource
 		gcbSubs["WAIT"] = strconv.FormatBool(g.options.OBSWait)
+		gcbSubs["WAIT_INTERVAL"] = g.options.OBSWaitInterval.String()
+		gcbSubs["WAIT_TIMEOUT"] = g.options.OBSWaitTimeout.String()
+
+		// The summary is written into the workspace of the job and
+		// archived in the bucket.
+		gcbSubs["WAIT_SUMMARY"] = ""
+		if g.options.OBSWaitSummary != "" {
+			gcbSubs["WAIT_SUMMARY"] = filepath.Base(g.options.OBSWaitSummary)
+		}
+
+		gcbSubs["WAIT_SUMMARY_BUCKET"] = gcsBucket
 
 		// Stop here when doing OBS stage
 		return gcbSubs, nil
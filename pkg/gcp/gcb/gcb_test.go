@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -408,6 +409,50 @@ func TestSetGCBSubstitutionsSuccess(t *testing.T) {
 				"K8S_REF":                git.DefaultRef,
 			},
 		},
+		{
+			name: "OBS stage",
+			gcbOpts: &gcb.Options{
+				OBSStage:         true,
+				Branch:           git.DefaultBranch,
+				GcpUser:          "test-user",
+				SpecTemplatePath: "cmd/krel/templates/latest",
+				Packages:         []string{"kubeadm", "kubelet"},
+				Architectures:    []string{"amd64", "arm64"},
+				Version:          "1.30.0",
+				OBSProject:       "isv:kubernetes:core:stable:v1.30",
+				OBSWait:          true,
+				OBSWaitInterval:  time.Minute,
+				OBSWaitTimeout:   2 * time.Hour,
+				OBSWaitSummary:   "/tmp/reports/summary.json",
+			},
+			repoMock:    mockRepo(),
+			versionMock: mockVersion("v1.30.0"),
+			releaseMock: mockRelease("v1.30.0"),
+			expected: map[string]string{
+				"RELEASE_BRANCH":      git.DefaultBranch,
+				"TOOL_ORG":            "",
+				"TOOL_REPO":           "",
+				"TOOL_REF":            "",
+				"FORCE_BUILD_KREL":    "",
+				"TYPE":                "",
+				"TYPE_TAG":            "",
+				"K8S_ORG":             git.DefaultGithubOrg,
+				"K8S_REPO":            git.DefaultGithubRepo,
+				"K8S_REF":             git.DefaultRef,
+				"SPEC_TEMPLATE_PATH":  "cmd/krel/templates/latest",
+				"PACKAGES":            "kubeadm...kubelet",
+				"ARCHITECTURES":       "amd64...arm64",
+				"VERSION":             "1.30.0",
+				"OBS_PROJECT":         "isv:kubernetes:core:stable:v1.30",
+				"OBS_PROJECT_TAG":     "isv-kubernetes-core-stable-v1.30",
+				"PACKAGE_SOURCE":      "",
+				"WAIT":                "true",
+				"WAIT_INTERVAL":       "1m0s",
+				"WAIT_TIMEOUT":        "2h0m0s",
+				"WAIT_SUMMARY":        "summary.json",
+				"WAIT_SUMMARY_BUCKET": "gs://test-bucket",
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -167,6 +167,12 @@ func (g *GmailSender) Send(body, subject string) error {
 
 // BuildMessage constructs an RFC 2822 email message with HTML content.
 func BuildMessage(sender Recipient, recipients []Recipient, subject, body string) string {
+	return buildMessage(sender, recipients, subject, `text/html; charset="UTF-8"`, body)
+}
+
+// buildMessage constructs an RFC 2822 email message with the provided
+// content type.
+func buildMessage(sender Recipient, recipients []Recipient, subject, contentType, body string) string {
 	toAddrs := make([]string, 0, len(recipients))
 	for _, r := range recipients {
 		if r.Name != "" {
@@ -182,10 +188,11 @@ func BuildMessage(sender Recipient, recipients []Recipient, subject, body string
 	}
 
 	return fmt.Sprintf(
-		"%sTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/html; charset=\"UTF-8\"\r\n\r\n%s",
+		"%sTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: %s\r\n\r\n%s",
 		fromHeader,
 		strings.Join(toAddrs, ", "),
 		subject,
+		contentType,
 		body,
 	)
 }
@@ -19,8 +19,7 @@ package mail
 import "fmt"
 
 // EmailSender is a generic interface for sending emails.
-// Implementations include GmailSender (Gmail OAuth) with SMTP planned
-// for the future.
+// Implementations include GmailSender (Gmail OAuth) and SMTPSender.
 type EmailSender interface {
 	Send(body, subject string) error
 }
@@ -0,0 +1,502 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mail
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/html"
+)
+
+// SMTPSecurity defines how the connection to the SMTP server is encrypted.
+type SMTPSecurity string
+
+const (
+	// SMTPSecurityStartTLS upgrades a plain connection using STARTTLS.
+	SMTPSecurityStartTLS SMTPSecurity = "starttls"
+
+	// SMTPSecurityTLS uses an implicit TLS connection (SMTPS).
+	SMTPSecurityTLS SMTPSecurity = "tls"
+
+	// SMTPSecurityNone uses an unencrypted connection.
+	SMTPSecurityNone SMTPSecurity = "none"
+)
+
+// SMTPAuth defines the SMTP authentication mechanism.
+type SMTPAuth string
+
+const (
+	SMTPAuthPlain SMTPAuth = "plain"
+	SMTPAuthLogin SMTPAuth = "login"
+	SMTPAuthNone  SMTPAuth = "none"
+)
+
+// defaultSMTPTimeout is the timeout for the whole SMTP session.
+const defaultSMTPTimeout = time.Minute
+
+// SMTPOptions are the settings to connect to an SMTP server.
+type SMTPOptions struct {
+	// Host is the hostname of the SMTP server.
+	Host string
+
+	// Port of the SMTP server. Defaults to 587 for STARTTLS, 465 for
+	// implicit TLS and 25 for unencrypted connections.
+	Port int
+
+	// Security is the connection encryption, defaults to STARTTLS.
+	Security SMTPSecurity
+
+	// Auth is the authentication mechanism, defaults to PLAIN.
+	Auth SMTPAuth
+
+	// Username and Password are the SMTP credentials.
+	Username string
+	Password string
+
+	// From is the sender of the emails.
+	From Recipient
+
+	// TLSConfig overrides the default TLS configuration, for example to
+	// trust a custom certificate authority.
+	TLSConfig *tls.Config
+
+	// Timeout for the SMTP session, defaults to one minute.
+	Timeout time.Duration
+}
+
+// SMTPSender sends multipart HTML and plain text emails via SMTP.
+type SMTPSender struct {
+	recipients []Recipient
+	options    *SMTPOptions
+}
+
+// NewSMTPSender creates a new SMTPSender after validating and defaulting
+// the provided options.
+func NewSMTPSender(opts *SMTPOptions) (*SMTPSender, error) {
+	if opts == nil {
+		return nil, errors.New("no SMTP options provided")
+	}
+
+	o := *opts
+	if o.Host == "" {
+		return nil, errors.New("SMTP host is required")
+	}
+
+	if o.From.Address == "" {
+		return nil, errors.New("SMTP sender address is required")
+	}
+
+	if o.Security == "" {
+		o.Security = SMTPSecurityStartTLS
+	}
+
+	if o.Auth == "" {
+		o.Auth = SMTPAuthPlain
+	}
+
+	if !slices.Contains(SMTPSecurities(), o.Security) {
+		return nil, fmt.Errorf("unsupported SMTP security %q", o.Security)
+	}
+
+	if !slices.Contains(SMTPAuths(), o.Auth) {
+		return nil, fmt.Errorf("unsupported SMTP authentication %q", o.Auth)
+	}
+
+	if o.Auth != SMTPAuthNone && o.Username == "" {
+		return nil, fmt.Errorf("SMTP username is required for %s authentication", o.Auth)
+	}
+
+	if o.Port == 0 {
+		switch o.Security {
+		case SMTPSecurityTLS:
+			o.Port = 465
+		case SMTPSecurityNone:
+			o.Port = 25
+		default:
+			o.Port = 587
+		}
+	}
+
+	if o.Timeout == 0 {
+		o.Timeout = defaultSMTPTimeout
+	}
+
+	return &SMTPSender{options: &o}, nil
+}
+
+// SMTPSecurities returns all supported SMTP connection securities.
+func SMTPSecurities() []SMTPSecurity {
+	return []SMTPSecurity{SMTPSecurityStartTLS, SMTPSecurityTLS, SMTPSecurityNone}
+}
+
+// SMTPAuths returns all supported SMTP authentication mechanisms.
+func SMTPAuths() []SMTPAuth {
+	return []SMTPAuth{SMTPAuthPlain, SMTPAuthLogin, SMTPAuthNone}
+}
+
+// SetRecipients sets the email recipients.
+func (s *SMTPSender) SetRecipients(recipients []Recipient) {
+	s.recipients = recipients
+}
+
+// SetGoogleGroupRecipients sets Google Groups as recipients.
+func (s *SMTPSender) SetGoogleGroupRecipients(groups ...GoogleGroup) {
+	s.recipients = GoogleGroupRecipients(groups...)
+}
+
+// Send sends the HTML body together with a plain text alternative via SMTP.
+// It satisfies the EmailSender interface.
+func (s *SMTPSender) Send(body, subject string) error {
+	if len(s.recipients) == 0 {
+		return errors.New("no recipients set")
+	}
+
+	msg, err := BuildMultipartMessage(s.options.From, s.recipients, subject, body)
+	if err != nil {
+		return fmt.Errorf("building message: %w", err)
+	}
+
+	client, err := s.connect()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := s.authenticate(client); err != nil {
+		return err
+	}
+
+	if err := client.Mail(s.options.From.Address); err != nil {
+		return fmt.Errorf("setting sender %s: %w", s.options.From.Address, err)
+	}
+
+	for _, r := range s.recipients {
+		if err := client.Rcpt(r.Address); err != nil {
+			return fmt.Errorf("adding recipient %s: %w", r.Address, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("starting message data: %w", err)
+	}
+
+	date := "Date: " + time.Now().Format(time.RFC1123Z) + "\r\n"
+	if _, err := io.WriteString(w, date+msg); err != nil {
+		return fmt.Errorf("writing message data: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("sending message: %w", err)
+	}
+
+	logrus.WithField("recipients", s.recipients).Debug("Mail successfully sent via SMTP")
+
+	if err := client.Quit(); err != nil {
+		logrus.Warnf("Closing SMTP session: %v", err)
+	}
+
+	return nil
+}
+
+// connect dials the SMTP server and secures the connection if configured.
+func (s *SMTPSender) connect() (*smtp.Client, error) {
+	addr := net.JoinHostPort(s.options.Host, strconv.Itoa(s.options.Port))
+	dialer := &net.Dialer{Timeout: s.options.Timeout}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+
+	logrus.Debugf("Connecting to SMTP server %s using %s", addr, s.options.Security)
+
+	if s.options.Security == SMTPSecurityTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, s.tlsConfig())
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("connecting to SMTP server %s: %w", addr, err)
+	}
+
+	if err := conn.SetDeadline(time.Now().Add(s.options.Timeout)); err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("setting connection deadline: %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, s.options.Host)
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("creating SMTP client: %w", err)
+	}
+
+	if s.options.Security == SMTPSecurityStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			client.Close()
+
+			return nil, fmt.Errorf("SMTP server %s does not support STARTTLS", addr)
+		}
+
+		if err := client.StartTLS(s.tlsConfig()); err != nil {
+			client.Close()
+
+			return nil, fmt.Errorf("starting TLS: %w", err)
+		}
+	}
+
+	return client, nil
+}
+
+func (s *SMTPSender) authenticate(client *smtp.Client) error {
+	var auth smtp.Auth
+
+	switch s.options.Auth {
+	case SMTPAuthPlain:
+		auth = smtp.PlainAuth("", s.options.Username, s.options.Password, s.options.Host)
+	case SMTPAuthLogin:
+		auth = &loginAuth{
+			host:     s.options.Host,
+			username: s.options.Username,
+			password: s.options.Password,
+		}
+	default:
+		return nil
+	}
+
+	if ok, _ := client.Extension("AUTH"); !ok {
+		return errors.New("SMTP server does not support authentication")
+	}
+
+	if err := client.Auth(auth); err != nil {
+		return fmt.Errorf("authenticating as %s: %w", s.options.Username, err)
+	}
+
+	return nil
+}
+
+func (s *SMTPSender) tlsConfig() *tls.Config {
+	if s.options.TLSConfig != nil {
+		cfg := s.options.TLSConfig.Clone()
+		if cfg.ServerName == "" {
+			cfg.ServerName = s.options.Host
+		}
+
+		return cfg
+	}
+
+	return &tls.Config{
+		ServerName: s.options.Host,
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+// loginAuth implements the LOGIN SASL mechanism, which is not part of
+// net/smtp but still required by some mail providers.
+type loginAuth struct {
+	host     string
+	username string
+	password string
+}
+
+func (a *loginAuth) Start(server *smtp.ServerInfo) (proto string, toServer []byte, err error) {
+	// Same as PlainAuth: never send credentials over an unencrypted
+	// connection, except for local connections.
+	if !server.TLS && !isLocalhost(server.Name) {
+		return "", nil, errors.New("unencrypted connection")
+	}
+
+	if server.Name != a.host {
+		return "", nil, errors.New("wrong host name")
+	}
+
+	return "LOGIN", nil, nil
+}
+
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(string(fromServer))) {
+	case "username:":
+		return []byte(a.username), nil
+	case "password:":
+		return []byte(a.password), nil
+	default:
+		return nil, fmt.Errorf("unexpected LOGIN challenge: %q", fromServer)
+	}
+}
+
+func isLocalhost(name string) bool {
+	return name == "localhost" || name == "127.0.0.1" || name == "::1"
+}
+
+// BuildMultipartMessage constructs an RFC 2822 email message using the same
+// headers as BuildMessage, but with a multipart/alternative body containing
+// a plain text version of the HTML content as well as the HTML itself.
+func BuildMultipartMessage(sender Recipient, recipients []Recipient, subject, body string) (string, error) {
+	content := &bytes.Buffer{}
+	mw := multipart.NewWriter(content)
+
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain", HTMLToText(body)},
+		{"text/html", body},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType + `; charset="UTF-8"`},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return "", fmt.Errorf("creating %s part: %w", part.contentType, err)
+		}
+
+		qw := quotedprintable.NewWriter(w)
+		if _, err := io.WriteString(qw, part.content); err != nil {
+			return "", fmt.Errorf("writing %s part: %w", part.contentType, err)
+		}
+
+		if err := qw.Close(); err != nil {
+			return "", fmt.Errorf("closing %s part: %w", part.contentType, err)
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return "", fmt.Errorf("closing multipart message: %w", err)
+	}
+
+	return buildMessage(
+		sender, recipients, subject,
+		fmt.Sprintf("multipart/alternative; boundary=%q", mw.Boundary()),
+		content.String(),
+	), nil
+}
+
+// HTMLToText converts an HTML document into readable plain text. Block
+// elements are separated by newlines and link targets are appended to the
+// link text.
+func HTMLToText(content string) string {
+	var (
+		sb   strings.Builder
+		href string
+		skip int
+	)
+
+	newline := func() {
+		if sb.Len() > 0 && !strings.HasSuffix(sb.String(), "\n") {
+			sb.WriteString("\n")
+		}
+	}
+
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+
+	for {
+		tt := tokenizer.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+
+		token := tokenizer.Token()
+
+		switch tt {
+		case html.TextToken:
+			if skip > 0 {
+				continue
+			}
+
+			text := strings.Join(strings.Fields(token.Data), " ")
+			if text == "" {
+				continue
+			}
+
+			if sb.Len() > 0 && !strings.HasSuffix(sb.String(), "\n") && !strings.HasSuffix(sb.String(), " ") {
+				sb.WriteString(" ")
+			}
+
+			sb.WriteString(text)
+		case html.StartTagToken, html.SelfClosingTagToken:
+			switch token.Data {
+			case "script", "style", "head":
+				if tt == html.StartTagToken {
+					skip++
+				}
+			case "a":
+				href = ""
+
+				for _, attr := range token.Attr {
+					if attr.Key == "href" {
+						href = attr.Val
+					}
+				}
+			case "li":
+				newline()
+				sb.WriteString("- ")
+			case "br":
+				sb.WriteString("\n")
+			default:
+				if isBlockElement(token.Data) {
+					newline()
+				}
+			}
+		case html.EndTagToken:
+			switch token.Data {
+			case "script", "style", "head":
+				skip = max(0, skip-1)
+			case "a":
+				if href != "" && !strings.HasSuffix(sb.String(), href) {
+					fmt.Fprintf(&sb, " (%s)", href)
+				}
+
+				href = ""
+			default:
+				if isBlockElement(token.Data) {
+					newline()
+				}
+			}
+		default:
+		}
+	}
+
+	return strings.TrimSpace(sb.String()) + "\n"
+}
+
+func isBlockElement(tag string) bool {
+	switch tag {
+	case "p", "div", "h1", "h2", "h3", "h4", "h5", "h6", "ul", "ol", "li",
+		"table", "tr", "pre", "blockquote", "hr", "section", "article":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,469 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mail_test
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	netmail "net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/mail"
+)
+
+// smtpStandIn is a minimal SMTP server accepting a single session.
+type smtpStandIn struct {
+	t         *testing.T
+	listener  net.Listener
+	tlsConfig *tls.Config
+	startTLS  bool
+	username  string
+	password  string
+
+	mu       sync.Mutex
+	auth     string
+	from     string
+	to       []string
+	data     string
+	finished chan struct{}
+}
+
+// newSMTPStandIn starts a local SMTP server and returns it together with the
+// client TLS configuration trusting its certificate.
+func newSMTPStandIn(t *testing.T, implicitTLS, startTLS bool) (*smtpStandIn, *tls.Config) {
+	t.Helper()
+
+	// Reuse the test certificate of the httptest package
+	ts := httptest.NewUnstartedServer(http.NotFoundHandler())
+	ts.StartTLS()
+	t.Cleanup(ts.Close)
+
+	transport, ok := ts.Client().Transport.(*http.Transport)
+	require.True(t, ok)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	if implicitTLS {
+		listener = tls.NewListener(listener, ts.TLS)
+	}
+
+	s := &smtpStandIn{
+		t:         t,
+		listener:  listener,
+		tlsConfig: ts.TLS,
+		startTLS:  startTLS,
+		username:  "user",
+		password:  "secret",
+		finished:  make(chan struct{}),
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go s.serve()
+
+	return s, transport.TLSClientConfig
+}
+
+func (s *smtpStandIn) port() int {
+	addr, ok := s.listener.Addr().(*net.TCPAddr)
+	require.True(s.t, ok)
+
+	return addr.Port
+}
+
+func (s *smtpStandIn) serve() {
+	defer close(s.finished)
+
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	isTLS := false
+
+	if _, ok := conn.(*tls.Conn); ok {
+		isTLS = true
+	}
+
+	reply := func(line string) { _ = tp.PrintfLine("%s", line) } //nolint:errcheck // test server
+
+	reply("220 localhost ESMTP stand-in")
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			lines := []string{"250-localhost"}
+			if s.startTLS && !isTLS {
+				lines = append(lines, "250-STARTTLS")
+			}
+
+			lines = append(lines, "250-AUTH PLAIN LOGIN", "250 OK")
+			for _, l := range lines {
+				reply(l)
+			}
+		case "STARTTLS":
+			reply("220 Ready to start TLS")
+
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+
+			conn = tlsConn
+			tp = textproto.NewConn(conn)
+			isTLS = true
+		case "AUTH":
+			mechanism, initial, _ := strings.Cut(arg, " ")
+
+			var user, pass string
+
+			switch mechanism {
+			case "PLAIN":
+				decoded, err := base64.StdEncoding.DecodeString(initial)
+				if err != nil {
+					reply("501 invalid encoding")
+
+					continue
+				}
+
+				parts := strings.Split(string(decoded), "\x00")
+				if len(parts) == 3 {
+					user, pass = parts[1], parts[2]
+				}
+			case "LOGIN":
+				read := func(challenge string) string {
+					reply("334 " + base64.StdEncoding.EncodeToString([]byte(challenge)))
+
+					l, err := tp.ReadLine()
+					if err != nil {
+						return ""
+					}
+
+					decoded, err := base64.StdEncoding.DecodeString(l)
+					if err != nil {
+						return ""
+					}
+
+					return string(decoded)
+				}
+				user = read("Username:")
+				pass = read("Password:")
+			}
+
+			if user != s.username || pass != s.password {
+				reply("535 authentication failed")
+
+				continue
+			}
+
+			s.mu.Lock()
+			s.auth = mechanism
+			s.mu.Unlock()
+			reply("235 authenticated")
+		case "MAIL":
+			s.mu.Lock()
+			s.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			s.mu.Unlock()
+			reply("250 OK")
+		case "RCPT":
+			s.mu.Lock()
+			s.to = append(s.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			s.mu.Unlock()
+			reply("250 OK")
+		case "DATA":
+			reply("354 Start mail input")
+
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+
+			s.mu.Lock()
+			s.data = string(data)
+			s.mu.Unlock()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func (s *smtpStandIn) wait() {
+	<-s.finished
+}
+
+func TestSMTPSenderSend(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name         string
+		implicitTLS  bool
+		startTLS     bool
+		security     mail.SMTPSecurity
+		auth         mail.SMTPAuth
+		password     string
+		expectedAuth string
+		errorMsg     string
+	}{
+		{
+			name:         "STARTTLS with PLAIN auth",
+			startTLS:     true,
+			security:     mail.SMTPSecurityStartTLS,
+			auth:         mail.SMTPAuthPlain,
+			password:     "secret",
+			expectedAuth: "PLAIN",
+		},
+		{
+			name:         "implicit TLS with LOGIN auth",
+			implicitTLS:  true,
+			security:     mail.SMTPSecurityTLS,
+			auth:         mail.SMTPAuthLogin,
+			password:     "secret",
+			expectedAuth: "LOGIN",
+		},
+		{
+			name:     "unencrypted without auth",
+			security: mail.SMTPSecurityNone,
+			auth:     mail.SMTPAuthNone,
+		},
+		{
+			name:     "STARTTLS not supported by server",
+			security: mail.SMTPSecurityStartTLS,
+			auth:     mail.SMTPAuthPlain,
+			password: "secret",
+			errorMsg: "does not support STARTTLS",
+		},
+		{
+			name:     "wrong password",
+			startTLS: true,
+			security: mail.SMTPSecurityStartTLS,
+			auth:     mail.SMTPAuthLogin,
+			password: "wrong",
+			errorMsg: "authenticating as user",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, tlsConfig := newSMTPStandIn(t, tc.implicitTLS, tc.startTLS)
+
+			sender, err := mail.NewSMTPSender(&mail.SMTPOptions{
+				Host:      "127.0.0.1",
+				Port:      server.port(),
+				Security:  tc.security,
+				Auth:      tc.auth,
+				Username:  "user",
+				Password:  tc.password,
+				From:      mail.Recipient{Name: "Release Managers", Address: "release-managers@kubernetes.io"},
+				TLSConfig: tlsConfig,
+			})
+			require.NoError(t, err)
+
+			sender.SetGoogleGroupRecipients(
+				mail.KubernetesAnnounceGoogleGroup, mail.KubernetesDevGoogleGroup,
+			)
+
+			err = sender.Send("<h1>Kubernetes v1.35.0</h1><p>is live!</p>", "Kubernetes v1.35.0 is live!")
+			if tc.errorMsg != "" {
+				require.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			server.wait()
+
+			server.mu.Lock()
+			defer server.mu.Unlock()
+
+			require.Equal(t, tc.expectedAuth, server.auth)
+			require.Equal(t, "release-managers@kubernetes.io", server.from)
+			require.Equal(t, []string{
+				"kubernetes-announce@googlegroups.com", "dev@kubernetes.io",
+			}, server.to)
+
+			msg, err := netmail.ReadMessage(strings.NewReader(server.data))
+			require.NoError(t, err)
+			require.Equal(t, "Release Managers <release-managers@kubernetes.io>", msg.Header.Get("From"))
+			require.Equal(t, "Kubernetes v1.35.0 is live!", msg.Header.Get("Subject"))
+			require.NotEmpty(t, msg.Header.Get("Date"))
+
+			parts := readMultipart(t, msg.Header.Get("Content-Type"), msg.Body)
+			require.Equal(t, map[string]string{
+				"text/plain": "Kubernetes v1.35.0\nis live!\n",
+				"text/html":  "<h1>Kubernetes v1.35.0</h1><p>is live!</p>",
+			}, parts)
+		})
+	}
+}
+
+func readMultipart(t *testing.T, contentType string, body io.Reader) map[string]string {
+	t.Helper()
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+
+	res := map[string]string{}
+	reader := multipart.NewReader(body, params["boundary"])
+
+	for {
+		part, err := reader.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, "quoted-printable", part.Header.Get("Content-Transfer-Encoding"))
+
+		partType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(quotedprintable.NewReader(part))
+		require.NoError(t, err)
+
+		res[partType] = string(content)
+	}
+
+	return res
+}
+
+func TestSMTPSenderSendNoRecipients(t *testing.T) {
+	t.Parallel()
+
+	sender, err := mail.NewSMTPSender(&mail.SMTPOptions{
+		Host: "localhost", Auth: mail.SMTPAuthNone, From: mail.Recipient{Address: "a@b.c"},
+	})
+	require.NoError(t, err)
+	require.ErrorContains(t, sender.Send("body", "subject"), "no recipients set")
+}
+
+func TestNewSMTPSender(t *testing.T) {
+	t.Parallel()
+
+	from := mail.Recipient{Address: "rm@example.com"}
+
+	for name, tc := range map[string]struct {
+		opts        *mail.SMTPOptions
+		shouldError bool
+	}{
+		"defaults":            {opts: &mail.SMTPOptions{Host: "smtp.example.com", Username: "u", From: from}},
+		"nil options":         {shouldError: true},
+		"no host":             {opts: &mail.SMTPOptions{Username: "u", From: from}, shouldError: true},
+		"no sender":           {opts: &mail.SMTPOptions{Host: "smtp.example.com", Username: "u"}, shouldError: true},
+		"no username":         {opts: &mail.SMTPOptions{Host: "smtp.example.com", From: from}, shouldError: true},
+		"invalid security":    {opts: &mail.SMTPOptions{Host: "h", Username: "u", From: from, Security: "ssl"}, shouldError: true},
+		"invalid auth":        {opts: &mail.SMTPOptions{Host: "h", Username: "u", From: from, Auth: "cram-md5"}, shouldError: true},
+		"no auth no username": {opts: &mail.SMTPOptions{Host: "h", From: from, Auth: mail.SMTPAuthNone}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := mail.NewSMTPSender(tc.opts)
+			if tc.shouldError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuildMultipartMessage(t *testing.T) {
+	t.Parallel()
+
+	msg, err := mail.BuildMultipartMessage(
+		mail.Recipient{Name: "RM", Address: "rm@example.com"},
+		[]mail.Recipient{{Address: "dev@kubernetes.io"}},
+		"Test",
+		"<p>"+strings.Repeat("long line ", 20)+"</p>",
+	)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(msg, "From: RM <rm@example.com>\r\nTo: dev@kubernetes.io\r\nSubject: Test\r\n"))
+
+	parsed, err := netmail.ReadMessage(bufio.NewReader(strings.NewReader(msg)))
+	require.NoError(t, err)
+
+	parts := readMultipart(t, parsed.Header.Get("Content-Type"), parsed.Body)
+	require.Len(t, parts, 2)
+	require.Equal(t, strings.TrimSpace(strings.Repeat("long line ", 20))+"\r\n", parts["text/plain"])
+
+	// Quoted-printable encoding keeps the body lines short
+	_, body, _ := strings.Cut(msg, "\r\n\r\n")
+	for line := range strings.SplitSeq(body, "\r\n") {
+		require.LessOrEqual(t, len(line), 78, "line too long: "+strconv.Quote(line))
+	}
+}
+
+func TestHTMLToText(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		html     string
+		expected string
+	}{
+		"paragraphs": {
+			html:     "<p>First</p><p>Second  paragraph\nwith   spaces</p>",
+			expected: "First\nSecond paragraph with spaces\n",
+		},
+		"links": {
+			html:     `<p>See <a href="https://kubernetes.io">the website</a> and <a href="https://k8s.io">https://k8s.io</a></p>`,
+			expected: "See the website (https://kubernetes.io) and https://k8s.io\n",
+		},
+		"lists": {
+			html:     "<h2>Changes</h2><ul><li>one</li><li>two</li></ul>",
+			expected: "Changes\n- one\n- two\n",
+		},
+		"skipped elements and entities": {
+			html:     "<html><head><title>x</title><style>p {}</style></head><body>A &amp; B<br>C</body></html>",
+			expected: "A & B\nC\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, mail.HTMLToText(tc.html))
+		})
+	}
+}
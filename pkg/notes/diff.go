@@ -0,0 +1,288 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// NoteChangeType describes how a release note changed between two release
+// notes documents.
+type NoteChangeType string
+
+const (
+	// NoteAdded indicates that the note only exists in the new document.
+	NoteAdded NoteChangeType = "added"
+
+	// NoteRemoved indicates that the note only exists in the old document.
+	NoteRemoved NoteChangeType = "removed"
+
+	// NoteModified indicates that the note content differs between both
+	// documents.
+	NoteModified NoteChangeType = "modified"
+)
+
+// FieldChange is a single modified field of a release note.
+type FieldChange struct {
+	// Field is the JSON name of the modified field, for example `text`.
+	Field string `json:"field"`
+
+	// Old is the previous value of the field.
+	Old any `json:"old"`
+
+	// New is the current value of the field.
+	New any `json:"new"`
+}
+
+// NoteDiff contains the changes of a single release note.
+type NoteDiff struct {
+	// PrNumber is the PR the release note belongs to.
+	PrNumber int `json:"pr_number"`
+
+	// Type is the kind of the change.
+	Type NoteChangeType `json:"type"`
+
+	// Text is the text of the note in the new document, or in the old one
+	// for removed notes.
+	Text string `json:"text"`
+
+	// Changes are the modified fields, only set for modified notes.
+	Changes []FieldChange `json:"changes,omitempty"`
+}
+
+// ReleaseNotesDiff is the difference between two release notes documents.
+type ReleaseNotesDiff struct {
+	Added    []NoteDiff `json:"added"`
+	Removed  []NoteDiff `json:"removed"`
+	Modified []NoteDiff `json:"modified"`
+}
+
+// ParseReleaseNotesJSON reads release notes in the JSON format written by
+// `release-notes generate --format=json`.
+func ParseReleaseNotesJSON(r io.Reader) (*ReleaseNotes, error) {
+	byPR := ReleaseNotesByPR{}
+	if err := json.NewDecoder(r).Decode(&byPR); err != nil {
+		return nil, fmt.Errorf("decoding release notes JSON: %w", err)
+	}
+
+	releaseNotes := NewReleaseNotes()
+
+	for _, pr := range slices.Sorted(maps.Keys(byPR)) {
+		if byPR[pr] == nil {
+			continue
+		}
+
+		releaseNotes.Set(pr, byPR[pr])
+	}
+
+	return releaseNotes, nil
+}
+
+// DiffReleaseNotes compares two sets of release notes by their content hash
+// and returns the added, removed and modified notes ordered by PR number.
+// Notes are only reported as modified if one of the compared fields changed,
+// which means that a different commit or label order is not a modification.
+func DiffReleaseNotes(oldNotes, newNotes *ReleaseNotes) (*ReleaseNotesDiff, error) {
+	diff := &ReleaseNotesDiff{
+		Added:    []NoteDiff{},
+		Removed:  []NoteDiff{},
+		Modified: []NoteDiff{},
+	}
+
+	prs := slices.Sorted(maps.Keys(oldNotes.ByPR()))
+	for pr := range newNotes.ByPR() {
+		if oldNotes.Get(pr) == nil {
+			prs = append(prs, pr)
+		}
+	}
+
+	slices.Sort(prs)
+
+	for _, pr := range prs {
+		oldNote, newNote := oldNotes.Get(pr), newNotes.Get(pr)
+
+		switch {
+		case oldNote == nil:
+			diff.Added = append(diff.Added, NoteDiff{
+				PrNumber: pr, Type: NoteAdded, Text: newNote.Text,
+			})
+
+		case newNote == nil:
+			diff.Removed = append(diff.Removed, NoteDiff{
+				PrNumber: pr, Type: NoteRemoved, Text: oldNote.Text,
+			})
+
+		default:
+			oldHash, err := oldNote.ContentHash()
+			if err != nil {
+				return nil, fmt.Errorf("hashing old note for PR #%d: %w", pr, err)
+			}
+
+			newHash, err := newNote.ContentHash()
+			if err != nil {
+				return nil, fmt.Errorf("hashing new note for PR #%d: %w", pr, err)
+			}
+
+			if oldHash == newHash {
+				continue
+			}
+
+			changes := fieldChanges(oldNote, newNote)
+			if len(changes) == 0 {
+				continue
+			}
+
+			diff.Modified = append(diff.Modified, NoteDiff{
+				PrNumber: pr,
+				Type:     NoteModified,
+				Text:     newNote.Text,
+				Changes:  changes,
+			})
+		}
+	}
+
+	return diff, nil
+}
+
+// fieldChanges returns the modified fields between two notes. Labels are
+// compared regardless of their order.
+func fieldChanges(oldNote, newNote *ReleaseNote) []FieldChange {
+	changes := []FieldChange{}
+
+	addString := func(field, o, n string) {
+		if o != n {
+			changes = append(changes, FieldChange{Field: field, Old: o, New: n})
+		}
+	}
+
+	addBool := func(field string, o, n bool) {
+		if o != n {
+			changes = append(changes, FieldChange{Field: field, Old: o, New: n})
+		}
+	}
+
+	addLabels := func(field string, o, n []string) {
+		o, n = sortedLabels(o), sortedLabels(n)
+		if !slices.Equal(o, n) {
+			changes = append(changes, FieldChange{Field: field, Old: o, New: n})
+		}
+	}
+
+	addString("text", oldNote.Text, newNote.Text)
+	addString("author", oldNote.Author, newNote.Author)
+	addLabels("kinds", oldNote.Kinds, newNote.Kinds)
+	addLabels("sigs", oldNote.SIGs, newNote.SIGs)
+	addLabels("areas", oldNote.Areas, newNote.Areas)
+	addBool("action_required", oldNote.ActionRequired, newNote.ActionRequired)
+	addBool("feature", oldNote.Feature, newNote.Feature)
+	addBool("do_not_publish", oldNote.DoNotPublish, newNote.DoNotPublish)
+	addLabels("documentation", documentationURLs(oldNote), documentationURLs(newNote))
+	addString("pr_body", oldNote.PRBody, newNote.PRBody)
+
+	return changes
+}
+
+func sortedLabels(labels []string) []string {
+	res := slices.Clone(labels)
+	if res == nil {
+		res = []string{}
+	}
+
+	slices.Sort(res)
+
+	return res
+}
+
+func documentationURLs(note *ReleaseNote) []string {
+	urls := make([]string, 0, len(note.Documentation))
+	for _, doc := range note.Documentation {
+		if doc != nil {
+			urls = append(urls, doc.URL)
+		}
+	}
+
+	return urls
+}
+
+// Empty returns true if both release notes documents are equal.
+func (d *ReleaseNotesDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0
+}
+
+// String returns a human readable representation of the diff.
+func (d *ReleaseNotesDiff) String() string {
+	if d.Empty() {
+		return "No release note changes"
+	}
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "%d added, %d removed, %d modified release notes\n",
+		len(d.Added), len(d.Removed), len(d.Modified),
+	)
+
+	for _, section := range []struct {
+		title string
+		notes []NoteDiff
+	}{
+		{"Added", d.Added},
+		{"Removed", d.Removed},
+		{"Modified", d.Modified},
+	} {
+		if len(section.notes) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(sb, "\n%s:\n", section.title)
+
+		for _, note := range section.notes {
+			fmt.Fprintf(sb, "  #%d: %s\n", note.PrNumber, firstLine(note.Text))
+
+			for _, change := range note.Changes {
+				fmt.Fprintf(sb, "    %s: %s -> %s\n",
+					change.Field, formatFieldValue(change.Old), formatFieldValue(change.New),
+				)
+			}
+		}
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func firstLine(s string) string {
+	line, _, found := strings.Cut(strings.TrimSpace(s), "\n")
+	if found {
+		return line + " [...]"
+	}
+
+	return line
+}
+
+func formatFieldValue(v any) string {
+	switch value := v.(type) {
+	case string:
+		return fmt.Sprintf("%q", value)
+	case []string:
+		return "[" + strings.Join(value, ", ") + "]"
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newDiffTestNotes(notes ...*ReleaseNote) *ReleaseNotes {
+	res := NewReleaseNotes()
+	for _, note := range notes {
+		res.Set(note.PrNumber, note)
+	}
+
+	return res
+}
+
+func TestDiffReleaseNotes(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		oldNotes *ReleaseNotes
+		newNotes *ReleaseNotes
+		expected *ReleaseNotesDiff
+	}{
+		{
+			name: "equal notes",
+			oldNotes: newDiffTestNotes(
+				&ReleaseNote{PrNumber: 1, Text: "Note", Kinds: []string{"bug", "feature"}},
+			),
+			newNotes: newDiffTestNotes(
+				&ReleaseNote{PrNumber: 1, Text: "Note", Kinds: []string{"bug", "feature"}},
+			),
+			expected: &ReleaseNotesDiff{
+				Added: []NoteDiff{}, Removed: []NoteDiff{}, Modified: []NoteDiff{},
+			},
+		},
+		{
+			name: "added and removed notes",
+			oldNotes: newDiffTestNotes(
+				&ReleaseNote{PrNumber: 1, Text: "Removed"},
+				&ReleaseNote{PrNumber: 2, Text: "Kept"},
+			),
+			newNotes: newDiffTestNotes(
+				&ReleaseNote{PrNumber: 3, Text: "Added"},
+				&ReleaseNote{PrNumber: 2, Text: "Kept"},
+			),
+			expected: &ReleaseNotesDiff{
+				Added:    []NoteDiff{{PrNumber: 3, Type: NoteAdded, Text: "Added"}},
+				Removed:  []NoteDiff{{PrNumber: 1, Type: NoteRemoved, Text: "Removed"}},
+				Modified: []NoteDiff{},
+			},
+		},
+		{
+			name: "modified fields",
+			oldNotes: newDiffTestNotes(&ReleaseNote{
+				PrNumber: 10,
+				Text:     "Old text",
+				Kinds:    []string{"bug"},
+				SIGs:     []string{"node", "apps"},
+			}),
+			newNotes: newDiffTestNotes(&ReleaseNote{
+				PrNumber:       10,
+				Text:           "New text",
+				Kinds:          []string{"feature"},
+				SIGs:           []string{"apps", "node"},
+				ActionRequired: true,
+			}),
+			expected: &ReleaseNotesDiff{
+				Added:   []NoteDiff{},
+				Removed: []NoteDiff{},
+				Modified: []NoteDiff{{
+					PrNumber: 10,
+					Type:     NoteModified,
+					Text:     "New text",
+					Changes: []FieldChange{
+						{Field: "text", Old: "Old text", New: "New text"},
+						{Field: "kinds", Old: []string{"bug"}, New: []string{"feature"}},
+						{Field: "action_required", Old: false, New: true},
+					},
+				}},
+			},
+		},
+		{
+			name: "commit and label order changes are ignored",
+			oldNotes: newDiffTestNotes(&ReleaseNote{
+				PrNumber: 1,
+				Commit:   "aaa",
+				Text:     "Note",
+				SIGs:     []string{"node", "apps"},
+				Documentation: []*Documentation{
+					{URL: "https://kep.k8s.io/1"},
+					{URL: "https://k8s.io/docs"},
+				},
+			}),
+			newNotes: newDiffTestNotes(&ReleaseNote{
+				PrNumber: 1,
+				Commit:   "bbb",
+				Text:     "Note",
+				SIGs:     []string{"apps", "node"},
+				Documentation: []*Documentation{
+					{URL: "https://k8s.io/docs"},
+					{URL: "https://kep.k8s.io/1"},
+				},
+			}),
+			expected: &ReleaseNotesDiff{
+				Added: []NoteDiff{}, Removed: []NoteDiff{}, Modified: []NoteDiff{},
+			},
+		},
+		{
+			name: "changes outside of the content hash are ignored",
+			oldNotes: newDiffTestNotes(
+				&ReleaseNote{PrNumber: 1, Text: "Note", Markdown: "Note ([#1], @foo)"},
+			),
+			newNotes: newDiffTestNotes(
+				&ReleaseNote{PrNumber: 1, Text: "Note", Markdown: "Note"},
+			),
+			expected: &ReleaseNotesDiff{
+				Added: []NoteDiff{}, Removed: []NoteDiff{}, Modified: []NoteDiff{},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := DiffReleaseNotes(tc.oldNotes, tc.newNotes)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestReleaseNotesDiffString(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "No release note changes", (&ReleaseNotesDiff{}).String())
+
+	diff := &ReleaseNotesDiff{
+		Added:   []NoteDiff{{PrNumber: 3, Type: NoteAdded, Text: "Added\nsecond line"}},
+		Removed: []NoteDiff{{PrNumber: 1, Type: NoteRemoved, Text: "Removed"}},
+		Modified: []NoteDiff{{
+			PrNumber: 10,
+			Type:     NoteModified,
+			Text:     "New text",
+			Changes: []FieldChange{
+				{Field: "text", Old: "Old text", New: "New text"},
+				{Field: "sigs", Old: []string{"node"}, New: []string{"apps", "node"}},
+				{Field: "action_required", Old: false, New: true},
+			},
+		}},
+	}
+
+	require.Equal(t, strings.Join([]string{
+		"1 added, 1 removed, 1 modified release notes",
+		"",
+		"Added:",
+		"  #3: Added [...]",
+		"",
+		"Removed:",
+		"  #1: Removed",
+		"",
+		"Modified:",
+		"  #10: New text",
+		`    text: "Old text" -> "New text"`,
+		"    sigs: [node] -> [apps, node]",
+		"    action_required: false -> true",
+	}, "\n"), diff.String())
+}
+
+func TestParseReleaseNotesJSON(t *testing.T) {
+	t.Parallel()
+
+	res, err := ParseReleaseNotesJSON(strings.NewReader(`{
+  "2": {"pr_number": 2, "text": "Second"},
+  "1": {"pr_number": 1, "text": "First", "kinds": ["bug"]}
+}`))
+	require.NoError(t, err)
+	require.Equal(t, ReleaseNotesHistory{1, 2}, res.History())
+	require.Equal(t, "First", res.Get(1).Text)
+	require.Equal(t, []string{"bug"}, res.Get(1).Kinds)
+
+	_, err = ParseReleaseNotesJSON(strings.NewReader("invalid"))
+	require.Error(t, err)
+}
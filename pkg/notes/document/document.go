@@ -21,12 +21,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
-	"text/template"
 
 	"github.com/sirupsen/logrus"
 	"golang.org/x/text/cases"
@@ -46,6 +47,7 @@ const prodRegistry = "registry.k8s.io"
 type Document struct {
 	NotesWithActionRequired notes.Notes    `json:"action_required"`
 	Notes                   NoteCollection `json:"notes"`
+	NotesBySIG              SIGCollection  `json:"notes_by_sig,omitempty"`
 	FileDownloads           *FileMetadata  `json:"downloads"`
 	ImageDownloads          *ImageMetadata `json:"images"`
 	CurrentRevision         string         `json:"release_tag"`
@@ -237,6 +239,15 @@ func (n *NoteCollection) Sort(kindPriority []notes.Kind) {
 	})
 }
 
+// SIGCategory contains notes which belong to the same SIG.
+type SIGCategory struct {
+	SIG         string
+	NoteEntries *notes.Notes
+}
+
+// SIGCollection is a collection of SIG categories.
+type SIGCollection []SIGCategory
+
 var kindPriority = []notes.Kind{
 	notes.KindDeprecation,
 	notes.KindAPIChange,
@@ -296,6 +307,7 @@ func New(
 	}
 
 	kindCategory := make(map[notes.Kind]NoteCategory)
+	sigCategory := make(map[string]*notes.Notes)
 
 	for _, pr := range releaseNotes.History() {
 		note := releaseNotes.Get(pr)
@@ -325,6 +337,14 @@ func New(
 			continue
 		}
 
+		for _, sig := range note.SIGs {
+			if _, ok := sigCategory[sig]; !ok {
+				sigCategory[sig] = &notes.Notes{}
+			}
+
+			*sigCategory[sig] = append(*sigCategory[sig], processNote(note.Markdown))
+		}
+
 		// TODO: Refactor the logic here and add testing.
 		if note.DuplicateKind { //nolint:gocritic // a switch case would not make it better
 			kind := mapKind(highestPriorityKind(note.Kinds))
@@ -366,6 +386,13 @@ func New(
 	doc.Notes.Sort(kindPriority)
 	sort.Strings(doc.NotesWithActionRequired)
 
+	for _, sig := range slices.Sorted(maps.Keys(sigCategory)) {
+		sort.Strings(*sigCategory[sig])
+		doc.NotesBySIG = append(doc.NotesBySIG, SIGCategory{
+			SIG: sig, NoteEntries: sigCategory[sig],
+		})
+	}
+
 	return doc, nil
 }
 
@@ -373,40 +400,33 @@ func New(
 // `templateSpec`. If `templateSpec` is set to `options.GoTemplateDefault`,
 // then it renders in the default template markdown format.
 func (d *Document) RenderMarkdownTemplate(bucket, tars, images, templateSpec string) (string, error) {
+	if err := d.FetchDownloads(bucket, tars, images); err != nil {
+		return "", err
+	}
+
+	return (&MarkdownRenderer{TemplateSpec: templateSpec}).Render(d)
+}
+
+// FetchDownloads populates the file and image downloads of the document from
+// the `tars` and `images` directories. Both directories are optional.
+func (d *Document) FetchDownloads(bucket, tars, images string) error {
 	urlPrefix := release.URLPrefixForBucket(bucket)
 
 	fileMetadata, err := fetchFileMetadata(tars, urlPrefix, d.CurrentRevision)
 	if err != nil {
-		return "", fmt.Errorf("fetching file downloads metadata: %w", err)
+		return fmt.Errorf("fetching file downloads metadata: %w", err)
 	}
 
 	d.FileDownloads = fileMetadata
 
 	imageMetadata, err := fetchImageMetadata(images, d.CurrentRevision)
 	if err != nil {
-		return "", fmt.Errorf("fetching image downloads metadata: %w", err)
+		return fmt.Errorf("fetching image downloads metadata: %w", err)
 	}
 
 	d.ImageDownloads = imageMetadata
 
-	goTemplate, err := d.template(templateSpec)
-	if err != nil {
-		return "", fmt.Errorf("fetching template: %w", err)
-	}
-
-	tmpl, err := template.New("markdown").
-		Funcs(template.FuncMap{"prettyKind": prettyKind}).
-		Parse(goTemplate)
-	if err != nil {
-		return "", fmt.Errorf("parsing template: %w", err)
-	}
-
-	var s strings.Builder
-	if err := tmpl.Execute(&s, d); err != nil {
-		return "", fmt.Errorf("rendering with template: %w", err)
-	}
-
-	return strings.TrimSpace(s.String()), nil
+	return nil
 }
 
 // template returns either the default template, a template from file or an
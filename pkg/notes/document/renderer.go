@@ -0,0 +1,262 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package document
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"regexp"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+
+	"k8s.io/release/pkg/notes"
+	"k8s.io/release/pkg/notes/options"
+)
+
+// Renderer converts a release notes document into an output format.
+type Renderer interface {
+	// Render returns the document in the format of the renderer.
+	Render(doc *Document) (string, error)
+}
+
+// NewRenderer returns the renderer for the provided release notes format.
+// The `templateSpec` is only used by the markdown renderer.
+func NewRenderer(format, templateSpec string) (Renderer, error) { //nolint:ireturn // returning interface is intentional
+	switch format {
+	case options.FormatMarkdown:
+		return &MarkdownRenderer{TemplateSpec: templateSpec}, nil
+	case options.FormatHTML:
+		return &HTMLRenderer{}, nil
+	case options.FormatAsciiDoc:
+		return &AsciiDocRenderer{}, nil
+	case options.FormatRST:
+		return &RSTRenderer{}, nil
+	default:
+		return nil, fmt.Errorf("no document renderer available for format %q", format)
+	}
+}
+
+// MarkdownRenderer renders the document by using a markdown go template.
+type MarkdownRenderer struct {
+	// TemplateSpec is the go template in the format of
+	// `go-template:{default|path/to/template.ext}` or
+	// `go-template:inline:string`.
+	TemplateSpec string
+}
+
+// Render renders the document in markdown.
+func (r *MarkdownRenderer) Render(doc *Document) (string, error) {
+	goTemplate, err := doc.template(r.TemplateSpec)
+	if err != nil {
+		return "", fmt.Errorf("fetching template: %w", err)
+	}
+
+	return renderTextTemplate("markdown", goTemplate, doc, template.FuncMap{
+		"prettyKind": prettyKind,
+	})
+}
+
+// HTMLRenderer renders the document as standalone HTML page, which contains
+// anchors for every kind and SIG section.
+type HTMLRenderer struct{}
+
+// Render renders the document in HTML.
+func (*HTMLRenderer) Render(doc *Document) (string, error) {
+	md := goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+	tmpl, err := htmltemplate.New("html").Funcs(htmltemplate.FuncMap{
+		"prettyKind": prettyKind,
+		"prettySIG":  notes.PrettySIG,
+		"anchor":     anchor,
+		"inline": func(s string) (htmltemplate.HTML, error) {
+			var buf bytes.Buffer
+			if err := md.Convert([]byte(s), &buf); err != nil {
+				return "", fmt.Errorf("converting markdown: %w", err)
+			}
+
+			// Single paragraphs are rendered inline
+			res := strings.TrimSpace(buf.String())
+			if strings.Count(res, "<p>") == 1 {
+				res = strings.TrimSuffix(strings.TrimPrefix(res, "<p>"), "</p>")
+			}
+
+			return htmltemplate.HTML(res), nil //nolint:gosec // goldmark escapes raw HTML
+		},
+	}).Parse(htmlReleaseNotesTemplate)
+	if err != nil {
+		return "", fmt.Errorf("parsing template: %w", err)
+	}
+
+	var s strings.Builder
+	if err := tmpl.Execute(&s, doc); err != nil {
+		return "", fmt.Errorf("rendering with template: %w", err)
+	}
+
+	return strings.TrimSpace(s.String()), nil
+}
+
+// AsciiDocRenderer renders the document in AsciiDoc.
+type AsciiDocRenderer struct{}
+
+// Render renders the document in AsciiDoc.
+func (*AsciiDocRenderer) Render(doc *Document) (string, error) {
+	return renderTextTemplate("asciidoc", asciiDocReleaseNotesTemplate, doc, template.FuncMap{
+		"prettyKind": prettyKind,
+		"anchor":     anchor,
+		"inline": func(s string) string {
+			return convertInline(s,
+				func(text, url string) string {
+					return "link:" + url + "[" + strings.ReplaceAll(text, "]", `\]`) + "]"
+				},
+				func(code string) string { return "`+" + code + "+`" },
+				"",
+			)
+		},
+	})
+}
+
+// RSTRenderer renders the document in reStructuredText.
+type RSTRenderer struct{}
+
+// Render renders the document in reStructuredText.
+func (*RSTRenderer) Render(doc *Document) (string, error) {
+	inline := func(s string) string {
+		return convertInline(s,
+			func(text, url string) string {
+				return "`" + strings.ReplaceAll(text, "<", `\<`) + " <" + url + ">`__"
+			},
+			func(code string) string { return "``" + code + "``" },
+			`\ `,
+		)
+	}
+
+	return renderTextTemplate("rst", rstReleaseNotesTemplate, doc, template.FuncMap{
+		"prettyKind": prettyKind,
+		"anchor":     anchor,
+		"inline":     inline,
+		"title": func(char, title string) string {
+			return title + "\n" + strings.Repeat(char, utf8.RuneCountInString(title))
+		},
+		// Continuation lines of list items have to be indented
+		"item": func(s string) string {
+			return strings.ReplaceAll(inline(s), "\n", "\n  ")
+		},
+	})
+}
+
+// renderTextTemplate renders the document with the provided text template.
+func renderTextTemplate(
+	name, goTemplate string, doc *Document, funcs template.FuncMap,
+) (string, error) {
+	tmpl, err := template.New(name).Funcs(funcs).Parse(goTemplate)
+	if err != nil {
+		return "", fmt.Errorf("parsing template: %w", err)
+	}
+
+	var s strings.Builder
+	if err := tmpl.Execute(&s, doc); err != nil {
+		return "", fmt.Errorf("rendering with template: %w", err)
+	}
+
+	return strings.TrimSpace(s.String()), nil
+}
+
+var (
+	anchorRE       = regexp.MustCompile(`[^a-z0-9]+`)
+	markdownLinkRE = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	markdownCodeRE = regexp.MustCompile("`([^`]+)`")
+)
+
+// anchor converts the provided parts into a lowercase identifier, which can
+// be used for linking to a section.
+func anchor(parts ...any) string {
+	s := strings.ToLower(fmt.Sprint(parts...))
+
+	return strings.Trim(anchorRE.ReplaceAllString(s, "-"), "-")
+}
+
+// convertInline converts the markdown links and code spans of the text by
+// using the provided functions. The `boundary` gets inserted between a
+// converted element and an adjacent letter or digit, which is required by
+// formats only supporting inline markup at word boundaries.
+func convertInline(
+	text string,
+	link func(text, url string) string,
+	code func(code string) string,
+	boundary string,
+) string {
+	var (
+		sb          strings.Builder
+		afterMarkup bool
+	)
+
+	writeText := func(s string) {
+		if s == "" {
+			return
+		}
+
+		first, _ := utf8.DecodeRuneInString(s)
+		if afterMarkup && isWordRune(first) {
+			sb.WriteString(boundary)
+		}
+
+		sb.WriteString(s)
+		afterMarkup = false
+	}
+
+	writeMarkup := func(s string) {
+		last, _ := utf8.DecodeLastRuneInString(sb.String())
+		if sb.Len() > 0 && isWordRune(last) {
+			sb.WriteString(boundary)
+		}
+
+		sb.WriteString(s)
+		afterMarkup = true
+	}
+
+	convertLinks := func(s string) {
+		last := 0
+		for _, m := range markdownLinkRE.FindAllStringSubmatchIndex(s, -1) {
+			writeText(s[last:m[0]])
+			writeMarkup(link(s[m[2]:m[3]], s[m[4]:m[5]]))
+			last = m[1]
+		}
+
+		writeText(s[last:])
+	}
+
+	last := 0
+	for _, m := range markdownCodeRE.FindAllStringSubmatchIndex(text, -1) {
+		convertLinks(text[last:m[0]])
+		writeMarkup(code(text[m[2]:m[3]]))
+		last = m[1]
+	}
+
+	convertLinks(text[last:])
+
+	return sb.String()
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package document
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/cve"
+	"k8s.io/release/pkg/notes"
+	"k8s.io/release/pkg/notes/options"
+	"k8s.io/release/pkg/release"
+)
+
+func TestRenderer(t *testing.T) {
+	dir := t.TempDir()
+	setupTestDir(t, dir)
+
+	for _, tc := range []struct {
+		format         string
+		withCVE        bool
+		wantGoldenFile string
+	}{
+		{options.FormatMarkdown, false, "document.md.golden"},
+		{options.FormatHTML, true, "document.html.golden"},
+		{options.FormatAsciiDoc, true, "document.adoc.golden"},
+		{options.FormatRST, true, "document.rst.golden"},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			testNotes := notes.NewReleaseNotes()
+			testNotes.Set(0, makeSIGReleaseNote(notes.KindDeprecation, "Deprecation #1.", "api-machinery"))
+			testNotes.Set(1, makeSIGReleaseNote(notes.KindBug, "Bugfix.", "node"))
+			testNotes.Set(2, makeSIGReleaseNote(notes.KindCleanup, "Clean up.", "node", "cli"))
+			testNotes.Set(3, makeSIGReleaseNote(notes.KindDesign, "Design change."))
+			testNotes.Set(4, makeSIGReleaseNote(notes.KindDocumentation, "Update docs."))
+			testNotes.Set(5, makeSIGReleaseNote(notes.KindFailingTest, "Fix a failing test."))
+			testNotes.Set(6, makeSIGReleaseNote(notes.KindFeature, "A feature."))
+			testNotes.Set(7, makeSIGReleaseNote(notes.KindFlake, "Fix a flakey test."))
+			testNotes.Set(8, makeSIGReleaseNote("", "Uncategorized note."))
+			testNotes.Set(9, makeSIGReleaseNote(notes.KindBug, "- This note was prepended with a dash (-) initially."))
+			testNotes.Set(10, makeSIGReleaseNote(notes.KindBug, "* This note was prepended with a star (*) initially."))
+
+			duplicate := makeSIGReleaseNote(notes.KindDeprecation, "This note is duplicated across SIGs.", "apps", "node")
+			duplicate.Kinds = append(duplicate.Kinds, string(notes.KindBug))
+			duplicate.DuplicateKind = true
+
+			actionNeeded := makeSIGReleaseNote(notes.KindAPIChange, "Action required note.", "api-machinery")
+			actionNeeded.ActionRequired = true
+
+			testNotes.Set(11, duplicate)
+			testNotes.Set(12, actionNeeded)
+
+			doc, err := New(testNotes, "v1.16.0", "v1.28.1")
+			require.NoError(t, err)
+
+			if tc.withCVE {
+				doc.CVEList = []cve.CVE{{
+					ID:            "CVE-2022-1996",
+					Title:         "Authorization bypass",
+					Description:   "Run `kubectl get` with [an invalid token](https://example.com).",
+					TrackingIssue: "https://github.com/kubernetes/kubernetes/issues/1",
+					CVSSVector:    "CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H",
+					CVSSScore:     6.2,
+					CVSSRating:    "Medium",
+					CalcLink:      "https://www.first.org/cvss/calculator/3.1",
+				}}
+			}
+
+			require.NoError(t, doc.FetchDownloads(release.ProductionBucket, dir, dir))
+
+			renderer, err := NewRenderer(tc.format, options.GoTemplateDefault)
+			require.NoError(t, err)
+
+			got, err := renderer.Render(doc)
+			require.NoError(t, err)
+			require.Equal(t, readFile(t, filepath.Join("testdata", tc.wantGoldenFile)), got)
+		})
+	}
+}
+
+func TestNewRendererFailure(t *testing.T) {
+	for _, format := range []string{"", options.FormatJSON, "pdf"} {
+		_, err := NewRenderer(format, options.GoTemplateDefault)
+		require.Error(t, err, format)
+	}
+}
+
+func TestNotesBySIG(t *testing.T) {
+	testNotes := notes.NewReleaseNotes()
+	testNotes.Set(0, makeSIGReleaseNote(notes.KindBug, "Node fix.", "node"))
+	testNotes.Set(1, makeSIGReleaseNote(notes.KindFeature, "Shared feature.", "node", "apps"))
+	testNotes.Set(2, makeSIGReleaseNote(notes.KindFeature, "No SIG."))
+
+	skipped := makeSIGReleaseNote(notes.KindBug, "Not published.", "apps")
+	skipped.DoNotPublish = true
+	testNotes.Set(3, skipped)
+
+	doc, err := New(testNotes, "v1.16.0", "v1.16.1")
+	require.NoError(t, err)
+	require.Equal(t, SIGCollection{
+		{SIG: "apps", NoteEntries: &notes.Notes{"Shared feature."}},
+		{SIG: "node", NoteEntries: &notes.Notes{"Node fix.", "Shared feature."}},
+	}, doc.NotesBySIG)
+}
+
+func TestConvertInline(t *testing.T) {
+	link := func(text, url string) string { return "<" + text + "|" + url + ">" }
+	code := func(code string) string { return "'" + code + "'" }
+
+	for _, tc := range []struct {
+		input, boundary, expected string
+	}{
+		{"plain text", "", "plain text"},
+		{"use `--flag`s", "", "use '--flag's"},
+		{"see [docs](https://k8s.io) now", "", "see <docs|https://k8s.io> now"},
+		{"run `kubectl` and `kubeadm`", "", "run 'kubectl' and 'kubeadm'"},
+		{"a `[link](url)` in code", "", "a '[link](url)' in code"},
+		{"use `--flag`s", `\ `, `use '--flag'\ s`},
+		{"pre[docs](url)", `\ `, `pre\ <docs|url>`},
+		{"[docs](url).", `\ `, "<docs|url>."},
+	} {
+		require.Equal(t, tc.expected, convertInline(tc.input, link, code, tc.boundary), tc.input)
+	}
+}
+
+func TestAnchor(t *testing.T) {
+	require.Equal(t, "kind-api-change", anchor("kind-", notes.KindAPIChange))
+	require.Equal(t, "sig-cloud-provider", anchor("sig-", "Cloud Provider"))
+	require.Equal(t, "cve-2022-1996", anchor("CVE-2022-1996"))
+	require.Equal(t, "v1-28-1", anchor("v1.28.1"))
+}
+
+func TestRSTTitle(t *testing.T) {
+	got, err := (&RSTRenderer{}).Render(&Document{CurrentRevision: "v1.28.1"})
+	require.NoError(t, err)
+	require.Equal(t, strings.Join([]string{
+		"Kubernetes v1.28.1 Release Notes",
+		strings.Repeat("=", len("Kubernetes v1.28.1 Release Notes")),
+	}, "\n"), got)
+}
+
+func makeSIGReleaseNote(kind notes.Kind, markdown string, sigs ...string) *notes.ReleaseNote {
+	n := makeReleaseNote(kind, markdown)
+	n.SIGs = sigs
+
+	return n
+}
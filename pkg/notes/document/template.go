@@ -113,3 +113,366 @@ This release contains changes that address the following vulnerabilities:
 {{- end -}}
 {{- end -}}
 `
+
+// htmlReleaseNotesTemplate is the html/template for standalone HTML release
+// notes. Every kind and SIG section contains an anchor for direct linking.
+const htmlReleaseNotesTemplate = `
+{{- define "files" -}}
+<table>
+<thead><tr><th>filename</th><th>sha512 hash</th></tr></thead>
+<tbody>
+{{range .}}<tr><td><a href="{{.URL}}">{{.Name}}</a></td><td><code>{{.Checksum}}</code></td></tr>
+{{end -}}
+</tbody>
+</table>
+{{- end -}}
+<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Kubernetes {{.CurrentRevision}} Release Notes</title>
+</head>
+<body>
+<h1 id="{{anchor .CurrentRevision}}">Kubernetes {{.CurrentRevision}} Release Notes</h1>
+<nav>
+<ul>
+{{- if or .FileDownloads .ImageDownloads}}
+<li><a href="#downloads">Downloads for {{.CurrentRevision}}</a></li>
+{{- end}}
+{{- if .CVEList}}
+<li><a href="#important-security-information">Important Security Information</a></li>
+{{- end}}
+{{- if .NotesWithActionRequired}}
+<li><a href="#urgent-upgrade-notes">Urgent Upgrade Notes</a></li>
+{{- end}}
+{{- with .Notes}}
+<li><a href="#changes-by-kind">Changes by Kind</a>
+<ul>
+{{- range .}}
+<li><a href="#{{anchor "kind-" .Kind}}">{{prettyKind .Kind}}</a></li>
+{{- end}}
+</ul>
+</li>
+{{- end}}
+{{- with .NotesBySIG}}
+<li><a href="#changes-by-sig">Changes by SIG</a>
+<ul>
+{{- range .}}
+<li><a href="#{{anchor "sig-" .SIG}}">{{prettySIG .SIG}}</a></li>
+{{- end}}
+</ul>
+</li>
+{{- end}}
+</ul>
+</nav>
+{{- if or .FileDownloads .ImageDownloads}}
+<section>
+<h2 id="downloads">Downloads for {{.CurrentRevision}}</h2>
+{{- with .FileDownloads}}
+{{- with .Source}}
+<h3 id="source-code">Source Code</h3>
+{{template "files" .}}
+{{- end}}
+{{- with .Client}}
+<h3 id="client-binaries">Client Binaries</h3>
+{{template "files" .}}
+{{- end}}
+{{- with .Server}}
+<h3 id="server-binaries">Server Binaries</h3>
+{{template "files" .}}
+{{- end}}
+{{- with .Node}}
+<h3 id="node-binaries">Node Binaries</h3>
+{{template "files" .}}
+{{- end}}
+{{- end}}
+{{- with .ImageDownloads}}
+<h3 id="container-images">Container Images</h3>
+<p>All container images are available as manifest lists and support the described
+architectures. It is also possible to pull a specific architecture directly by
+adding the "-$ARCH" suffix to the container image name.</p>
+<table>
+<thead><tr><th>name</th><th>architectures</th></tr></thead>
+<tbody>
+{{range .}}<tr><td>{{inline .Name}}</td><td>{{range $i, $a := .Architectures}}{{if $i}}, {{end}}{{inline $a}}{{end}}</td></tr>
+{{end -}}
+</tbody>
+</table>
+{{- end}}
+</section>
+{{- end}}
+{{- with .CVEList}}
+<section>
+<h2 id="important-security-information">Important Security Information</h2>
+<p>This release contains changes that address the following vulnerabilities:</p>
+{{- range .}}
+<h3 id="{{anchor .ID}}">{{.ID}}: {{.Title}}</h3>
+<p>{{inline .Description}}</p>
+<p><strong>CVSS Rating:</strong> {{.CVSSRating}} ({{.CVSSScore}}) <a href="{{.CalcLink}}">{{.CVSSVector}}</a>
+{{- if .TrackingIssue}}<br>
+<strong>Tracking Issue:</strong> {{.TrackingIssue}}
+{{- end}}</p>
+{{- end}}
+</section>
+{{- end}}
+{{- with .NotesWithActionRequired}}
+<section>
+<h2 id="urgent-upgrade-notes">Urgent Upgrade Notes</h2>
+<h3>(No, really, you MUST read this before you upgrade)</h3>
+<ul>
+{{- range .}}
+<li>{{inline .}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+{{- with .Notes}}
+<section>
+<h2 id="changes-by-kind">Changes by Kind</h2>
+{{- range .}}
+<h3 id="{{anchor "kind-" .Kind}}">{{prettyKind .Kind}}</h3>
+<ul>
+{{- range .NoteEntries}}
+<li>{{inline .}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</section>
+{{- end}}
+{{- with .NotesBySIG}}
+<section>
+<h2 id="changes-by-sig">Changes by SIG</h2>
+{{- range .}}
+<h3 id="{{anchor "sig-" .SIG}}">{{prettySIG .SIG}}</h3>
+<ul>
+{{- range .NoteEntries}}
+<li>{{inline .}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</section>
+{{- end}}
+</body>
+</html>
+`
+
+// asciiDocReleaseNotesTemplate is the text/template for AsciiDoc release
+// notes.
+const asciiDocReleaseNotesTemplate = `
+{{- define "files" -}}
+[cols="1,3",options="header"]
+|===
+|filename |sha512 hash
+{{range .}}
+|link:{{.URL}}[{{.Name}}]
+|` + "`+{{.Checksum}}+`" + `
+{{end -}}
+|===
+{{- end -}}
+= Kubernetes {{.CurrentRevision}} Release Notes
+{{- if or .FileDownloads .ImageDownloads}}
+
+[[downloads]]
+== Downloads for {{.CurrentRevision}}
+{{- with .FileDownloads}}
+{{- with .Source}}
+
+=== Source Code
+
+{{template "files" .}}
+{{- end}}
+{{- with .Client}}
+
+=== Client Binaries
+
+{{template "files" .}}
+{{- end}}
+{{- with .Server}}
+
+=== Server Binaries
+
+{{template "files" .}}
+{{- end}}
+{{- with .Node}}
+
+=== Node Binaries
+
+{{template "files" .}}
+{{- end}}
+{{- end}}
+{{- with .ImageDownloads}}
+
+=== Container Images
+
+All container images are available as manifest lists and support the described
+architectures. It is also possible to pull a specific architecture directly by
+adding the "-$ARCH" suffix to the container image name.
+
+[cols="2,1",options="header"]
+|===
+|name |architectures
+{{range .}}
+|{{inline .Name}}
+|{{range $i, $a := .Architectures}}{{if $i}}, {{end}}{{inline $a}}{{end}}
+{{end -}}
+|===
+{{- end}}
+{{- end}}
+{{- with .CVEList}}
+
+[[important-security-information]]
+== Important Security Information
+
+This release contains changes that address the following vulnerabilities:
+{{- range .}}
+
+[[{{anchor .ID}}]]
+=== {{.ID}}: {{.Title}}
+
+{{inline .Description}}
+
+*CVSS Rating:* {{.CVSSRating}} ({{.CVSSScore}}) link:{{.CalcLink}}[{{.CVSSVector}}]
+{{- if .TrackingIssue}} +
+*Tracking Issue:* {{.TrackingIssue}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- with .NotesWithActionRequired}}
+
+[[urgent-upgrade-notes]]
+== Urgent Upgrade Notes
+
+=== (No, really, you MUST read this before you upgrade)
+{{range .}}
+* {{inline .}}
+{{- end}}
+{{- end}}
+{{- with .Notes}}
+
+[[changes-by-kind]]
+== Changes by Kind
+{{- range .}}
+
+[[{{anchor "kind-" .Kind}}]]
+=== {{prettyKind .Kind}}
+{{range .NoteEntries}}
+* {{inline .}}
+{{- end}}
+{{- end}}
+{{- end}}
+`
+
+// rstReleaseNotesTemplate is the text/template for reStructuredText release
+// notes.
+const rstReleaseNotesTemplate = `
+{{- define "files" -}}
+.. list-table::
+   :header-rows: 1
+
+   * - filename
+     - sha512 hash
+{{- range .}}
+   * - ` + "`{{.Name}} <{{.URL}}>`__" + `
+     - ` + "``{{.Checksum}}``" + `
+{{- end}}
+{{- end -}}
+{{title "=" (print "Kubernetes " .CurrentRevision " Release Notes")}}
+{{- if or .FileDownloads .ImageDownloads}}
+
+.. _downloads:
+
+{{title "-" (print "Downloads for " .CurrentRevision)}}
+{{- with .FileDownloads}}
+{{- with .Source}}
+
+{{title "~" "Source Code"}}
+
+{{template "files" .}}
+{{- end}}
+{{- with .Client}}
+
+{{title "~" "Client Binaries"}}
+
+{{template "files" .}}
+{{- end}}
+{{- with .Server}}
+
+{{title "~" "Server Binaries"}}
+
+{{template "files" .}}
+{{- end}}
+{{- with .Node}}
+
+{{title "~" "Node Binaries"}}
+
+{{template "files" .}}
+{{- end}}
+{{- end}}
+{{- with .ImageDownloads}}
+
+{{title "~" "Container Images"}}
+
+All container images are available as manifest lists and support the described
+architectures. It is also possible to pull a specific architecture directly by
+adding the "-$ARCH" suffix to the container image name.
+
+.. list-table::
+   :header-rows: 1
+
+   * - name
+     - architectures
+{{- range .}}
+   * - {{inline .Name}}
+     - {{range $i, $a := .Architectures}}{{if $i}}, {{end}}{{inline $a}}{{end}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- with .CVEList}}
+
+.. _important-security-information:
+
+{{title "-" "Important Security Information"}}
+
+This release contains changes that address the following vulnerabilities:
+{{- range .}}
+
+.. _{{anchor .ID}}:
+
+{{title "~" (print .ID ": " .Title)}}
+
+{{inline .Description}}
+
+**CVSS Rating:** {{.CVSSRating}} ({{.CVSSScore}}) ` + "`{{.CVSSVector}} <{{.CalcLink}}>`__" + `
+{{- if .TrackingIssue}}
+
+**Tracking Issue:** {{.TrackingIssue}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- with .NotesWithActionRequired}}
+
+.. _urgent-upgrade-notes:
+
+{{title "-" "Urgent Upgrade Notes"}}
+
+{{title "~" "(No, really, you MUST read this before you upgrade)"}}
+{{range .}}
+- {{item .}}
+{{- end}}
+{{- end}}
+{{- with .Notes}}
+
+.. _changes-by-kind:
+
+{{title "-" "Changes by Kind"}}
+{{- range .}}
+
+.. _{{anchor "kind-" .Kind}}:
+
+{{title "~" (prettyKind .Kind)}}
+{{range .NoteEntries}}
+- {{item .}}
+{{- end}}
+{{- end}}
+{{- end}}
+`
@@ -0,0 +1,402 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-sdk/github"
+
+	"k8s.io/release/pkg/notes/options"
+)
+
+// PullRequest is the forge independent representation of a GitHub or Gitea
+// pull request and a GitLab merge request.
+type PullRequest struct {
+	// Number is the number of the request, which is the IID on GitLab.
+	Number int
+
+	// Body is the description of the request.
+	Body string
+
+	// Labels are the label names of the request, like `kind/bug`.
+	Labels []string
+
+	// Author is the user name of the request author.
+	Author string
+
+	// AuthorURL is the web URL of the author profile.
+	AuthorURL string
+
+	// URL is the web URL of the request.
+	URL string
+
+	// SourceBranch is the qualified source branch, like
+	// `k8s-infra-cherrypick-robot:cherry-pick-123-to-release-1.30`.
+	SourceBranch string
+}
+
+// Forge is the abstraction of a code hosting platform which provides the
+// pull requests for gathering release notes.
+//
+//counterfeiter:generate . Forge
+type Forge interface {
+	// GetPullRequest returns the pull request for the provided number.
+	GetPullRequest(ctx context.Context, owner, repo string, number int) (*PullRequest, error)
+}
+
+// NewForge creates a new forge from the provided options. GitLab and Gitea
+// API responses get recorded or replayed if the record or replay directory
+// is set.
+func NewForge(opts *options.Options) (Forge, error) { //nolint:ireturn // returning interface is intentional
+	switch opts.Forge {
+	case "", options.ForgeGitHub:
+		client, err := opts.Client()
+		if err != nil {
+			return nil, fmt.Errorf("unable to create GitHub client: %w", err)
+		}
+
+		return NewGitHubForge(client), nil
+
+	case options.ForgeGitLab:
+		return NewGitLabForge(opts.ForgeURL, forgeHTTPClient(opts, "PRIVATE-TOKEN", opts.ForgeToken())), nil
+
+	case options.ForgeGitea:
+		token := opts.ForgeToken()
+		if token != "" {
+			token = "token " + token
+		}
+
+		return NewGiteaForge(opts.ForgeURL, forgeHTTPClient(opts, "Authorization", token)), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported forge: %s", opts.Forge)
+	}
+}
+
+// GitHubForge is the forge for GitHub.
+type GitHubForge struct {
+	client github.Client
+}
+
+// NewGitHubForge creates a new GitHub forge for the provided client.
+func NewGitHubForge(client github.Client) *GitHubForge {
+	return &GitHubForge{client: client}
+}
+
+// GetPullRequest returns the GitHub pull request for the provided number.
+// It waits and retries if the secondary API rate limit has been hit.
+func (f *GitHubForge) GetPullRequest(
+	ctx context.Context, owner, repo string, number int,
+) (*PullRequest, error) {
+	for {
+		pr, resp, err := f.client.GetPullRequest(ctx, owner, repo, number)
+		if err != nil {
+			if !canWaitAndRetry(resp, err) {
+				return nil, err
+			}
+
+			continue
+		}
+
+		labels := make([]string, 0, len(pr.Labels))
+		for _, label := range pr.Labels {
+			labels = append(labels, label.GetName())
+		}
+
+		return &PullRequest{
+			Number:       pr.GetNumber(),
+			Body:         pr.GetBody(),
+			Labels:       labels,
+			Author:       pr.GetUser().GetLogin(),
+			AuthorURL:    pr.GetUser().GetHTMLURL(),
+			URL:          pr.GetHTMLURL(),
+			SourceBranch: pr.GetHead().GetLabel(),
+		}, nil
+	}
+}
+
+// GitLabForge is the forge for GitLab merge requests.
+type GitLabForge struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewGitLabForge creates a new GitLab forge for the instance base URL, for
+// example `https://gitlab.com`.
+func NewGitLabForge(baseURL string, client *http.Client) *GitLabForge {
+	return &GitLabForge{baseURL: strings.TrimSuffix(baseURL, "/"), client: client}
+}
+
+type gitLabMergeRequest struct {
+	IID          int      `json:"iid"`
+	Description  string   `json:"description"`
+	Labels       []string `json:"labels"`
+	WebURL       string   `json:"web_url"`
+	SourceBranch string   `json:"source_branch"`
+	Author       struct {
+		Username string `json:"username"`
+		WebURL   string `json:"web_url"`
+	} `json:"author"`
+}
+
+// GetPullRequest returns the GitLab merge request for the provided IID.
+// Scoped labels like `kind::bug` are converted into `kind/bug`.
+func (f *GitLabForge) GetPullRequest(
+	ctx context.Context, owner, repo string, number int,
+) (*PullRequest, error) {
+	u := fmt.Sprintf("%s/api/v4/projects/%s/merge_requests/%d",
+		f.baseURL, url.PathEscape(owner+"/"+repo), number,
+	)
+
+	mr := &gitLabMergeRequest{}
+	if err := getForgeJSON(ctx, f.client, u, mr); err != nil {
+		return nil, fmt.Errorf("getting merge request !%d: %w", number, err)
+	}
+
+	labels := make([]string, 0, len(mr.Labels))
+	for _, label := range mr.Labels {
+		labels = append(labels, strings.Replace(label, "::", "/", 1))
+	}
+
+	return &PullRequest{
+		Number:       mr.IID,
+		Body:         mr.Description,
+		Labels:       labels,
+		Author:       mr.Author.Username,
+		AuthorURL:    mr.Author.WebURL,
+		URL:          mr.WebURL,
+		SourceBranch: mr.Author.Username + ":" + mr.SourceBranch,
+	}, nil
+}
+
+// GiteaForge is the forge for Gitea pull requests.
+type GiteaForge struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewGiteaForge creates a new Gitea forge for the instance base URL, for
+// example `https://gitea.com`.
+func NewGiteaForge(baseURL string, client *http.Client) *GiteaForge {
+	return &GiteaForge{baseURL: strings.TrimSuffix(baseURL, "/"), client: client}
+}
+
+type giteaPullRequest struct {
+	Number  int    `json:"number"`
+	Body    string `json:"body"`
+	HTMLURL string `json:"html_url"`
+	Labels  []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	User struct {
+		Login   string `json:"login"`
+		HTMLURL string `json:"html_url"`
+	} `json:"user"`
+	Head struct {
+		Label string `json:"label"`
+	} `json:"head"`
+}
+
+// GetPullRequest returns the Gitea pull request for the provided number.
+func (f *GiteaForge) GetPullRequest(
+	ctx context.Context, owner, repo string, number int,
+) (*PullRequest, error) {
+	u := fmt.Sprintf("%s/api/v1/repos/%s/%s/pulls/%d",
+		f.baseURL, url.PathEscape(owner), url.PathEscape(repo), number,
+	)
+
+	pr := &giteaPullRequest{}
+	if err := getForgeJSON(ctx, f.client, u, pr); err != nil {
+		return nil, fmt.Errorf("getting pull request #%d: %w", number, err)
+	}
+
+	labels := make([]string, 0, len(pr.Labels))
+	for _, label := range pr.Labels {
+		labels = append(labels, label.Name)
+	}
+
+	return &PullRequest{
+		Number:       pr.Number,
+		Body:         pr.Body,
+		Labels:       labels,
+		Author:       pr.User.Login,
+		AuthorURL:    pr.User.HTMLURL,
+		URL:          pr.HTMLURL,
+		SourceBranch: pr.Head.Label,
+	}, nil
+}
+
+// getForgeJSON requests the URL and decodes the JSON response into `v`.
+func getForgeJSON(ctx context.Context, client *http.Client, u string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("requesting %s: %w", u, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("requesting %s: HTTP status %s", u, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding response of %s: %w", u, err)
+	}
+
+	return nil
+}
+
+const forgeHTTPTimeout = 30 * time.Second
+
+// forgeHTTPClient returns the HTTP client for the GitLab and Gitea APIs,
+// which adds the authentication header and records or replays responses.
+func forgeHTTPClient(opts *options.Options, authHeader, authValue string) *http.Client {
+	var transport http.RoundTripper = &forgeAuthTransport{
+		header: authHeader,
+		value:  authValue,
+		next:   http.DefaultTransport,
+	}
+
+	switch {
+	case opts.ReplayDir != "":
+		transport = &forgeReplayTransport{dir: opts.ReplayDir}
+	case opts.RecordDir != "":
+		transport = &forgeRecordTransport{dir: opts.RecordDir, next: transport}
+	}
+
+	return &http.Client{Transport: transport, Timeout: forgeHTTPTimeout}
+}
+
+// forgeAuthTransport adds the authentication header to every request.
+type forgeAuthTransport struct {
+	header, value string
+	next          http.RoundTripper
+}
+
+func (t *forgeAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.value != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(t.header, t.value)
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// forgeRecord is a single recorded API response.
+type forgeRecord struct {
+	StatusCode int
+	Body       string
+}
+
+var forgeRecordNameRE = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// forgeRecordPath returns the file path of the recorded response for the
+// request, which is derived from its method and URL path.
+func forgeRecordPath(dir string, req *http.Request) string {
+	name := strings.Trim(forgeRecordNameRE.ReplaceAllString(req.URL.EscapedPath(), "-"), "-")
+
+	return filepath.Join(dir, fmt.Sprintf("%s-%s.json", req.Method, name))
+}
+
+// forgeRecordTransport stores all API responses in a directory.
+type forgeRecordTransport struct {
+	dir  string
+	next http.RoundTripper
+}
+
+func (t *forgeRecordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	path := forgeRecordPath(t.dir, req)
+	logrus.Debugf("Recording API call %s to %s", req.URL.Path, path)
+
+	content, err := json.MarshalIndent(
+		&forgeRecord{StatusCode: resp.StatusCode, Body: string(body)}, "", " ",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling record: %w", err)
+	}
+
+	if err := os.WriteFile(path, content, os.FileMode(0o644)); err != nil {
+		return nil, fmt.Errorf("writing record: %w", err)
+	}
+
+	resp.Body = io.NopCloser(strings.NewReader(string(body)))
+
+	return resp, nil
+}
+
+// forgeReplayTransport answers all requests from a directory of previously
+// recorded responses.
+type forgeReplayTransport struct {
+	dir string
+}
+
+func (t *forgeReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := forgeRecordPath(t.dir, req)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("no recorded response for %s %s", req.Method, req.URL.Path)
+		}
+
+		return nil, fmt.Errorf("reading record: %w", err)
+	}
+
+	record := &forgeRecord{}
+	if err := json.Unmarshal(content, record); err != nil {
+		return nil, fmt.Errorf("unmarshalling record %s: %w", path, err)
+	}
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", record.StatusCode, http.StatusText(record.StatusCode)),
+		StatusCode: record.StatusCode,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(record.Body)),
+		Request:    req,
+	}, nil
+}
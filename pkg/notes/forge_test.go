@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	gitobject "github.com/go-git/go-git/v5/plumbing/object"
+	gogithub "github.com/google/go-github/v88/github"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/release-sdk/github/githubfakes"
+
+	"k8s.io/release/pkg/notes/options"
+)
+
+func TestGitHubForge(t *testing.T) {
+	t.Parallel()
+
+	client := &githubfakes.FakeClient{}
+	client.GetPullRequestReturns(&gogithub.PullRequest{
+		Number:  new(42),
+		Body:    new("body"),
+		HTMLURL: new("https://github.com/kubernetes/kubernetes/pull/42"),
+		User: &gogithub.User{
+			Login:   new("k8s-infra-cherrypick-robot"),
+			HTMLURL: new("https://github.com/k8s-infra-cherrypick-robot"),
+		},
+		Labels: []*gogithub.Label{{Name: new("kind/bug")}, {Name: new("sig/node")}},
+		Head:   &gogithub.PullRequestBranch{Label: new("robot:cherry-pick-41-to-release-1.30")},
+	}, nil, nil)
+
+	pr, err := NewGitHubForge(client).GetPullRequest(t.Context(), "kubernetes", "kubernetes", 42)
+	require.NoError(t, err)
+	require.Equal(t, &PullRequest{
+		Number:       42,
+		Body:         "body",
+		Labels:       []string{"kind/bug", "sig/node"},
+		Author:       "k8s-infra-cherrypick-robot",
+		AuthorURL:    "https://github.com/k8s-infra-cherrypick-robot",
+		URL:          "https://github.com/kubernetes/kubernetes/pull/42",
+		SourceBranch: "robot:cherry-pick-41-to-release-1.30",
+	}, pr)
+
+	client.GetPullRequestReturns(nil, nil, errors.New("test"))
+	_, err = NewGitHubForge(client).GetPullRequest(t.Context(), "kubernetes", "kubernetes", 42)
+	require.Error(t, err)
+}
+
+func TestGitLabAndGiteaForge(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name           string
+		newForge       func(baseURL string, client *http.Client) Forge
+		owner, repo    string
+		number         int
+		expectedPath   string
+		expectedHeader string
+		response       string
+		expected       *PullRequest
+	}{
+		{
+			name: "GitLab",
+			newForge: func(baseURL string, client *http.Client) Forge {
+				return NewGitLabForge(baseURL, client)
+			},
+			owner:          "group/subgroup",
+			repo:           "project",
+			number:         3,
+			expectedPath:   "/api/v4/projects/group%2Fsubgroup%2Fproject/merge_requests/3",
+			expectedHeader: "PRIVATE-TOKEN",
+			response: `{"iid": 3, "description": "desc", "labels": ["kind::bug", "sig/node"],
+				"web_url": "https://gitlab/mr/3", "source_branch": "fix",
+				"author": {"username": "jdoe", "web_url": "https://gitlab/jdoe"}}`,
+			expected: &PullRequest{
+				Number:       3,
+				Body:         "desc",
+				Labels:       []string{"kind/bug", "sig/node"},
+				Author:       "jdoe",
+				AuthorURL:    "https://gitlab/jdoe",
+				URL:          "https://gitlab/mr/3",
+				SourceBranch: "jdoe:fix",
+			},
+		},
+		{
+			name: "Gitea",
+			newForge: func(baseURL string, client *http.Client) Forge {
+				return NewGiteaForge(baseURL, client)
+			},
+			owner:          "org",
+			repo:           "repo",
+			number:         5,
+			expectedPath:   "/api/v1/repos/org/repo/pulls/5",
+			expectedHeader: "Authorization",
+			response: `{"number": 5, "body": "desc", "labels": [{"name": "kind/bug"}],
+				"html_url": "https://gitea/pulls/5", "head": {"label": "fix"},
+				"user": {"login": "jdoe", "html_url": "https://gitea/jdoe"}}`,
+			expected: &PullRequest{
+				Number:       5,
+				Body:         "desc",
+				Labels:       []string{"kind/bug"},
+				Author:       "jdoe",
+				AuthorURL:    "https://gitea/jdoe",
+				URL:          "https://gitea/pulls/5",
+				SourceBranch: "fix",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.EscapedPath() != tc.expectedPath {
+					w.WriteHeader(http.StatusNotFound)
+
+					return
+				}
+
+				if r.Header.Get(tc.expectedHeader) == "" {
+					w.WriteHeader(http.StatusUnauthorized)
+
+					return
+				}
+
+				_, err := w.Write([]byte(tc.response))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(server.Close)
+
+			opts := &options.Options{RecordDir: t.TempDir()}
+			forge := tc.newForge(server.URL, forgeHTTPClient(opts, tc.expectedHeader, "token"))
+
+			// Real API access with recording
+			pr, err := forge.GetPullRequest(t.Context(), tc.owner, tc.repo, tc.number)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, pr)
+
+			_, err = forge.GetPullRequest(t.Context(), tc.owner, tc.repo, tc.number+1)
+			require.Error(t, err)
+
+			// Replay without API access
+			server.Close()
+
+			forge = tc.newForge(server.URL, forgeHTTPClient(
+				&options.Options{ReplayDir: opts.RecordDir}, tc.expectedHeader, "",
+			))
+
+			pr, err = forge.GetPullRequest(t.Context(), tc.owner, tc.repo, tc.number)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, pr)
+
+			_, err = forge.GetPullRequest(t.Context(), tc.owner, tc.repo, tc.number+1)
+			require.ErrorContains(t, err, "404")
+
+			_, err = forge.GetPullRequest(t.Context(), tc.owner, tc.repo, tc.number+2)
+			require.ErrorContains(t, err, "no recorded response")
+		})
+	}
+}
+
+func TestReleaseNoteForPullRequestReplay(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		forge, owner, repo string
+		number             int
+		expected           *ReleaseNote
+	}{
+		{
+			forge: options.ForgeGitLab, owner: "group", repo: "project", number: 12,
+			expected: &ReleaseNote{
+				Text:          "Added the `--foo` flag to the scheduler.",
+				Markdown:      "Added the `--foo` flag to the scheduler.",
+				Documentation: []*Documentation{},
+				Author:        "jdoe",
+				AuthorURL:     "https://gitlab.example.com/jdoe",
+				PrURL:         "https://gitlab.example.com/group/project/-/merge_requests/12",
+				PrNumber:      12,
+				SIGs:          []string{"scheduling"},
+				Kinds:         []string{"feature"},
+				DataFields:    map[string]ReleaseNotesDataField{},
+				PRBody:        "Improve the scheduler.\n\n```release-note\nAdded the `--foo` flag to the scheduler.\n```\n",
+			},
+		},
+		{
+			forge: options.ForgeGitea, owner: "org", repo: "repo", number: 7,
+			expected: &ReleaseNote{
+				Text:          "Fixed a crash on startup.",
+				Markdown:      "Fixed a crash on startup.",
+				Documentation: []*Documentation{},
+				Author:        "jdoe",
+				AuthorURL:     "https://gitea.example.com/jdoe",
+				PrURL:         "https://gitea.example.com/org/repo/pulls/7",
+				PrNumber:      7,
+				SIGs:          []string{"node"},
+				Kinds:         []string{"bug"},
+				DataFields:    map[string]ReleaseNotesDataField{},
+				PRBody:        "```release-note\nFixed a crash on startup.\n```\n",
+			},
+		},
+	} {
+		t.Run(tc.forge, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := NewGatherer(t.Context(), &options.Options{
+				Forge:      tc.forge,
+				ForgeURL:   "https://" + tc.forge + ".example.com",
+				GithubOrg:  tc.owner,
+				GithubRepo: tc.repo,
+				ReplayDir:  filepath.Join("testdata", "forge", tc.forge),
+			})
+			require.NoError(t, err)
+
+			note, err := g.ReleaseNoteForPullRequest(tc.number)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, note)
+		})
+	}
+}
+
+// mapForge is a forge which returns the pull requests from a map.
+type mapForge map[int]*PullRequest
+
+func (f mapForge) GetPullRequest(_ context.Context, _, _ string, number int) (*PullRequest, error) {
+	if pr, ok := f[number]; ok {
+		return pr, nil
+	}
+
+	return nil, errors.New("not found")
+}
+
+func TestBuildReleaseNoteCherryPickWithForge(t *testing.T) {
+	t.Parallel()
+
+	forge := mapForge{
+		1: {
+			Number:    1,
+			Author:    "jdoe",
+			AuthorURL: "https://gitlab.example.com/jdoe",
+		},
+		2: {
+			Number:       2,
+			Body:         "```release-note\nFixed a bug\n```\n",
+			Author:       k8sCherryPickBotUsername,
+			SourceBranch: k8sCherryPickBotUsername + ":cherry-pick-1-to-release-1.30",
+			Labels:       []string{"kind/bug"},
+		},
+	}
+
+	g := NewGathererWithForge(context.Background(), forge, options.New())
+	note, err := g.buildReleaseNote(&commitPrPair{Commit: &gitobject.Commit{}, PrNum: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, note.PrNumber)
+	require.Equal(t, "jdoe", note.Author)
+	require.Equal(t, "https://gitlab.example.com/jdoe", note.AuthorURL)
+	require.Equal(t, []string{"bug"}, note.Kinds)
+}
+
+func TestForgeRecordPath(t *testing.T) {
+	t.Parallel()
+
+	req, err := http.NewRequestWithContext(
+		t.Context(), http.MethodGet,
+		"https://gitlab.com/api/v4/projects/group%2Fproject/merge_requests/1", http.NoBody,
+	)
+	require.NoError(t, err)
+	require.Equal(t,
+		filepath.Join("dir", "GET-api-v4-projects-group-2Fproject-merge_requests-1.json"),
+		forgeRecordPath("dir", req),
+	)
+}
+
+func TestNewForge(t *testing.T) {
+	t.Parallel()
+
+	forge, err := NewForge(&options.Options{Forge: options.ForgeGitLab, ForgeURL: "https://gitlab.com"})
+	require.NoError(t, err)
+	require.IsType(t, &GitLabForge{}, forge)
+
+	forge, err = NewForge(&options.Options{Forge: options.ForgeGitea, ReplayDir: t.TempDir()})
+	require.NoError(t, err)
+	require.IsType(t, &GiteaForge{}, forge)
+
+	forge, err = NewForge(&options.Options{ReplayDir: t.TempDir()})
+	require.NoError(t, err)
+	require.IsType(t, &GitHubForge{}, forge)
+
+	_, err = NewForge(&options.Options{Forge: "bitbucket"})
+	require.Error(t, err)
+}
@@ -235,7 +235,7 @@ type releaseNotesAggregator struct {
 }
 
 type Gatherer struct {
-	client       github.Client
+	forge        Forge
 	context      context.Context //nolint:containedctx // contained context is intentional
 	options      *options.Options
 	MapProviders []*MapProvider
@@ -243,13 +243,13 @@ type Gatherer struct {
 
 // NewGatherer creates a new notes gatherer.
 func NewGatherer(ctx context.Context, opts *options.Options) (*Gatherer, error) {
-	client, err := opts.Client()
+	forge, err := NewForge(opts)
 	if err != nil {
-		return nil, fmt.Errorf("unable to create notes client: %w", err)
+		return nil, fmt.Errorf("unable to create notes forge: %w", err)
 	}
 
 	return &Gatherer{
-		client:  client,
+		forge:   forge,
 		context: ctx,
 		options: opts,
 	}, nil
@@ -257,10 +257,15 @@ func NewGatherer(ctx context.Context, opts *options.Options) (*Gatherer, error)
 
 // NewGathererWithClient creates a new notes gatherer with a specific client.
 func NewGathererWithClient(ctx context.Context, c github.Client) *Gatherer {
+	return NewGathererWithForge(ctx, NewGitHubForge(c), options.New())
+}
+
+// NewGathererWithForge creates a new notes gatherer with a specific forge.
+func NewGathererWithForge(ctx context.Context, forge Forge, opts *options.Options) *Gatherer {
 	return &Gatherer{
-		client:  c,
+		forge:   forge,
 		context: ctx,
-		options: options.New(),
+		options: opts,
 	}
 }
 
@@ -518,12 +523,12 @@ func classifyURL(u *url.URL) DocType {
 // ReleaseNoteForPullRequest returns a release note from a pull request number.
 // If the release note is blank or.
 func (g *Gatherer) ReleaseNoteForPullRequest(prNr int) (*ReleaseNote, error) {
-	pr, _, err := g.client.GetPullRequest(g.context, g.options.GithubOrg, g.options.GithubRepo, prNr)
+	pr, err := g.forge.GetPullRequest(g.context, g.options.GithubOrg, g.options.GithubRepo, prNr)
 	if err != nil {
-		return nil, fmt.Errorf("reading PR from GitHub: %w", err)
+		return nil, fmt.Errorf("reading PR #%d: %w", prNr, err)
 	}
 
-	prBody := pr.GetBody()
+	prBody := pr.Body
 
 	// This will be true when the release note is NONE or the flag is set
 	var doNotPublish bool
@@ -539,12 +544,12 @@ func (g *Gatherer) ReleaseNoteForPullRequest(prNr int) (*ReleaseNote, error) {
 	// If we can't extract the release note, consider that the PR is invalid and take the next one
 	s, err := noteTextFromString(prBody)
 	if err != nil && !doNotPublish {
-		return nil, fmt.Errorf("PR #%d does not seem to contain a valid release note: %w", pr.GetNumber(), err)
+		return nil, fmt.Errorf("PR #%d does not seem to contain a valid release note: %w", pr.Number, err)
 	}
 
 	// If we found a valid release note, return the PR, otherwise, take the next one
 	if s == "" && !doNotPublish {
-		return nil, fmt.Errorf("PR #%d does not seem to contain a valid release note", pr.GetNumber())
+		return nil, fmt.Errorf("PR #%d does not seem to contain a valid release note", pr.Number)
 	}
 
 	if doNotPublish {
@@ -556,9 +561,9 @@ func (g *Gatherer) ReleaseNoteForPullRequest(prNr int) (*ReleaseNote, error) {
 		Text:           s,
 		Markdown:       s,
 		Documentation:  []*Documentation{},
-		Author:         *pr.GetUser().Login,
-		AuthorURL:      fmt.Sprintf("%s%s", g.options.GithubBaseURL, *pr.GetUser().Login),
-		PrURL:          fmt.Sprintf("%s%s/%s/pull/%d", g.options.GithubBaseURL, g.options.GithubOrg, g.options.GithubRepo, prNr),
+		Author:         pr.Author,
+		AuthorURL:      pr.AuthorURL,
+		PrURL:          pr.URL,
 		PrNumber:       prNr,
 		SIGs:           labelsWithPrefix(pr, "sig"),
 		Kinds:          labelsWithPrefix(pr, "kind"),
@@ -573,19 +578,19 @@ func (g *Gatherer) ReleaseNoteForPullRequest(prNr int) (*ReleaseNote, error) {
 	}
 
 	if s != "" {
-		logrus.Infof("PR #%d seems to contain a release note", pr.GetNumber())
+		logrus.Infof("PR #%d seems to contain a release note", pr.Number)
 	}
 
 	return note, nil
 }
 
 func (g *Gatherer) buildReleaseNote(pair *commitPrPair) (*ReleaseNote, error) {
-	pr, _, err := g.client.GetPullRequest(g.context, g.options.GithubOrg, g.options.GithubRepo, pair.PrNum)
+	pr, err := g.forge.GetPullRequest(g.context, g.options.GithubOrg, g.options.GithubRepo, pair.PrNum)
 	if err != nil {
 		return nil, err
 	}
 
-	prBody := pr.GetBody()
+	prBody := pr.Body
 
 	if MatchesExcludeFilter(prBody) {
 		return nil, nil //nolint:nilnil // intentional nil,nil return
@@ -606,26 +611,27 @@ func (g *Gatherer) buildReleaseNote(pair *commitPrPair) (*ReleaseNote, error) {
 	}
 
 	if isAutomatedCherryPickPR(pr) {
-		logrus.Infof("PR #%d seems to be an automated cherry-pick, retrieving origin info", pr.GetNumber())
+		logrus.Infof("PR #%d seems to be an automated cherry-pick, retrieving origin info", pr.Number)
 
 		originPRNum, err := originPrNumFromPr(pr)
 		if err != nil {
 			return nil, err
 		}
 
-		originPR, err := g.getPr(originPRNum)
+		originPR, err := g.forge.GetPullRequest(g.context, g.options.GithubOrg, g.options.GithubRepo, originPRNum)
 		if err != nil {
 			return nil, err
 		}
 
-		pr.User = originPR.GetUser()
+		pr.Author = originPR.Author
+		pr.AuthorURL = originPR.AuthorURL
 	}
 
 	documentation := DocumentationFromString(prBody)
 
-	author := pr.GetUser().GetLogin()
-	authorURL := pr.GetUser().GetHTMLURL()
-	prURL := pr.GetHTMLURL()
+	author := pr.Author
+	authorURL := pr.AuthorURL
+	prURL := pr.URL
 	isFeature := slices.Contains(labelsWithPrefix(pr, "kind"), "feature")
 	sigLabels := labelsWithPrefix(pr, "sig")
 	noteSuffix := prettifySIGList(sigLabels)
@@ -636,11 +642,11 @@ func (g *Gatherer) buildReleaseNote(pair *commitPrPair) (*ReleaseNote, error) {
 
 	indented := strings.ReplaceAll(text, "\n", "\n  ")
 	markdown := fmt.Sprintf("%s (#%d, @%s)",
-		indented, pr.GetNumber(), author)
+		indented, pr.Number, author)
 
 	if g.options.AddMarkdownLinks {
 		markdown = fmt.Sprintf("%s ([#%d](%s), [@%s](%s))",
-			indented, pr.GetNumber(), prURL, author, authorURL)
+			indented, pr.Number, prURL, author, authorURL)
 	}
 
 	if noteSuffix != "" {
@@ -658,7 +664,7 @@ func (g *Gatherer) buildReleaseNote(pair *commitPrPair) (*ReleaseNote, error) {
 		Author:         author,
 		AuthorURL:      authorURL,
 		PrURL:          prURL,
-		PrNumber:       pr.GetNumber(),
+		PrNumber:       pr.Number,
 		SIGs:           sigLabels,
 		Kinds:          labelsWithPrefix(pr, "kind"),
 		Areas:          labelsWithPrefix(pr, "area"),
@@ -807,12 +813,10 @@ func MatchesExcludeFilter(msg string) bool {
 }
 
 // matchesLabelFilter returns true if any of PR labels match the includeLabels.
-func matchesLabelFilter(prLabels []*gogithub.Label, includeLabels []string) bool {
+func matchesLabelFilter(prLabels, includeLabels []string) bool {
 	for _, include := range includeLabels {
-		for _, label := range prLabels {
-			if label.GetName() == include {
-				return true
-			}
+		if slices.Contains(prLabels, include) {
+			return true
 		}
 	}
 
@@ -829,20 +833,20 @@ func matchesFilter(msg string, filters []*regexp.Regexp) bool {
 	return false
 }
 
-func isAutomatedCherryPickPR(pr *gogithub.PullRequest) bool {
-	if pr == nil || pr.GetUser() == nil {
+func isAutomatedCherryPickPR(pr *PullRequest) bool {
+	if pr == nil {
 		return false
 	}
 
-	return pr.GetUser().GetLogin() == k8sCherryPickBotUsername
+	return pr.Author == k8sCherryPickBotUsername
 }
 
-func originPrNumFromPr(pr *gogithub.PullRequest) (int, error) {
-	if pr == nil || pr.GetHead() == nil {
+func originPrNumFromPr(pr *PullRequest) (int, error) {
+	if pr == nil || pr.SourceBranch == "" {
 		return 0, errNoOriginPRIDFoundInPR
 	}
 
-	originPR := prForRegex(regexK8sCherryPickBotBranch, pr.GetHead().GetLabel())
+	originPR := prForRegex(regexK8sCherryPickBotBranch, pr.SourceBranch)
 	if originPR == 0 {
 		return 0, errNoOriginPRIDFoundInPR
 	}
@@ -854,12 +858,12 @@ func originPrNumFromPr(pr *gogithub.PullRequest) (int, error) {
 // a given string. This pattern is used often in the k/k repo and we can take
 // advantage of this to contextualize release note generation with the kind, sig,
 // area, etc labels.
-func labelsWithPrefix(pr *gogithub.PullRequest, prefix string) []string {
+func labelsWithPrefix(pr *PullRequest, prefix string) []string {
 	var labels []string
 
 	for _, label := range pr.Labels {
-		if strings.HasPrefix(*label.Name, prefix) {
-			labels = append(labels, strings.TrimPrefix(*label.Name, prefix+"/"))
+		if strings.HasPrefix(label, prefix) {
+			labels = append(labels, strings.TrimPrefix(label, prefix+"/"))
 		}
 	}
 
@@ -867,14 +871,8 @@ func labelsWithPrefix(pr *gogithub.PullRequest, prefix string) []string {
 }
 
 // labelExactMatch indicates whether or not a matching label was found on PR.
-func labelExactMatch(pr *gogithub.PullRequest, labelToFind string) bool {
-	for _, label := range pr.Labels {
-		if *label.Name == labelToFind {
-			return true
-		}
-	}
-
-	return false
+func labelExactMatch(pr *PullRequest, labelToFind string) bool {
+	return slices.Contains(pr.Labels, labelToFind)
 }
 
 func stripActionRequired(note string) string {
@@ -978,19 +976,6 @@ func canWaitAndRetry(r *gogithub.Response, err error) bool {
 	return false
 }
 
-func (g *Gatherer) getPr(prNum int) (*gogithub.PullRequest, error) {
-	for {
-		res, resp, err := g.client.GetPullRequest(g.context, g.options.GithubOrg, g.options.GithubRepo, prNum)
-		if err != nil {
-			if !canWaitAndRetry(resp, err) {
-				return nil, err
-			}
-		} else {
-			return res, nil
-		}
-	}
-}
-
 func prsNumForCommitFromMessage(commitMessage string) (prs []int, err error) {
 	// Thankfully k8s-merge-robot commits the PR number consistently. If this ever
 	// stops being true, this definitely won't work anymore.
@@ -1015,6 +1000,14 @@ func prsNumForCommitFromMessage(commitMessage string) (prs []int, err error) {
 		prs = append(prs, pr)
 	}
 
+	// GitLab merge commits reference the merge request by its IID
+	regex = regexp.MustCompile(`See merge request \S+!(?P<number>\d+)`)
+
+	pr = prForRegex(regex, commitMessage)
+	if pr != 0 {
+		prs = append(prs, pr)
+	}
+
 	// If the PR was squash merged, the regexp is different
 	regex = regexp.MustCompile(`\(#(?P<number>\d+)\)`)
 
@@ -1052,9 +1045,9 @@ func prForRegex(regex *regexp.Regexp, commitMessage string) int {
 	return pr
 }
 
-// prettySIG takes a sig name as parsed by the `sig-foo` label and returns a
+// PrettySIG takes a sig name as parsed by the `sig-foo` label and returns a
 // "pretty" version of it that can be printed in documents.
-func prettySIG(sig string) string {
+func PrettySIG(sig string) string {
 	parts := strings.Split(sig, "-")
 	for i, part := range parts {
 		switch part {
@@ -1084,13 +1077,13 @@ func prettifySIGList(sigs []string) string {
 	for i, sig := range sigs {
 		switch i {
 		case 0:
-			sigList = "SIG " + prettySIG(sig)
+			sigList = "SIG " + PrettySIG(sig)
 
 		case len(sigs) - 1:
-			sigList = fmt.Sprintf("%s and %s", sigList, prettySIG(sig))
+			sigList = fmt.Sprintf("%s and %s", sigList, PrettySIG(sig))
 
 		default:
-			sigList = fmt.Sprintf("%s, %s", sigList, prettySIG(sig))
+			sigList = fmt.Sprintf("%s, %s", sigList, PrettySIG(sig))
 		}
 	}
 
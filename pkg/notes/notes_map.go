@@ -22,11 +22,14 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	"go.yaml.in/yaml/v4"
 
 	"sigs.k8s.io/release-sdk/object"
+
+	"k8s.io/release/pkg/objectstore"
 )
 
 // MapProvider interface that obtains release notes maps from a source.
@@ -34,12 +37,25 @@ type MapProvider interface {
 	GetMapsForPR(int) ([]*ReleaseNotesMap, error)
 }
 
-// NewProviderFromInitString creates a new map provider from an initialization string.
+// NewProviderFromInitString creates a new map provider from an initialization
+// string. Bucket URLs (`gs://`, `s3://`, `file://`), web URLs (`https://`) and
+// GitHub repositories (`github://<org>/<repo>/<path>[@<ref>]`) are read lazily
+// per PR from files named like `MapFileName`. All other strings are used as
+// local directory.
 func NewProviderFromInitString(initString string) (MapProvider, error) { //nolint:ireturn // returning interface is intentional
-	// If init string starts with gs:// return a CloudStorageProvider
-	if len(initString) >= 5 && initString[0:5] == object.GcsPrefix {
-		// Currently for illustration purposes
-		return nil, errors.New("CloudStorageProvider is not yet implemented")
+	switch {
+	// Buckets are read lazily through the object store
+	case strings.HasPrefix(initString, object.GcsPrefix),
+		strings.HasPrefix(initString, objectstore.S3Prefix),
+		strings.HasPrefix(initString, objectstore.LocalPrefix):
+		return NewCloudStorageMapProvider(initString), nil
+
+	case strings.HasPrefix(initString, "https://"),
+		strings.HasPrefix(initString, "http://"):
+		return NewHTTPMapProvider(initString), nil
+
+	case strings.HasPrefix(initString, GitHubMapPrefix):
+		return NewGitHubMapProvider(initString)
 	}
 
 	// Otherwise, build a DirectoryMapProvider using the
@@ -58,8 +74,6 @@ func NewProviderFromInitString(initString string) (MapProvider, error) { //nolin
 
 // ParseReleaseNotesMap Parses a Release Notes Map.
 func ParseReleaseNotesMap(mapPath string) (*[]ReleaseNotesMap, error) {
-	notemaps := []ReleaseNotesMap{}
-
 	yamlReader, err := os.Open(mapPath)
 	if err != nil {
 		return nil, fmt.Errorf("opening maps: %w", err)
@@ -67,7 +81,18 @@ func ParseReleaseNotesMap(mapPath string) (*[]ReleaseNotesMap, error) {
 
 	defer yamlReader.Close()
 
-	decoder := yaml.NewDecoder(yamlReader)
+	notemaps, err := parseReleaseNotesMap(yamlReader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notemaps, nil
+}
+
+// parseReleaseNotesMap decodes all release notes maps from the reader.
+func parseReleaseNotesMap(reader io.Reader) ([]ReleaseNotesMap, error) {
+	notemaps := []ReleaseNotesMap{}
+	decoder := yaml.NewDecoder(reader)
 
 	for {
 		noteMap := ReleaseNotesMap{}
@@ -80,7 +105,7 @@ func ParseReleaseNotesMap(mapPath string) (*[]ReleaseNotesMap, error) {
 		notemaps = append(notemaps, noteMap)
 	}
 
-	return &notemaps, nil
+	return notemaps, nil
 }
 
 // ReleaseNotesMap holds the changes that will be applied to the notes
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	khttp "sigs.k8s.io/release-utils/http"
+
+	"k8s.io/release/pkg/objectstore"
+)
+
+const (
+	// GitHubMapPrefix is the init string prefix of release notes maps
+	// stored in a GitHub repository, for example
+	// `github://kubernetes/sig-release/releases/release-1.30/release-notes/maps@master`.
+	GitHubMapPrefix = "github://"
+
+	// gitHubRawURL is the base URL for downloading raw files from GitHub.
+	gitHubRawURL = "https://raw.githubusercontent.com"
+
+	// gitHubDefaultRef is the git reference used if the init string does
+	// not contain one.
+	gitHubDefaultRef = "master"
+)
+
+// MapFileName returns the file name of the release notes map for a PR, as
+// written by `krel release-notes`.
+func MapFileName(pr int) string {
+	return fmt.Sprintf("pr-%d-map.yaml", pr)
+}
+
+// mapCache lazily fetches the release notes maps per PR and keeps them for
+// subsequent lookups.
+type mapCache struct {
+	mu   sync.Mutex
+	maps map[int][]*ReleaseNotesMap
+}
+
+// getMapsForPR returns the cached maps for the PR or fetches them by using
+// the provided function, which returns nil content if no map exists.
+func (c *mapCache) getMapsForPR(
+	pr int, fetch func(pr int) ([]byte, error),
+) ([]*ReleaseNotesMap, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if notesMap, ok := c.maps[pr]; ok {
+		return notesMap, nil
+	}
+
+	if c.maps == nil {
+		c.maps = map[int][]*ReleaseNotesMap{}
+	}
+
+	content, err := fetch(pr)
+	if err != nil {
+		return nil, err
+	}
+
+	var notesMap []*ReleaseNotesMap
+
+	if content != nil {
+		notemaps, err := parseReleaseNotesMap(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("parsing note map for PR #%d: %w", pr, err)
+		}
+
+		for i := range notemaps {
+			if notemaps[i].PR == pr {
+				notesMap = append(notesMap, &notemaps[i])
+			}
+		}
+	}
+
+	c.maps[pr] = notesMap
+
+	return notesMap, nil
+}
+
+// CloudStorageMapProvider is a provider that lazily gets maps from a bucket.
+// The bucket can be any location supported by the object store, like
+// `gs://`, `s3://` or `file://`.
+type CloudStorageMapProvider struct {
+	Path string
+
+	store objectstore.Store
+	cache mapCache
+}
+
+// NewCloudStorageMapProvider creates a new map provider for the bucket path.
+func NewCloudStorageMapProvider(path string) *CloudStorageMapProvider {
+	return &CloudStorageMapProvider{
+		Path:  path,
+		store: objectstore.New(path),
+	}
+}
+
+// SetStore can be used to set the internal object store.
+func (mp *CloudStorageMapProvider) SetStore(store objectstore.Store) {
+	mp.store = store
+}
+
+// GetMapsForPR get the release notes maps for a specific PR number.
+func (mp *CloudStorageMapProvider) GetMapsForPR(pr int) ([]*ReleaseNotesMap, error) {
+	return mp.cache.getMapsForPR(pr, mp.fetch)
+}
+
+func (mp *CloudStorageMapProvider) fetch(pr int) ([]byte, error) {
+	path, err := mp.store.NormalizePath(mp.Path, MapFileName(pr))
+	if err != nil {
+		return nil, fmt.Errorf("normalize map path: %w", err)
+	}
+
+	exists, err := mp.store.PathExists(path)
+	if err != nil {
+		return nil, fmt.Errorf("checking if %s exists: %w", path, err)
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	logrus.Debugf("Reading release notes map %s", path)
+
+	content, err := mp.store.ReadObject(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading release notes map: %w", err)
+	}
+
+	return content, nil
+}
+
+// HTTPMapProvider is a provider that lazily gets maps from a web server.
+type HTTPMapProvider struct {
+	BaseURL string
+
+	agent *khttp.Agent
+	cache mapCache
+}
+
+// NewHTTPMapProvider creates a new map provider for the base URL.
+func NewHTTPMapProvider(baseURL string) *HTTPMapProvider {
+	return &HTTPMapProvider{
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		agent:   khttp.NewAgent().WithFailOnHTTPError(false),
+	}
+}
+
+// NewGitHubMapProvider creates a new map provider for an init string in
+// the format `github://<org>/<repo>/<path>[@<ref>]`.
+func NewGitHubMapProvider(initString string) (*HTTPMapProvider, error) {
+	location, ref, found := strings.Cut(
+		strings.TrimPrefix(initString, GitHubMapPrefix), "@",
+	)
+	if !found || ref == "" {
+		ref = gitHubDefaultRef
+	}
+
+	parts := strings.SplitN(strings.Trim(location, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf(
+			"invalid GitHub map location %q, expected %s<org>/<repo>/<path>[@<ref>]",
+			initString, GitHubMapPrefix,
+		)
+	}
+
+	baseURL := strings.Join([]string{gitHubRawURL, parts[0], parts[1], ref}, "/")
+	if len(parts) == 3 {
+		baseURL += "/" + parts[2]
+	}
+
+	return NewHTTPMapProvider(baseURL), nil
+}
+
+// GetMapsForPR get the release notes maps for a specific PR number.
+func (mp *HTTPMapProvider) GetMapsForPR(pr int) ([]*ReleaseNotesMap, error) {
+	return mp.cache.getMapsForPR(pr, mp.fetch)
+}
+
+func (mp *HTTPMapProvider) fetch(pr int) ([]byte, error) {
+	u := mp.BaseURL + "/" + MapFileName(pr)
+	logrus.Debugf("Downloading release notes map %s", u)
+
+	resp, err := mp.agent.GetRequest(u)
+	if err != nil {
+		return nil, fmt.Errorf("downloading %s: %w", u, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("downloading %s: HTTP status %s", u, resp.Status)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", u, err)
+	}
+
+	return content, nil
+}
@@ -17,6 +17,11 @@ limitations under the License.
 package notes
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -29,8 +34,11 @@ func TestNewProviderFromInitString(t *testing.T) {
 	}{
 		{initString: "maps/testdata/applymap-unit-test/", returnsError: false},
 		{initString: "/this/shoud/not/really.exist/as/a/d33rect0ree", returnsError: true},
-		{initString: "gs://bucket-name/map/path/", returnsError: true},
-		{initString: "github://kubernetes/sig-release/maps", returnsError: true},
+		{initString: "gs://bucket-name/map/path/", returnsError: false},
+		{initString: "s3://bucket-name/map/path/", returnsError: false},
+		{initString: "https://example.com/maps", returnsError: false},
+		{initString: "github://kubernetes/sig-release/maps", returnsError: false},
+		{initString: "github://kubernetes", returnsError: true},
 	}
 	for _, testCase := range testCases {
 		provider, err := NewProviderFromInitString(testCase.initString)
@@ -66,6 +74,111 @@ func TestGetMapsForPR(t *testing.T) {
 	require.GreaterOrEqual(t, 4, len(maps))
 }
 
+func TestCloudStorageMapProvider(t *testing.T) {
+	mapsDir := t.TempDir()
+	content, err := os.ReadFile("maps/testdata/fullmap.yaml")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(
+		filepath.Join(mapsDir, MapFileName(123)), content, 0o600,
+	))
+
+	provider, err := NewProviderFromInitString("file://" + mapsDir)
+	require.NoError(t, err)
+	require.IsType(t, &CloudStorageMapProvider{}, provider)
+
+	maps, err := provider.GetMapsForPR(123)
+	require.NoError(t, err)
+	require.Len(t, maps, 4)
+	require.Equal(t, 123, maps[0].PR)
+
+	maps, err = provider.GetMapsForPR(95000)
+	require.NoError(t, err)
+	require.Empty(t, maps)
+
+	// Maps are cached after the first lookup
+	require.NoError(t, os.Remove(filepath.Join(mapsDir, MapFileName(123))))
+	maps, err = provider.GetMapsForPR(123)
+	require.NoError(t, err)
+	require.Len(t, maps, 4)
+}
+
+func TestHTTPMapProvider(t *testing.T) {
+	content, err := os.ReadFile("maps/testdata/fullmap.yaml")
+	require.NoError(t, err)
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+
+			switch r.URL.Path {
+			case "/maps/" + MapFileName(123):
+				_, err := w.Write(content)
+				require.NoError(t, err)
+			case "/maps/" + MapFileName(500):
+				w.WriteHeader(http.StatusForbidden)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		},
+	))
+	defer server.Close()
+
+	provider, err := NewProviderFromInitString(server.URL + "/maps/")
+	require.NoError(t, err)
+
+	for range 2 {
+		maps, err := provider.GetMapsForPR(123)
+		require.NoError(t, err)
+		require.Len(t, maps, 4)
+	}
+
+	maps, err := provider.GetMapsForPR(95000)
+	require.NoError(t, err)
+	require.Empty(t, maps)
+
+	_, err = provider.GetMapsForPR(500)
+	require.Error(t, err)
+
+	require.Equal(t, int32(3), requests.Load())
+}
+
+func TestNewGitHubMapProvider(t *testing.T) {
+	for _, tc := range []struct {
+		initString  string
+		expected    string
+		shouldError bool
+	}{
+		{
+			initString: "github://kubernetes/sig-release/releases/release-1.30/release-notes/maps",
+			expected:   "https://raw.githubusercontent.com/kubernetes/sig-release/master/releases/release-1.30/release-notes/maps",
+		},
+		{
+			initString: "github://kubernetes/sig-release/maps/@main",
+			expected:   "https://raw.githubusercontent.com/kubernetes/sig-release/main/maps",
+		},
+		{
+			initString: "github://kubernetes/sig-release",
+			expected:   "https://raw.githubusercontent.com/kubernetes/sig-release/master",
+		},
+		{
+			initString:  "github://kubernetes/",
+			shouldError: true,
+		},
+	} {
+		provider, err := NewGitHubMapProvider(tc.initString)
+		if tc.shouldError {
+			require.Error(t, err)
+
+			continue
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, provider.BaseURL)
+	}
+}
+
 func TestReleaseNotesMapIntegrity(t *testing.T) {
 	maps, err := ParseReleaseNotesMap("maps/testdata/fullmap.yaml")
 	require.NoError(t, err)
@@ -203,6 +203,20 @@ func TestGetPRNumberFromCommitMessage(t *testing.T) {
 This reverts commit abcdef1234567890abcdef1234567890abcdef12.`,
 			expectedPRNumber: 1234,
 		},
+		{
+			name: "Get PR number from GitLab merge request",
+			commitMessage: `Merge branch 'fix-flake' into 'main'
+
+Fix flaky test
+
+See merge request group/subgroup/project!87`,
+			expectedPRNumber: 87,
+		},
+		{
+			name:             "Get PR number from Gitea merged PR",
+			commitMessage:    "Merge pull request 'Fix flaky test' (#42) from user/fix-flake into main",
+			expectedPRNumber: 42,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -231,7 +245,7 @@ func TestPrettySIG(t *testing.T) {
 	}
 
 	for input, expected := range cases {
-		require.Equal(t, expected, (prettySIG(input)))
+		require.Equal(t, expected, (PrettySIG(input)))
 	}
 }
 
@@ -633,7 +647,7 @@ func newTestGatherer(t *testing.T, client *githubfakes.FakeClient, opts *options
 	}
 
 	return &Gatherer{
-		client:  client,
+		forge:   NewGitHubForge(client),
 		context: context.Background(),
 		options: opts,
 	}
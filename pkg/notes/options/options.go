@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -38,6 +39,14 @@ type Options struct {
 	// GithubUploadURL specifies the Github upload URL.
 	GithubUploadURL string
 
+	// Forge specifies the code hosting platform to gather the pull or merge
+	// requests from. Can be `github` (default), `gitlab` or `gitea`.
+	Forge string
+
+	// ForgeURL specifies the base URL of the GitLab or Gitea instance, for
+	// example `https://gitlab.com`. Not used for GitHub.
+	ForgeURL string
+
 	// GithubOrg specifies the GitHub organization from which will be
 	// cloned/pulled if Pull is true.
 	GithubOrg string
@@ -80,8 +89,8 @@ type Options struct {
 	// because the skipped-to commit may not be on the first-parent chain.
 	OriginalStartSHA string
 
-	// Format specifies the format of the release notes. Can be either
-	// `json` or `markdown`.
+	// Format specifies the format of the release notes. Can be `json`,
+	// `markdown`, `html`, `asciidoc` or `rst`.
 	Format string
 
 	// If the `Format` is `markdown`, then this specifies the selected go
@@ -122,6 +131,7 @@ type Options struct {
 	ReplayDir string
 
 	githubToken string
+	forgeToken  string
 	gitCloneFn  func(string, string, string, bool) (*git.Repo, error)
 
 	// MapProviders list of release notes map providers to query during generations
@@ -146,9 +156,32 @@ const (
 	RevisionDiscoveryModeMinorToMinor      = "minor-to-minor"
 )
 
+const (
+	ForgeGitHub = "github"
+	ForgeGitLab = "gitlab"
+	ForgeGitea  = "gitea"
+
+	// GitLabTokenEnvKey is the environment variable containing the token
+	// for accessing the GitLab API.
+	GitLabTokenEnvKey = "GITLAB_TOKEN"
+
+	// GiteaTokenEnvKey is the environment variable containing the token for
+	// accessing the Gitea API.
+	GiteaTokenEnvKey = "GITEA_TOKEN"
+
+	// DefaultGitLabURL is the default GitLab instance URL.
+	DefaultGitLabURL = "https://gitlab.com"
+
+	// DefaultGiteaURL is the default Gitea instance URL.
+	DefaultGiteaURL = "https://gitea.com"
+)
+
 const (
 	FormatJSON     = "json"
 	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatAsciiDoc = "asciidoc"
+	FormatRST      = "rst"
 
 	GoTemplatePrefix       = "go-template:"
 	GoTemplatePrefixInline = "inline:"
@@ -162,6 +195,7 @@ func New() *Options {
 		DiscoverMode:       RevisionDiscoveryModeNONE,
 		GithubOrg:          git.DefaultGithubOrg,
 		GithubRepo:         git.DefaultGithubRepo,
+		Forge:              ForgeGitHub,
 		Format:             FormatMarkdown,
 		GoTemplate:         GoTemplateDefault,
 		Pull:               true,
@@ -185,8 +219,21 @@ func (o *Options) ValidateAndFinish() (err error) {
 		return errors.New("please do not use record and replay together")
 	}
 
+	if err := o.checkForgeOptions(); err != nil {
+		return fmt.Errorf("while checking forge flags: %w", err)
+	}
+
 	if o.ReplayDir != "" {
 		logrus.Info("Using replay mode")
+	} else if o.Forge != ForgeGitHub {
+		// GitLab and Gitea tokens are only required for private projects
+		o.forgeToken = os.Getenv(o.forgeTokenEnvKey())
+		if o.forgeToken == "" {
+			logrus.Warnf(
+				"Environment variable `%s` is not set, accessing the %s API anonymously",
+				o.forgeTokenEnvKey(), o.Forge,
+			)
+		}
 	} else {
 		// The GitHub Token is required if replay is not specified
 		token, ok := os.LookupEnv(github.TokenEnvKey)
@@ -317,6 +364,56 @@ func (o *Options) Client() (github.Client, error) { //nolint:ireturn // returnin
 	return gh.Client(), nil
 }
 
+// Forges returns all supported code hosting platforms.
+func Forges() []string {
+	return []string{ForgeGitHub, ForgeGitLab, ForgeGitea}
+}
+
+// ForgeToken returns the token for accessing the GitLab or Gitea API, which
+// may be empty for anonymous access.
+func (o *Options) ForgeToken() string {
+	return o.forgeToken
+}
+
+// checkForgeOptions verifies the forge and defaults its URL.
+func (o *Options) checkForgeOptions() error {
+	if o.Forge == "" {
+		o.Forge = ForgeGitHub
+	}
+
+	if !slices.Contains(Forges(), o.Forge) {
+		return fmt.Errorf("invalid forge: %s", o.Forge)
+	}
+
+	if o.ForgeURL == "" {
+		switch o.Forge {
+		case ForgeGitLab:
+			o.ForgeURL = DefaultGitLabURL
+		case ForgeGitea:
+			o.ForgeURL = DefaultGiteaURL
+		}
+	}
+
+	o.ForgeURL = strings.TrimSuffix(o.ForgeURL, "/")
+
+	return nil
+}
+
+func (o *Options) forgeTokenEnvKey() string {
+	if o.Forge == ForgeGitea {
+		return GiteaTokenEnvKey
+	}
+
+	return GitLabTokenEnvKey
+}
+
+// Formats returns all supported release notes formats.
+func Formats() []string {
+	return []string{
+		FormatJSON, FormatMarkdown, FormatHTML, FormatAsciiDoc, FormatRST,
+	}
+}
+
 // checkFormatOptions verifies that template related options are sane.
 func (o *Options) checkFormatOptions() error {
 	// Validate the output format and template
@@ -341,12 +438,12 @@ func (o *Options) checkFormatOptions() error {
 		}
 	}
 
-	if o.Format == FormatJSON && o.GoTemplate != GoTemplateDefault {
-		return errors.New("go-template cannot be defined when in JSON mode")
+	if !slices.Contains(Formats(), o.Format) {
+		return fmt.Errorf("invalid format: %s", o.Format)
 	}
 
-	if o.Format != FormatJSON && o.Format != FormatMarkdown {
-		return fmt.Errorf("invalid format: %s", o.Format)
+	if o.Format != FormatMarkdown && o.GoTemplate != GoTemplateDefault {
+		return fmt.Errorf("go-template cannot be defined when in %s mode", o.Format)
 	}
 
 	return nil
@@ -393,7 +490,12 @@ func (o *Options) resolveDiscoverMode() error {
 }
 
 func (o *Options) repo() (repo *git.Repo, err error) {
-	if o.Pull {
+	switch {
+	case o.Pull && o.Forge != "" && o.Forge != ForgeGitHub:
+		repoURL := fmt.Sprintf("%s/%s/%s.git", o.ForgeURL, o.GithubOrg, o.GithubRepo)
+		logrus.Infof("Cloning/updating repository %s", repoURL)
+		repo, err = git.CloneOrOpenRepo(o.RepoPath, repoURL, false, true, nil)
+	case o.Pull:
 		logrus.Infof("Cloning/updating repository %s/%s", o.GithubOrg, o.GithubRepo)
 		repo, err = o.gitCloneFn(
 			o.RepoPath,
@@ -401,7 +503,7 @@ func (o *Options) repo() (repo *git.Repo, err error) {
 			o.GithubRepo,
 			false,
 		)
-	} else {
+	default:
 		logrus.Infof("Re-using local repo %s", o.RepoPath)
 		repo, err = git.OpenRepo(o.RepoPath)
 	}
@@ -406,3 +406,63 @@ func TestValidateAndFinishFailureGoTemplate(t *testing.T) {
 	// When
 	require.Error(t, options.ValidateAndFinish())
 }
+
+func TestValidateAndFinishSuccessFormats(t *testing.T) {
+	for _, format := range Formats() {
+		options := newTestOptions(t)
+
+		// Given
+		options.Format = format
+
+		// When
+		require.NoError(t, options.ValidateAndFinish(), format)
+		options.testRepo.cleanup(t)
+	}
+}
+
+func TestValidateAndFinishFailureGoTemplateFormat(t *testing.T) {
+	options := newTestOptions(t)
+	defer options.testRepo.cleanup(t)
+
+	// Given
+	options.Format = FormatHTML
+	options.GoTemplate = GoTemplateInline + "{{.}}"
+
+	// When
+	require.Error(t, options.ValidateAndFinish())
+}
+
+func TestValidateAndFinishFailureForge(t *testing.T) {
+	options := newTestOptions(t)
+	defer options.testRepo.cleanup(t)
+
+	// Given
+	options.Forge = "wrong"
+
+	// When
+	require.Error(t, options.ValidateAndFinish())
+}
+
+func TestValidateAndFinishSuccessForgeURL(t *testing.T) {
+	for _, tc := range []struct {
+		forge, forgeURL, expected string
+	}{
+		{ForgeGitHub, "", ""},
+		{ForgeGitLab, "", DefaultGitLabURL},
+		{ForgeGitea, "", DefaultGiteaURL},
+		{ForgeGitea, "https://gitea.example.com/", "https://gitea.example.com"},
+	} {
+		options := newTestOptions(t)
+
+		// Given
+		options.Forge = tc.forge
+		options.ForgeURL = tc.forgeURL
+
+		// When
+		require.NoError(t, options.ValidateAndFinish())
+
+		// Then
+		require.Equal(t, tc.expected, options.ForgeURL)
+		options.testRepo.cleanup(t)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cloud.google.com/go/storage"
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-sdk/gcli"
+	"sigs.k8s.io/release-sdk/object"
+)
+
+// GCS is the Google Cloud Storage backend, which uses `gsutil` for most of
+// its operations.
+type GCS struct {
+	*object.GCS
+}
+
+// NewGCS creates a new Google Cloud Storage backend.
+func NewGCS() *GCS {
+	return &GCS{object.NewGCS()}
+}
+
+// Prefix returns the `gs://` URL prefix.
+func (g *GCS) Prefix() string {
+	return object.GcsPrefix
+}
+
+// ReadObject returns the content of the object by using `gsutil cat`.
+func (g *GCS) ReadObject(path string) ([]byte, error) {
+	path, err := g.NormalizePath(path)
+	if err != nil {
+		return nil, fmt.Errorf("normalize GCS path: %w", err)
+	}
+
+	content, err := gcli.GSUtilOutput("cat", path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	return []byte(content), nil
+}
+
+// CopyFileToRemote copies a single file to GCS by setting the attributes as
+// `gsutil` headers.
+func (g *GCS) CopyFileToRemote(src, dst string, attrs *ObjectAttrs) error {
+	dst, err := g.NormalizePath(dst)
+	if err != nil {
+		return fmt.Errorf("normalize GCS path: %w", err)
+	}
+
+	args := []string{"-m"}
+
+	if attrs != nil {
+		if attrs.ContentType != "" {
+			args = append(args, "-h", "Content-Type:"+attrs.ContentType)
+		}
+
+		if attrs.CacheControl != "" {
+			args = append(args, "-h", "Cache-Control:"+attrs.CacheControl)
+		}
+
+		keys := make([]string, 0, len(attrs.Metadata))
+		for key := range attrs.Metadata {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			args = append(args, "-h", fmt.Sprintf("x-goog-meta-%s: %s", key, attrs.Metadata[key]))
+		}
+	}
+
+	args = append(args, "cp", src, dst)
+
+	if err := gcli.GSUtil(args...); err != nil {
+		return fmt.Errorf("copy %s to %s: %w", src, dst, err)
+	}
+
+	return nil
+}
+
+// CheckWriteAccess verifies that the bucket exists and the current
+// authenticated GCP user has write permissions to it.
+func (g *GCS) CheckWriteAccess(bucket string) error {
+	logrus.Infof("Checking bucket %s for write permissions", bucket)
+
+	client, err := storage.NewClient(context.Background())
+	if err != nil {
+		return fmt.Errorf(
+			"fetching gcloud credentials, try running "+
+				`"gcloud auth application-default login"`+
+				": %w",
+			err,
+		)
+	}
+
+	bucketHandle := client.Bucket(trimPrefix(bucket, object.GcsPrefix))
+	if bucketHandle == nil {
+		return fmt.Errorf(
+			"identify specified bucket for artifacts: %s", bucket,
+		)
+	}
+
+	// Check if bucket exists and user has permissions
+	requiredGCSPerms := []string{"storage.objects.create"}
+
+	perms, err := bucketHandle.IAM().TestPermissions(
+		context.Background(), requiredGCSPerms,
+	)
+	if err != nil {
+		return fmt.Errorf("find release artifact bucket, try running `gcloud auth application-default login`: %w", err)
+	}
+
+	if len(perms) != 1 {
+		return fmt.Errorf(
+			"GCP user must have at least %s permissions on bucket %s",
+			requiredGCSPerms, bucket,
+		)
+	}
+
+	return nil
+}
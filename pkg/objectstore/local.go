@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-sdk/object"
+)
+
+// Local is a store backed by a directory on the local filesystem. Buckets are
+// referenced by absolute `file://` URLs, for example `file:///tmp/bucket`.
+type Local struct {
+	noClobber    bool
+	allowMissing bool
+}
+
+// NewLocal creates a new local filesystem store.
+func NewLocal() *Local {
+	return &Local{
+		noClobber:    true,
+		allowMissing: true,
+	}
+}
+
+func (l *Local) SetOptions(opts ...object.OptFn) {
+	for _, f := range opts {
+		f(l)
+	}
+}
+
+func (l *Local) WithNoClobber(noClobber bool) object.OptFn {
+	return func(object.Store) {
+		l.noClobber = noClobber
+	}
+}
+
+func (l *Local) WithAllowMissing(allowMissing bool) object.OptFn {
+	return func(object.Store) {
+		l.allowMissing = allowMissing
+	}
+}
+
+// Prefix returns the `file://` URL prefix.
+func (l *Local) Prefix() string {
+	return LocalPrefix
+}
+
+// NormalizePath combines the path parts to an absolute `file://` URL.
+func (l *Local) NormalizePath(pathParts ...string) (string, error) {
+	path, err := joinPath(LocalPrefix, pathParts...)
+	if err != nil {
+		return "", err
+	}
+
+	return LocalPrefix + "/" + strings.TrimLeft(path, "/"), nil
+}
+
+// IsPathNormalized returns true if the path is an absolute `file://` URL.
+func (l *Local) IsPathNormalized(path string) bool {
+	return strings.HasPrefix(path, LocalPrefix+"/")
+}
+
+// PathExists returns true if the file or directory exists.
+func (l *Local) PathExists(path string) (bool, error) {
+	_, err := os.Stat(l.localPath(path))
+	if err == nil {
+		return true, nil
+	}
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+// CopyToRemote copies a local file or directory into the store.
+func (l *Local) CopyToRemote(src, dst string) error {
+	logrus.Infof("Copying %s to %s", src, dst)
+
+	if _, err := os.Stat(src); err != nil {
+		if l.allowMissing {
+			logrus.Infof("Source %s does not exist. Skipping upload.", src)
+
+			return nil
+		}
+
+		return fmt.Errorf("source %s does not exist", src)
+	}
+
+	return l.copy(src, l.localPath(dst))
+}
+
+// CopyToLocal copies a file or directory from the store to the local path.
+func (l *Local) CopyToLocal(src, dst string) error {
+	logrus.Infof("Copying %s to %s", src, dst)
+
+	return l.copy(l.localPath(src), dst)
+}
+
+// CopyBucketToBucket copies between two locations of the store.
+func (l *Local) CopyBucketToBucket(src, dst string) error {
+	logrus.Infof("Copying %s to %s", src, dst)
+
+	return l.copy(l.localPath(src), l.localPath(dst))
+}
+
+// RsyncRecursive copies the contents of the source directory into the
+// destination directory. Both paths can either be local or store paths.
+func (l *Local) RsyncRecursive(src, dst string) error {
+	src, dst = l.localPath(src), l.localPath(dst)
+	logrus.Infof("Syncing %s to %s", src, dst)
+
+	return copyTree(src, dst, false)
+}
+
+// GetReleasePath returns the path to retrieve builds from or push builds to.
+func (l *Local) GetReleasePath(
+	bucket, gcsRoot, version string, fast bool,
+) (string, error) {
+	return getPath(l.NormalizePath, bucket, gcsRoot, version, fast)
+}
+
+// GetMarkerPath returns the path where version markers should be stored.
+func (l *Local) GetMarkerPath(
+	bucket, gcsRoot string, fast bool,
+) (string, error) {
+	return getPath(l.NormalizePath, bucket, gcsRoot, "", fast)
+}
+
+// ReadObject returns the content of the file.
+func (l *Local) ReadObject(path string) ([]byte, error) {
+	content, err := os.ReadFile(l.localPath(path))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	return content, nil
+}
+
+// CopyFileToRemote copies a single file into the store. The attributes are
+// ignored because the filesystem has no way to store them.
+func (l *Local) CopyFileToRemote(src, dst string, _ *ObjectAttrs) error {
+	return copyFile(src, l.localPath(dst), false)
+}
+
+// CheckWriteAccess creates the bucket directory if required and verifies
+// that it is writable.
+func (l *Local) CheckWriteAccess(bucket string) error {
+	dir := l.localPath(bucket)
+	logrus.Infof("Checking directory %s for write permissions", dir)
+
+	if err := os.MkdirAll(dir, os.FileMode(0o755)); err != nil {
+		return fmt.Errorf("create bucket directory: %w", err)
+	}
+
+	f, err := os.CreateTemp(dir, ".write-check-")
+	if err != nil {
+		return fmt.Errorf("bucket directory %s is not writable: %w", dir, err)
+	}
+
+	f.Close()
+
+	return os.Remove(f.Name())
+}
+
+// localPath converts a store path into a filesystem path.
+func (l *Local) localPath(path string) string {
+	if !isLocalPath(path) {
+		return path
+	}
+
+	return "/" + strings.TrimLeft(trimPrefix(path, LocalPrefix), "/")
+}
+
+// copy follows the semantics of `gsutil cp -r`: if the destination is an
+// existing directory, then the source is copied into it.
+func (l *Local) copy(src, dst string) error {
+	srcInfo, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("stat source %s: %w", src, err)
+	}
+
+	if dstInfo, err := os.Stat(dst); err == nil && dstInfo.IsDir() {
+		dst = filepath.Join(dst, filepath.Base(src))
+	}
+
+	if srcInfo.IsDir() {
+		return copyTree(src, dst, l.noClobber)
+	}
+
+	return copyFile(src, dst, l.noClobber)
+}
+
+// copyTree copies the contents of the src directory into dst.
+func copyTree(src, dst string, noClobber bool) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return fmt.Errorf("get relative path: %w", err)
+		}
+
+		target := filepath.Join(dst, rel)
+
+		if d.IsDir() {
+			return os.MkdirAll(target, os.FileMode(0o755))
+		}
+
+		return copyFile(path, target, noClobber)
+	})
+}
+
+// copyFile copies a single file and creates the parent directories of dst.
+func copyFile(src, dst string, noClobber bool) error {
+	if noClobber {
+		if _, err := os.Stat(dst); err == nil {
+			logrus.Debugf("Skipping existing file %s", dst)
+
+			return nil
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), os.FileMode(0o755)); err != nil {
+		return fmt.Errorf("create destination directory: %w", err)
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open source file: %w", err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return fmt.Errorf("create destination file: %w", err)
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+
+		return fmt.Errorf("copy %s to %s: %w", src, dst, err)
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/objectstore"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		path   string
+		prefix string
+		isGCS  bool
+	}{
+		{path: "file:///tmp/bucket", prefix: objectstore.LocalPrefix},
+		{path: "s3://bucket", prefix: objectstore.S3Prefix},
+		{path: "gs://bucket", prefix: "gs://", isGCS: true},
+		{path: "bucket", prefix: "gs://", isGCS: true},
+	} {
+		require.Equal(t, tc.prefix, objectstore.New(tc.path).Prefix(), tc.path)
+		require.Equal(t, tc.isGCS, objectstore.IsGCS(tc.path), tc.path)
+	}
+}
+
+func TestLocalNormalizePath(t *testing.T) {
+	t.Parallel()
+
+	sut := objectstore.NewLocal()
+
+	for _, tc := range []struct {
+		parts       []string
+		expected    string
+		shouldError bool
+	}{
+		{
+			parts:    []string{"file:///tmp/bucket", "release", "v1.0.0"},
+			expected: "file:///tmp/bucket/release/v1.0.0",
+		},
+		{
+			parts:    []string{"/tmp/bucket", "release"},
+			expected: "file:///tmp/bucket/release",
+		},
+		{
+			parts:       []string{"file:///tmp/bucket", "file:///tmp"},
+			shouldError: true,
+		},
+		{
+			parts:       []string{"", ""},
+			shouldError: true,
+		},
+	} {
+		res, err := sut.NormalizePath(tc.parts...)
+		if tc.shouldError {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+			require.True(t, sut.IsPathNormalized(res))
+		}
+	}
+}
+
+func TestLocalCopy(t *testing.T) {
+	t.Parallel()
+
+	src := t.TempDir()
+	bucket := objectstore.LocalPrefix + t.TempDir()
+	sut := objectstore.NewLocal()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "bin", "linux"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "bin", "linux", "kubectl"), []byte("kubectl"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "latest.txt"), []byte("v1.0.0"), 0o644))
+
+	// Directory upload
+	dst, err := sut.NormalizePath(bucket, "release", "v1.0.0")
+	require.NoError(t, err)
+	require.NoError(t, sut.CopyToRemote(filepath.Join(src, "bin"), dst))
+
+	exists, err := sut.PathExists(dst + "/linux/kubectl")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// Single file upload with attributes
+	marker, err := sut.NormalizePath(bucket, "release", "latest.txt")
+	require.NoError(t, err)
+	require.NoError(t, sut.CopyFileToRemote(
+		filepath.Join(src, "latest.txt"), marker,
+		&objectstore.ObjectAttrs{ContentType: "text/plain"},
+	))
+
+	content, err := sut.ReadObject(marker)
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", string(content))
+
+	// No clobber keeps the existing content
+	require.NoError(t, os.WriteFile(filepath.Join(src, "latest.txt"), []byte("v1.0.1"), 0o644))
+	require.NoError(t, sut.CopyToRemote(filepath.Join(src, "latest.txt"), marker))
+	content, err = sut.ReadObject(marker)
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", string(content))
+
+	// Sync between buckets
+	target, err := sut.NormalizePath(bucket, "copy")
+	require.NoError(t, err)
+	require.NoError(t, sut.RsyncRecursive(dst, target))
+
+	exists, err = sut.PathExists(target + "/linux/kubectl")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// Download
+	local := t.TempDir()
+	require.NoError(t, sut.CopyToLocal(marker, filepath.Join(local, "marker")))
+	require.FileExists(t, filepath.Join(local, "marker"))
+
+	// Missing sources
+	require.NoError(t, sut.CopyToRemote(filepath.Join(src, "missing"), dst))
+	sut.SetOptions(sut.WithAllowMissing(false))
+	require.Error(t, sut.CopyToRemote(filepath.Join(src, "missing"), dst))
+}
+
+func TestLocalCheckWriteAccess(t *testing.T) {
+	t.Parallel()
+
+	bucket := objectstore.LocalPrefix + filepath.Join(t.TempDir(), "bucket")
+	require.NoError(t, objectstore.NewLocal().CheckWriteAccess(bucket))
+	require.DirExists(t, filepath.Join(bucket[len(objectstore.LocalPrefix):]))
+}
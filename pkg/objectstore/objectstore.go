@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/release-sdk/object"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//go:generate /usr/bin/env bash -c "cat ../../hack/boilerplate/boilerplate.generatego.txt objectstorefakes/fake_store.go > objectstorefakes/_fake_store.go && mv objectstorefakes/_fake_store.go objectstorefakes/fake_store.go"
+
+const (
+	// LocalPrefix is the URL prefix for buckets on the local filesystem.
+	LocalPrefix = "file://"
+
+	// S3Prefix is the URL prefix for S3 compatible buckets.
+	S3Prefix = "s3://"
+)
+
+// Store is the storage backend used for staging and releasing artifacts. It
+// extends the release-sdk `object.Store` by the operations which are otherwise
+// done by directly calling `gsutil`.
+//
+//counterfeiter:generate . Store
+type Store interface {
+	object.Store
+
+	// WithNoClobber returns an option to not overwrite existing objects.
+	WithNoClobber(noClobber bool) object.OptFn
+
+	// WithAllowMissing returns an option to skip copying non existing
+	// sources.
+	WithAllowMissing(allowMissing bool) object.OptFn
+
+	// Prefix returns the URL prefix of the store, for example `gs://`.
+	Prefix() string
+
+	// ReadObject returns the content of the object at the provided path.
+	ReadObject(path string) ([]byte, error)
+
+	// CopyFileToRemote copies a single local file to the remote path and sets
+	// the provided attributes on the object if supported by the store.
+	CopyFileToRemote(src, dst string, attrs *ObjectAttrs) error
+
+	// CheckWriteAccess verifies that the bucket exists and that the current
+	// user is allowed to write into it.
+	CheckWriteAccess(bucket string) error
+}
+
+// ObjectAttrs are the optional attributes of a written object.
+type ObjectAttrs struct {
+	// ContentType is the MIME type of the object.
+	ContentType string
+
+	// CacheControl is the Cache-Control header served with the object.
+	CacheControl string
+
+	// Metadata is the custom key value metadata of the object.
+	Metadata map[string]string
+}
+
+// New returns the store responsible for the provided bucket or path, which
+// is selected by its URL scheme. Paths without a known scheme default to
+// Google Cloud Storage.
+func New(path string) Store {
+	switch {
+	case isLocalPath(path):
+		return NewLocal()
+	case isS3Path(path):
+		return NewS3()
+	default:
+		return NewGCS()
+	}
+}
+
+// IsGCS returns true if the provided bucket or path is handled by the Google
+// Cloud Storage backend.
+func IsGCS(path string) bool {
+	return !isLocalPath(path) && !isS3Path(path)
+}
+
+func isLocalPath(path string) bool {
+	return strings.HasPrefix(path, strings.TrimSuffix(LocalPrefix, "/"))
+}
+
+func isS3Path(path string) bool {
+	return strings.HasPrefix(path, strings.TrimSuffix(S3Prefix, "/"))
+}
+
+// trimPrefix removes the URL prefix from the path. It also handles prefixes
+// which got collapsed by `filepath.Join`, like `file:/`.
+func trimPrefix(path, prefix string) string {
+	if after, ok := strings.CutPrefix(path, prefix); ok {
+		return after
+	}
+
+	return strings.TrimPrefix(path, strings.TrimSuffix(prefix, "/"))
+}
+
+// joinPath combines the path parts and returns the path without its prefix.
+func joinPath(prefix string, pathParts ...string) (string, error) {
+	if len(pathParts) == 0 {
+		return "", errors.New("must contain at least one path part")
+	}
+
+	nonEmpty := false
+
+	for i, part := range pathParts {
+		if part != "" {
+			nonEmpty = true
+		}
+
+		if i > 0 && strings.Contains(part, strings.TrimSuffix(prefix, "/")) {
+			return "", fmt.Errorf(
+				"one of the path parts contained a `%s`, which may suggest "+
+					"a filepath.Join() error in the caller", prefix,
+			)
+		}
+	}
+
+	if !nonEmpty {
+		return "", errors.New("all paths provided were empty")
+	}
+
+	return trimPrefix(filepath.Join(pathParts...), prefix), nil
+}
+
+// getPath returns the release or version marker path for the provided
+// normalize function.
+//
+// Expected destination format:
+//
+//	<prefix><bucket>/<gcsRoot>[/fast][/<version>]
+func getPath(
+	normalize func(...string) (string, error),
+	bucket, gcsRoot, version string,
+	fast bool,
+) (string, error) {
+	if gcsRoot == "" {
+		return "", errors.New("root directory must be specified")
+	}
+
+	pathParts := []string{bucket, gcsRoot}
+	if fast {
+		pathParts = append(pathParts, "fast")
+	}
+
+	if version != "" {
+		pathParts = append(pathParts, version)
+	}
+
+	return normalize(pathParts...)
+}
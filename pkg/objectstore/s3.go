@@ -0,0 +1,707 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-sdk/object"
+)
+
+const (
+	// S3EndpointEnvKey is the environment variable for the S3 API endpoint,
+	// for example a local MinIO instance.
+	S3EndpointEnvKey = "AWS_ENDPOINT_URL"
+
+	// S3RegionEnvKey is the environment variable for the S3 region.
+	S3RegionEnvKey = "AWS_REGION"
+
+	// S3AccessKeyEnvKey is the environment variable for the access key ID.
+	S3AccessKeyEnvKey = "AWS_ACCESS_KEY_ID"
+
+	// S3SecretKeyEnvKey is the environment variable for the secret key.
+	S3SecretKeyEnvKey = "AWS_SECRET_ACCESS_KEY"
+
+	// S3SessionTokenEnvKey is the environment variable for the optional
+	// session token.
+	S3SessionTokenEnvKey = "AWS_SESSION_TOKEN"
+
+	defaultS3Region = "us-east-1"
+
+	// emptyPayloadHash is the SHA256 of an empty request body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// S3 is a store for S3 compatible object storage like MinIO. Requests use
+// path-style addressing, which means that buckets are referenced by
+// `s3://<bucket>/<key>` URLs. The endpoint and credentials are read from the
+// standard AWS environment variables.
+type S3 struct {
+	endpoint     string
+	region       string
+	credentials  aws.Credentials
+	client       *http.Client
+	signer       *v4.Signer
+	noClobber    bool
+	allowMissing bool
+}
+
+// NewS3 creates a new S3 store configured from the environment.
+func NewS3() *S3 {
+	region := os.Getenv(S3RegionEnvKey)
+	if region == "" {
+		region = defaultS3Region
+	}
+
+	endpoint := os.Getenv(S3EndpointEnvKey)
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	}
+
+	return &S3{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		region:   region,
+		credentials: aws.Credentials{
+			AccessKeyID:     os.Getenv(S3AccessKeyEnvKey),
+			SecretAccessKey: os.Getenv(S3SecretKeyEnvKey),
+			SessionToken:    os.Getenv(S3SessionTokenEnvKey),
+		},
+		client: &http.Client{Timeout: 10 * time.Minute},
+		signer: v4.NewSigner(func(o *v4.SignerOptions) {
+			o.DisableURIPathEscaping = true
+		}),
+		noClobber:    true,
+		allowMissing: true,
+	}
+}
+
+// SetEndpoint can be used to override the S3 API endpoint.
+func (s *S3) SetEndpoint(endpoint string) {
+	s.endpoint = strings.TrimSuffix(endpoint, "/")
+}
+
+// SetCredentials can be used to override the S3 credentials.
+func (s *S3) SetCredentials(accessKeyID, secretAccessKey string) {
+	s.credentials = aws.Credentials{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+	}
+}
+
+func (s *S3) SetOptions(opts ...object.OptFn) {
+	for _, f := range opts {
+		f(s)
+	}
+}
+
+func (s *S3) WithNoClobber(noClobber bool) object.OptFn {
+	return func(object.Store) {
+		s.noClobber = noClobber
+	}
+}
+
+func (s *S3) WithAllowMissing(allowMissing bool) object.OptFn {
+	return func(object.Store) {
+		s.allowMissing = allowMissing
+	}
+}
+
+// Prefix returns the `s3://` URL prefix.
+func (s *S3) Prefix() string {
+	return S3Prefix
+}
+
+// NormalizePath combines the path parts to a `s3://` URL.
+func (s *S3) NormalizePath(pathParts ...string) (string, error) {
+	p, err := joinPath(S3Prefix, pathParts...)
+	if err != nil {
+		return "", err
+	}
+
+	return S3Prefix + strings.TrimLeft(p, "/"), nil
+}
+
+// IsPathNormalized returns true if the path is a `s3://` URL.
+func (s *S3) IsPathNormalized(p string) bool {
+	return strings.HasPrefix(p, S3Prefix)
+}
+
+// PathExists returns true if either an object or a prefix with the path
+// exists.
+func (s *S3) PathExists(p string) (bool, error) {
+	bucket, key, err := s.splitPath(p)
+	if err != nil {
+		return false, err
+	}
+
+	exists, err := s.objectExists(bucket, key)
+	if err != nil || exists {
+		return exists, err
+	}
+
+	keys, err := s.listKeys(bucket, dirPrefix(key))
+	if err != nil {
+		return false, err
+	}
+
+	return len(keys) > 0, nil
+}
+
+// CopyToRemote uploads a local file or directory.
+func (s *S3) CopyToRemote(src, dst string) error {
+	logrus.Infof("Copying %s to %s", src, dst)
+
+	info, err := os.Stat(src)
+	if err != nil {
+		if s.allowMissing {
+			logrus.Infof("Source %s does not exist. Skipping upload.", src)
+
+			return nil
+		}
+
+		return fmt.Errorf("source %s does not exist", src)
+	}
+
+	bucket, key, err := s.splitPath(dst)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		if strings.HasSuffix(dst, "/") {
+			key = path.Join(key, filepath.Base(src))
+		}
+
+		return s.uploadFile(src, bucket, key, nil, s.noClobber)
+	}
+
+	// Copy into the "directory" if it already exists, like `gsutil cp -r`.
+	if keys, err := s.listKeys(bucket, dirPrefix(key)); err == nil && len(keys) > 0 {
+		key = path.Join(key, filepath.Base(src))
+	}
+
+	return s.uploadTree(src, bucket, key, s.noClobber)
+}
+
+// CopyToLocal downloads an object or all objects below a prefix.
+func (s *S3) CopyToLocal(src, dst string) error {
+	logrus.Infof("Copying %s to %s", src, dst)
+
+	bucket, key, err := s.splitPath(src)
+	if err != nil {
+		return err
+	}
+
+	exists, err := s.objectExists(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		if info, err := os.Stat(dst); err == nil && info.IsDir() {
+			dst = filepath.Join(dst, path.Base(key))
+		}
+
+		return s.downloadFile(bucket, key, dst)
+	}
+
+	if info, err := os.Stat(dst); err == nil && info.IsDir() {
+		dst = filepath.Join(dst, path.Base(key))
+	}
+
+	return s.downloadTree(bucket, key, dst)
+}
+
+// CopyBucketToBucket copies an object or prefix by using server side copies.
+func (s *S3) CopyBucketToBucket(src, dst string) error {
+	logrus.Infof("Copying %s to %s", src, dst)
+
+	srcBucket, srcKey, err := s.splitPath(src)
+	if err != nil {
+		return err
+	}
+
+	dstBucket, dstKey, err := s.splitPath(dst)
+	if err != nil {
+		return err
+	}
+
+	exists, err := s.objectExists(srcBucket, srcKey)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return s.copyObject(srcBucket, srcKey, dstBucket, dstKey)
+	}
+
+	return s.copyPrefix(srcBucket, srcKey, dstBucket, path.Join(dstKey, path.Base(srcKey)))
+}
+
+// RsyncRecursive copies the contents of the source into the destination.
+// Both paths can either be local or `s3://` paths.
+func (s *S3) RsyncRecursive(src, dst string) error {
+	logrus.Infof("Syncing %s to %s", src, dst)
+
+	switch {
+	case isS3Path(src) && isS3Path(dst):
+		srcBucket, srcKey, err := s.splitPath(src)
+		if err != nil {
+			return err
+		}
+
+		dstBucket, dstKey, err := s.splitPath(dst)
+		if err != nil {
+			return err
+		}
+
+		return s.copyPrefix(srcBucket, srcKey, dstBucket, dstKey)
+
+	case isS3Path(dst):
+		bucket, key, err := s.splitPath(dst)
+		if err != nil {
+			return err
+		}
+
+		return s.uploadTree(src, bucket, key, false)
+
+	case isS3Path(src):
+		bucket, key, err := s.splitPath(src)
+		if err != nil {
+			return err
+		}
+
+		return s.downloadTree(bucket, key, dst)
+
+	default:
+		return copyTree(src, dst, false)
+	}
+}
+
+// GetReleasePath returns the path to retrieve builds from or push builds to.
+func (s *S3) GetReleasePath(
+	bucket, gcsRoot, version string, fast bool,
+) (string, error) {
+	return getPath(s.NormalizePath, bucket, gcsRoot, version, fast)
+}
+
+// GetMarkerPath returns the path where version markers should be stored.
+func (s *S3) GetMarkerPath(
+	bucket, gcsRoot string, fast bool,
+) (string, error) {
+	return getPath(s.NormalizePath, bucket, gcsRoot, "", fast)
+}
+
+// ReadObject returns the content of the object.
+func (s *S3) ReadObject(p string) ([]byte, error) {
+	bucket, key, err := s.splitPath(p)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(http.MethodGet, bucket, key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, fmt.Errorf("read %s: %w", p, err)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// CopyFileToRemote uploads a single file and sets the attributes as object
+// headers.
+func (s *S3) CopyFileToRemote(src, dst string, attrs *ObjectAttrs) error {
+	bucket, key, err := s.splitPath(dst)
+	if err != nil {
+		return err
+	}
+
+	return s.uploadFile(src, bucket, key, attrs, false)
+}
+
+// CheckWriteAccess verifies that the bucket exists and accepts writes.
+func (s *S3) CheckWriteAccess(bucket string) error {
+	bucket, _, err := s.splitPath(bucket)
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("Checking S3 bucket %s for write permissions", bucket)
+
+	key := fmt.Sprintf(".write-check-%d", time.Now().UnixNano())
+
+	resp, err := s.do(http.MethodPut, bucket, key, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return fmt.Errorf("bucket %s is not writable: %w", bucket, err)
+	}
+
+	deleteResp, err := s.do(http.MethodDelete, bucket, key, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer deleteResp.Body.Close()
+
+	return checkResponse(deleteResp)
+}
+
+// splitPath returns the bucket and object key of a path.
+func (s *S3) splitPath(p string) (bucket, key string, err error) {
+	p = strings.TrimLeft(trimPrefix(p, S3Prefix), "/")
+	if p == "" {
+		return "", "", errors.New("path does not contain a bucket")
+	}
+
+	bucket, key, _ = strings.Cut(p, "/")
+
+	return bucket, strings.TrimSuffix(key, "/"), nil
+}
+
+// dirPrefix returns the prefix to list all objects below the key.
+func dirPrefix(key string) string {
+	if key == "" {
+		return ""
+	}
+
+	return key + "/"
+}
+
+func (s *S3) objectExists(bucket, key string) (bool, error) {
+	if key == "" {
+		return false, nil
+	}
+
+	resp, err := s.do(http.MethodHead, bucket, key, nil, nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// listKeys returns all object keys with the provided prefix.
+func (s *S3) listKeys(bucket, prefix string) ([]string, error) {
+	keys := []string{}
+	token := ""
+
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		resp, err := s.do(http.MethodGet, bucket, "", query, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := checkResponse(resp); err != nil {
+			resp.Body.Close()
+
+			return nil, fmt.Errorf("list %s/%s: %w", bucket, prefix, err)
+		}
+
+		result := &listBucketResult{}
+		err = xml.NewDecoder(resp.Body).Decode(result)
+		resp.Body.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("decode list response: %w", err)
+		}
+
+		for _, content := range result.Contents {
+			keys = append(keys, content.Key)
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+
+		token = result.NextContinuationToken
+	}
+}
+
+func (s *S3) uploadFile(
+	src, bucket, key string, attrs *ObjectAttrs, noClobber bool,
+) error {
+	if noClobber {
+		exists, err := s.objectExists(bucket, key)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			logrus.Debugf("Skipping existing object %s/%s", bucket, key)
+
+			return nil
+		}
+	}
+
+	f, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", src, err)
+	}
+	defer f.Close()
+
+	header := http.Header{}
+
+	if attrs != nil {
+		if attrs.ContentType != "" {
+			header.Set("Content-Type", attrs.ContentType)
+		}
+
+		if attrs.CacheControl != "" {
+			header.Set("Cache-Control", attrs.CacheControl)
+		}
+
+		for k, v := range attrs.Metadata {
+			header.Set("X-Amz-Meta-"+k, v)
+		}
+	}
+
+	resp, err := s.do(http.MethodPut, bucket, key, nil, header, f)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return fmt.Errorf("upload %s to %s/%s: %w", src, bucket, key, err)
+	}
+
+	return nil
+}
+
+func (s *S3) uploadTree(src, bucket, key string, noClobber bool) error {
+	return filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return fmt.Errorf("get relative path: %w", err)
+		}
+
+		return s.uploadFile(
+			p, bucket, path.Join(key, filepath.ToSlash(rel)), nil, noClobber,
+		)
+	})
+}
+
+func (s *S3) downloadFile(bucket, key, dst string) error {
+	content, err := s.ReadObject(S3Prefix + path.Join(bucket, key))
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), os.FileMode(0o755)); err != nil {
+		return fmt.Errorf("create destination directory: %w", err)
+	}
+
+	if err := os.WriteFile(dst, content, os.FileMode(0o644)); err != nil {
+		return fmt.Errorf("write %s: %w", dst, err)
+	}
+
+	return nil
+}
+
+func (s *S3) downloadTree(bucket, key, dst string) error {
+	keys, err := s.listKeys(bucket, dirPrefix(key))
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 && !s.allowMissing {
+		return fmt.Errorf("no objects found in %s/%s", bucket, key)
+	}
+
+	for _, k := range keys {
+		rel := strings.TrimPrefix(k, dirPrefix(key))
+		if err := s.downloadFile(bucket, k, filepath.Join(dst, filepath.FromSlash(rel))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *S3) copyObject(srcBucket, srcKey, dstBucket, dstKey string) error {
+	header := http.Header{}
+	header.Set("X-Amz-Copy-Source", "/"+path.Join(srcBucket, srcKey))
+
+	resp, err := s.do(http.MethodPut, dstBucket, dstKey, nil, header, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return fmt.Errorf(
+			"copy %s/%s to %s/%s: %w", srcBucket, srcKey, dstBucket, dstKey, err,
+		)
+	}
+
+	return nil
+}
+
+func (s *S3) copyPrefix(srcBucket, srcKey, dstBucket, dstKey string) error {
+	keys, err := s.listKeys(srcBucket, dirPrefix(srcKey))
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		rel := strings.TrimPrefix(k, dirPrefix(srcKey))
+		if err := s.copyObject(srcBucket, k, dstBucket, path.Join(dstKey, rel)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// do sends a signed request to the S3 API. The body is read twice, once to
+// calculate the payload hash for the signature and once to send it, so that
+// files get streamed instead of being loaded into memory.
+func (s *S3) do(
+	method, bucket, key string, query url.Values, header http.Header, body io.ReadSeeker,
+) (*http.Response, error) {
+	u := fmt.Sprintf("%s/%s", s.endpoint, bucket)
+	if key != "" {
+		u += "/" + (&url.URL{Path: key}).EscapedPath()
+	}
+
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(
+		context.Background(), method, u, http.NoBody,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	payloadHash := emptyPayloadHash
+
+	if body != nil {
+		hash := sha256.New()
+
+		size, err := io.Copy(hash, body)
+		if err != nil {
+			return nil, fmt.Errorf("hash request body: %w", err)
+		}
+
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("rewind request body: %w", err)
+		}
+
+		payloadHash = hex.EncodeToString(hash.Sum(nil))
+
+		if size > 0 {
+			req.Body = io.NopCloser(body)
+			req.ContentLength = size
+			req.GetBody = func() (io.ReadCloser, error) {
+				if _, err := body.Seek(0, io.SeekStart); err != nil {
+					return nil, err
+				}
+
+				return io.NopCloser(body), nil
+			}
+		}
+	}
+
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	if err := s.signer.SignHTTP(
+		context.Background(), s.credentials, req, payloadHash, "s3", s.region, time.Now(),
+	); err != nil {
+		return nil, fmt.Errorf("sign request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", method, u, err)
+	}
+
+	return resp, nil
+}
+
+// checkResponse returns an error for non successful responses.
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	msg, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+}
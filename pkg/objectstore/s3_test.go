@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/objectstore"
+)
+
+// fakeS3 is a minimal in memory S3 API with path-style addressing.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	headers map[string]http.Header
+	// readOnly rejects all writes with an S3 error document.
+	readOnly bool
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		objects: map[string][]byte{},
+		headers: map[string]http.Header{},
+	}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256") {
+		w.WriteHeader(http.StatusForbidden)
+
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, "/")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+		bucket := strings.TrimSuffix(name, "/")
+		prefix := bucket + "/" + r.URL.Query().Get("prefix")
+
+		type content struct {
+			Key string `xml:"Key"`
+		}
+
+		result := struct {
+			XMLName  xml.Name  `xml:"ListBucketResult"`
+			Contents []content `xml:"Contents"`
+		}{}
+
+		keys := []string{}
+
+		for k := range f.objects {
+			if strings.HasPrefix(k, prefix) {
+				keys = append(keys, k)
+			}
+		}
+
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			result.Contents = append(result.Contents, content{
+				Key: strings.TrimPrefix(k, bucket+"/"),
+			})
+		}
+
+		_ = xml.NewEncoder(w).Encode(result) //nolint:errcheck // test server
+
+	case r.Method == http.MethodPut && f.readOnly:
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = io.WriteString(w, `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`) //nolint:errcheck // test server
+
+	case r.Method == http.MethodPut:
+		if src := r.Header.Get("X-Amz-Copy-Source"); src != "" {
+			f.objects[name] = f.objects[strings.TrimPrefix(src, "/")]
+
+			return
+		}
+
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		sum := sha256.Sum256(content)
+		if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		f.objects[name] = content
+		f.headers[name] = r.Header.Clone()
+
+	case r.Method == http.MethodGet, r.Method == http.MethodHead:
+		content, ok := f.objects[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(content) //nolint:errcheck // test server
+		}
+
+	case r.Method == http.MethodDelete:
+		delete(f.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestS3(t *testing.T) (*objectstore.S3, *fakeS3) {
+	t.Helper()
+
+	fake := newFakeS3()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	sut := objectstore.NewS3()
+	sut.SetEndpoint(server.URL)
+	sut.SetCredentials("access", "secret")
+
+	return sut, fake
+}
+
+func TestS3NormalizePath(t *testing.T) {
+	t.Parallel()
+
+	sut := objectstore.NewS3()
+
+	res, err := sut.NormalizePath("s3://bucket", "release", "v1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/release/v1.0.0", res)
+	require.True(t, sut.IsPathNormalized(res))
+
+	res, err = sut.GetMarkerPath("s3://bucket", "release", true)
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/release/fast", res)
+
+	_, err = sut.NormalizePath("s3://bucket", "s3://other")
+	require.Error(t, err)
+}
+
+func TestS3Copy(t *testing.T) {
+	t.Parallel()
+
+	sut, fake := newTestS3(t)
+	src := t.TempDir()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "bin", "linux"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "bin", "linux", "kubectl"), []byte("kubectl"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "latest.txt"), []byte("v1.0.0"), 0o644))
+
+	// Directory upload
+	require.NoError(t, sut.CopyToRemote(filepath.Join(src, "bin"), "s3://bucket/release/v1.0.0"))
+	require.Equal(t, []byte("kubectl"), fake.objects["bucket/release/v1.0.0/linux/kubectl"])
+
+	exists, err := sut.PathExists("s3://bucket/release/v1.0.0")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = sut.PathExists("s3://bucket/release/v2.0.0")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Single file upload with attributes
+	require.NoError(t, sut.CopyFileToRemote(
+		filepath.Join(src, "latest.txt"), "s3://bucket/release/latest.txt",
+		&objectstore.ObjectAttrs{
+			ContentType:  "text/plain",
+			CacheControl: "private, max-age=0",
+			Metadata:     map[string]string{"Surrogate-Key": "release"},
+		},
+	))
+
+	header := fake.headers["bucket/release/latest.txt"]
+	require.Equal(t, "text/plain", header.Get("Content-Type"))
+	require.Equal(t, "private, max-age=0", header.Get("Cache-Control"))
+	require.Equal(t, "release", header.Get("X-Amz-Meta-Surrogate-Key"))
+
+	content, err := sut.ReadObject("s3://bucket/release/latest.txt")
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", string(content))
+
+	// Server side sync
+	require.NoError(t, sut.RsyncRecursive("s3://bucket/release/v1.0.0", "s3://other/v1.0.0"))
+	require.Equal(t, []byte("kubectl"), fake.objects["other/v1.0.0/linux/kubectl"])
+
+	// Download
+	local := t.TempDir()
+	require.NoError(t, sut.RsyncRecursive("s3://other/v1.0.0", local))
+	require.FileExists(t, filepath.Join(local, "linux", "kubectl"))
+
+	_, err = sut.ReadObject("s3://bucket/missing")
+	require.Error(t, err)
+}
+
+func TestS3CheckWriteAccess(t *testing.T) {
+	t.Parallel()
+
+	sut, fake := newTestS3(t)
+	require.NoError(t, sut.CheckWriteAccess("s3://bucket"))
+	require.Empty(t, fake.objects)
+}
+
+func TestS3ErrorResponse(t *testing.T) {
+	t.Parallel()
+
+	sut, fake := newTestS3(t)
+	fake.readOnly = true
+
+	err := sut.CheckWriteAccess("s3://bucket")
+	require.ErrorContains(t, err, "<Code>AccessDenied</Code>")
+
+	src := filepath.Join(t.TempDir(), "kubectl")
+	require.NoError(t, os.WriteFile(src, []byte("binary"), 0o600))
+
+	err = sut.CopyFileToRemote(src, "s3://bucket/kubectl", nil)
+	require.ErrorContains(t, err, "Access Denied")
+}
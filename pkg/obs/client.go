@@ -0,0 +1,487 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package obs
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // OBS identifies source files by their MD5 sum
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// clientTimeout is the timeout of a single OBS API request. Uploads of
+	// the artifacts archives can take a while.
+	clientTimeout = 15 * time.Minute
+)
+
+// Final package build status codes, see
+// https://openbuildservice.org/help/manuals/obs-user-guide/cha.obs.build_results
+const (
+	BuildStatusSucceeded    = "succeeded"
+	BuildStatusFailed       = "failed"
+	BuildStatusUnresolvable = "unresolvable"
+	BuildStatusBroken       = "broken"
+	BuildStatusDisabled     = "disabled"
+	BuildStatusExcluded     = "excluded"
+	BuildStatusLocked       = "locked"
+)
+
+// finalBuildStatuses are the status codes of finished builds.
+var finalBuildStatuses = []string{
+	BuildStatusSucceeded,
+	BuildStatusFailed,
+	BuildStatusUnresolvable,
+	BuildStatusBroken,
+	BuildStatusDisabled,
+	BuildStatusExcluded,
+	BuildStatusLocked,
+}
+
+// failedBuildStatuses are the status codes of builds which did not produce
+// packages.
+var failedBuildStatuses = []string{
+	BuildStatusFailed,
+	BuildStatusUnresolvable,
+	BuildStatusBroken,
+}
+
+// Client is a client for the OpenBuildService REST API, see
+// https://api.opensuse.org/apidocs/
+type Client struct {
+	apiURL     string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// NewClient creates a new OBS API client which authenticates with the
+// provided credentials.
+func NewClient(apiURL, username, password string) *Client {
+	return &Client{
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		username:   username,
+		password:   password,
+		httpClient: &http.Client{Timeout: clientTimeout},
+	}
+}
+
+// NewClientFromEnv creates a new OBS API client using the credentials and
+// API URL from the OBS_* environment variables.
+func NewClientFromEnv() (*Client, error) {
+	password := os.Getenv(OBSPasswordKey)
+	if password == "" {
+		return nil, fmt.Errorf("%s environment variable not set", OBSPasswordKey)
+	}
+
+	return NewClient(apiURLFromEnv(), usernameFromEnv(), password), nil
+}
+
+func apiURLFromEnv() string {
+	if apiURL := os.Getenv(OBSAPIURLKey); apiURL != "" {
+		return apiURL
+	}
+
+	return obsAPIURL
+}
+
+func usernameFromEnv() string {
+	if username := os.Getenv(OBSUsernameKey); username != "" {
+		return username
+	}
+
+	return obsK8sUsername
+}
+
+// Person is an OBS user account.
+type Person struct {
+	Login    string `xml:"login"`
+	Email    string `xml:"email"`
+	RealName string `xml:"realname"`
+}
+
+// Directory is a source listing of a project or package.
+type Directory struct {
+	Name    string           `xml:"name,attr"`
+	Rev     string           `xml:"rev,attr"`
+	SrcMD5  string           `xml:"srcmd5,attr"`
+	Entries []DirectoryEntry `xml:"entry"`
+}
+
+// DirectoryEntry is a package of a project or a file of a package.
+type DirectoryEntry struct {
+	Name  string `xml:"name,attr"`
+	MD5   string `xml:"md5,attr"`
+	Size  int64  `xml:"size,attr"`
+	MTime int64  `xml:"mtime,attr"`
+}
+
+// ResultList are the build results of a project.
+type ResultList struct {
+	State   string   `xml:"state,attr"`
+	Results []Result `xml:"result"`
+}
+
+// Result is the build result of a repository and architecture.
+type Result struct {
+	Project    string          `xml:"project,attr"`
+	Repository string          `xml:"repository,attr"`
+	Arch       string          `xml:"arch,attr"`
+	Code       string          `xml:"code,attr"`
+	State      string          `xml:"state,attr"`
+	Dirty      bool            `xml:"dirty,attr"`
+	Statuses   []PackageStatus `xml:"status"`
+}
+
+// PackageStatus is the build status of a package in a repository.
+type PackageStatus struct {
+	Package string `xml:"package,attr"`
+	Code    string `xml:"code,attr"`
+	Details string `xml:"details"`
+}
+
+// apiStatus is the status returned by the API on errors.
+type apiStatus struct {
+	Code    string `xml:"code,attr"`
+	Summary string `xml:"summary"`
+}
+
+// Whoami returns the account of the authenticated user.
+func (c *Client) Whoami(ctx context.Context) (*Person, error) {
+	person := &Person{}
+	if err := c.getXML(ctx, c.url(nil, "person", c.username), person); err != nil {
+		return nil, fmt.Errorf("getting user %s: %w", c.username, err)
+	}
+
+	return person, nil
+}
+
+// ListPackages returns the names of the packages of a project.
+func (c *Client) ListPackages(ctx context.Context, project string) ([]string, error) {
+	dir := &Directory{}
+	if err := c.getXML(ctx, c.url(nil, "source", project), dir); err != nil {
+		return nil, fmt.Errorf("listing packages of project %s: %w", project, err)
+	}
+
+	packages := make([]string, 0, len(dir.Entries))
+	for _, entry := range dir.Entries {
+		packages = append(packages, entry.Name)
+	}
+
+	return packages, nil
+}
+
+// ListFiles returns the source files of a package.
+func (c *Client) ListFiles(ctx context.Context, project, packageName string) (*Directory, error) {
+	dir := &Directory{}
+	if err := c.getXML(ctx, c.url(nil, "source", project, packageName), dir); err != nil {
+		return nil, fmt.Errorf("listing files of package %s/%s: %w", project, packageName, err)
+	}
+
+	return dir, nil
+}
+
+// UploadFile stores a file in the upload revision of a package, which gets
+// applied by Commit.
+func (c *Client) UploadFile(ctx context.Context, project, packageName, fileName string, content io.Reader) error {
+	u := c.url(url.Values{"rev": {"upload"}}, "source", project, packageName, fileName)
+	if _, err := c.do(ctx, http.MethodPut, u, content); err != nil {
+		return fmt.Errorf("uploading %s to package %s/%s: %w", fileName, project, packageName, err)
+	}
+
+	return nil
+}
+
+// DeleteFile removes a file in the upload revision of a package, which gets
+// applied by Commit.
+func (c *Client) DeleteFile(ctx context.Context, project, packageName, fileName string) error {
+	u := c.url(url.Values{"rev": {"upload"}}, "source", project, packageName, fileName)
+	if _, err := c.do(ctx, http.MethodDelete, u, nil); err != nil {
+		return fmt.Errorf("deleting %s from package %s/%s: %w", fileName, project, packageName, err)
+	}
+
+	return nil
+}
+
+// Commit commits the upload revision of a package, which triggers the
+// build.
+func (c *Client) Commit(ctx context.Context, project, packageName, message string) error {
+	u := c.url(url.Values{"cmd": {"commit"}, "comment": {message}}, "source", project, packageName)
+	if _, err := c.do(ctx, http.MethodPost, u, nil); err != nil {
+		return fmt.Errorf("committing package %s/%s: %w", project, packageName, err)
+	}
+
+	return nil
+}
+
+// Release copies the successful builds of a package into the release
+// target repositories of the project.
+func (c *Client) Release(ctx context.Context, project, packageName string) error {
+	u := c.url(url.Values{"cmd": {"release"}}, "source", project, packageName)
+	if _, err := c.do(ctx, http.MethodPost, u, nil); err != nil {
+		return fmt.Errorf("releasing package %s/%s: %w", project, packageName, err)
+	}
+
+	return nil
+}
+
+// BuildResults returns the build results of the packages for all
+// repositories and architectures of the project.
+func (c *Client) BuildResults(ctx context.Context, project string, packages ...string) (*ResultList, error) {
+	results := &ResultList{}
+
+	u := c.url(url.Values{"package": packages}, "build", project, "_result")
+	if err := c.getXML(ctx, u, results); err != nil {
+		return nil, fmt.Errorf("getting build results of project %s: %w", project, err)
+	}
+
+	return results, nil
+}
+
+// BuildLogTail returns the end of the build log of a package, which is at
+// most size bytes long.
+func (c *Client) BuildLogTail(ctx context.Context, project, repository, arch, packageName string, size int64) (string, error) {
+	elems := []string{"build", project, repository, arch, packageName, "_log"}
+
+	entry := &Directory{}
+	if err := c.getXML(ctx, c.url(url.Values{"view": {"entry"}}, elems...), entry); err != nil {
+		return "", fmt.Errorf("getting build log size of %s/%s/%s/%s: %w", project, repository, arch, packageName, err)
+	}
+
+	start := int64(0)
+	if len(entry.Entries) > 0 && entry.Entries[0].Size > size {
+		start = entry.Entries[0].Size - size
+	}
+
+	u := c.url(url.Values{"nostream": {"1"}, "start": {strconv.FormatInt(start, 10)}}, elems...)
+
+	data, err := c.do(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", fmt.Errorf("getting build log of %s/%s/%s/%s: %w", project, repository, arch, packageName, err)
+	}
+
+	return string(data), nil
+}
+
+// PushPackage makes the files of the local directory the sources of the
+// package and commits them. Files which are unchanged are not uploaded,
+// files which do not exist locally are removed. Hidden files are ignored.
+func (c *Client) PushPackage(ctx context.Context, project, packageName, dir, message string) error {
+	remote, err := c.ListFiles(ctx, project, packageName)
+	if err != nil {
+		return err
+	}
+
+	remoteMD5 := map[string]string{}
+	for _, entry := range remote.Entries {
+		remoteMD5[entry.Name] = entry.MD5
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("reading package directory: %w", err)
+	}
+
+	local := map[string]bool{}
+
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		local[entry.Name()] = true
+
+		if err := c.uploadIfChanged(ctx, project, packageName, filepath.Join(dir, entry.Name()), remoteMD5[entry.Name()]); err != nil {
+			return err
+		}
+	}
+
+	for name := range remoteMD5 {
+		if local[name] {
+			continue
+		}
+
+		logrus.Infof("Deleting %s from package %s/%s", name, project, packageName)
+
+		if err := c.DeleteFile(ctx, project, packageName, name); err != nil {
+			return err
+		}
+	}
+
+	logrus.Infof("Committing package %s/%s", project, packageName)
+
+	return c.Commit(ctx, project, packageName, message)
+}
+
+func (c *Client) uploadIfChanged(ctx context.Context, project, packageName, filePath, remoteMD5 string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("opening package file: %w", err)
+	}
+	defer f.Close()
+
+	//nolint:gosec // OBS identifies source files by their MD5 sum
+	hash := md5.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return fmt.Errorf("hashing package file: %w", err)
+	}
+
+	name := filepath.Base(filePath)
+	if hex.EncodeToString(hash.Sum(nil)) == remoteMD5 {
+		logrus.Infof("Skipping unchanged %s of package %s/%s", name, project, packageName)
+
+		return nil
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("rewinding package file: %w", err)
+	}
+
+	logrus.Infof("Uploading %s to package %s/%s", name, project, packageName)
+
+	return c.UploadFile(ctx, project, packageName, name, f)
+}
+
+// Finished returns true if results are available and the builds in all
+// repositories are finished.
+func (r *ResultList) Finished() bool {
+	if len(r.Results) == 0 {
+		return false
+	}
+
+	for _, result := range r.Results {
+		if result.Dirty || len(result.Statuses) == 0 {
+			return false
+		}
+
+		for _, status := range result.Statuses {
+			if !slices.Contains(finalBuildStatuses, status.Code) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// url returns the API URL for the path elements, which get escaped.
+func (c *Client) url(query url.Values, elems ...string) string {
+	escaped := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		escaped = append(escaped, url.PathEscape(elem))
+	}
+
+	u := c.apiURL + "/" + path.Join(escaped...)
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	return u
+}
+
+// getXML sends an authenticated GET request and decodes the XML response
+// into v.
+func (c *Client) getXML(ctx context.Context, u string, v any) error {
+	data, err := c.do(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := xml.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+
+	return nil
+}
+
+// do sends an authenticated request and returns the response body.
+func (c *Client) do(ctx context.Context, method, u string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/xml")
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending %s request: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, responseError(resp.StatusCode, data)
+	}
+
+	return data, nil
+}
+
+// responseError creates an error from the status returned by the API.
+func responseError(statusCode int, data []byte) error {
+	status := &apiStatus{}
+	if err := xml.Unmarshal(data, status); err != nil || status.Summary == "" {
+		return fmt.Errorf("unexpected status code %d", statusCode)
+	}
+
+	if status.Code == "" {
+		return errors.New(status.Summary)
+	}
+
+	return fmt.Errorf("%s (%s, status code %d)", status.Summary, status.Code, statusCode)
+}
+
+// checkoutProject creates the local directories of the project packages in
+// the workspace. Sources are not downloaded because the stage replaces them
+// and the release does not need them.
+func checkoutProject(ctx context.Context, client *Client, workspaceDir, project string) error {
+	packages, err := client.ListPackages(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range packages {
+		if err := os.MkdirAll(filepath.Join(workspaceDir, obsRoot, project, pkg), os.ModePerm); err != nil {
+			return fmt.Errorf("creating package directory: %w", err)
+		}
+	}
+
+	return nil
+}
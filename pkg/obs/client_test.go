@@ -0,0 +1,399 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package obs_test
+
+import (
+	"crypto/md5" //nolint:gosec // OBS identifies source files by their MD5 sum
+	"encoding/hex"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/obs"
+)
+
+const (
+	testOBSUser     = "k8s-release-bot"
+	testOBSPassword = "secret"
+	testOBSProject  = "isv:kubernetes:core:stable:v1.36:build"
+)
+
+// fakeOBS is an in-memory OpenBuildService API.
+type fakeOBS struct {
+	mu sync.Mutex
+
+	// sources are the committed files of every "project/package".
+	sources map[string]map[string][]byte
+
+	// uploads are the upload revisions of every "project/package".
+	uploads map[string]map[string][]byte
+
+	// results is the build results XML returned for every package.
+	results string
+
+	requests []string
+	commits  []string
+	releases []string
+}
+
+func newFakeOBS(t *testing.T, packages ...string) (*fakeOBS, *httptest.Server) {
+	t.Helper()
+
+	f := &fakeOBS{
+		sources: map[string]map[string][]byte{},
+		uploads: map[string]map[string][]byte{},
+	}
+
+	for _, pkg := range packages {
+		f.sources[testOBSProject+"/"+pkg] = map[string][]byte{}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /person/{user}", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "<person><login>%s</login><email>bot@k8s.io</email></person>", r.PathValue("user"))
+	})
+	mux.HandleFunc("GET /source/{project}", f.listPackages)
+	mux.HandleFunc("GET /source/{project}/{package}", f.listFiles)
+	mux.HandleFunc("PUT /source/{project}/{package}/{file}", f.uploadFile)
+	mux.HandleFunc("DELETE /source/{project}/{package}/{file}", f.uploadFile)
+	mux.HandleFunc("POST /source/{project}/{package}", f.command)
+	mux.HandleFunc("GET /build/{project}/{repository}/{arch}/{package}/_log", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("view") == "entry" {
+			fmt.Fprintf(w, `<directory><entry name="_log" size="%d"/></directory>`, len(testBuildLog))
+
+			return
+		}
+
+		start, err := strconv.Atoi(r.URL.Query().Get("start"))
+		if err != nil || r.URL.Query().Get("nostream") != "1" {
+			writeStatus(w, http.StatusBadRequest, "invalid_parameter", "invalid start")
+
+			return
+		}
+
+		_, _ = io.WriteString(w, testBuildLog[start:])
+	})
+	mux.HandleFunc("GET /build/{project}/_result", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		_, _ = io.WriteString(w, f.results)
+	})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/build/"+testOBSProject+"/_result" {
+			f.requests[len(f.requests)-1] += "?" + r.URL.RawQuery
+		}
+		f.mu.Unlock()
+
+		if user, password, ok := r.BasicAuth(); !ok || user != testOBSUser || password != testOBSPassword {
+			writeStatus(w, http.StatusUnauthorized, "authentication_required", "Authentication required")
+
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	return f, srv
+}
+
+func writeStatus(w http.ResponseWriter, statusCode int, code, summary string) {
+	w.WriteHeader(statusCode)
+	fmt.Fprintf(w, `<status code=%q><summary>%s</summary></status>`, code, summary)
+}
+
+func (f *fakeOBS) listPackages(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	fmt.Fprint(w, "<directory>")
+
+	for _, key := range slices.Sorted(maps.Keys(f.sources)) {
+		if pkg, ok := strings.CutPrefix(key, r.PathValue("project")+"/"); ok {
+			fmt.Fprintf(w, "<entry name=%q/>", pkg)
+		}
+	}
+
+	fmt.Fprint(w, "</directory>")
+}
+
+func (f *fakeOBS) listFiles(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	files, ok := f.sources[r.PathValue("project")+"/"+r.PathValue("package")]
+	if !ok {
+		writeStatus(w, http.StatusNotFound, "unknown_package", "unknown package")
+
+		return
+	}
+
+	fmt.Fprintf(w, "<directory name=%q>", r.PathValue("package"))
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		//nolint:gosec // OBS identifies source files by their MD5 sum
+		sum := md5.Sum(files[name])
+		fmt.Fprintf(w, "<entry name=%q md5=%q size=\"%d\"/>", name, hex.EncodeToString(sum[:]), len(files[name]))
+	}
+
+	fmt.Fprint(w, "</directory>")
+}
+
+func (f *fakeOBS) uploadFile(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := r.PathValue("project") + "/" + r.PathValue("package")
+	if r.URL.Query().Get("rev") != "upload" {
+		writeStatus(w, http.StatusBadRequest, "invalid_revision", "expected upload revision")
+
+		return
+	}
+
+	if _, ok := f.uploads[key]; !ok {
+		f.uploads[key] = maps.Clone(f.sources[key])
+	}
+
+	if r.Method == http.MethodDelete {
+		delete(f.uploads[key], r.PathValue("file"))
+
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeStatus(w, http.StatusInternalServerError, "read_error", err.Error())
+
+		return
+	}
+
+	f.uploads[key][r.PathValue("file")] = data
+}
+
+func (f *fakeOBS) command(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := r.PathValue("project") + "/" + r.PathValue("package")
+
+	switch r.URL.Query().Get("cmd") {
+	case "commit":
+		if upload, ok := f.uploads[key]; ok {
+			f.sources[key] = upload
+			delete(f.uploads, key)
+		}
+
+		f.commits = append(f.commits, key+": "+r.URL.Query().Get("comment"))
+	case "release":
+		f.releases = append(f.releases, key)
+	default:
+		writeStatus(w, http.StatusBadRequest, "illegal_request", "unknown command")
+	}
+}
+
+const testBuildLog = "[  1s] starting build\n[ 42s] build failed\n"
+
+const testBuildResults = `<resultlist state="abc">
+  <result project="isv:kubernetes:core:stable:v1.36:build" repository="deb" arch="x86_64" code="published" state="published">
+    <status package="kubeadm" code="succeeded"/>
+  </result>
+  <result project="isv:kubernetes:core:stable:v1.36:build" repository="rpm" arch="aarch64" code="published" state="published">
+    <status package="kubeadm" code="%s"/>
+  </result>
+</resultlist>`
+
+func TestClient(t *testing.T) {
+	fake, srv := newFakeOBS(t, "kubeadm", "kubectl")
+	client := obs.NewClient(srv.URL+"/", testOBSUser, testOBSPassword)
+
+	person, err := client.Whoami(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, testOBSUser, person.Login)
+
+	packages, err := client.ListPackages(t.Context(), testOBSProject)
+	require.NoError(t, err)
+	require.Equal(t, []string{"kubeadm", "kubectl"}, packages)
+
+	_, err = client.ListFiles(t.Context(), testOBSProject, "kubelet")
+	require.ErrorContains(t, err, "unknown package (unknown_package, status code 404)")
+
+	_, err = obs.NewClient(srv.URL, testOBSUser, "wrong").Whoami(t.Context())
+	require.ErrorContains(t, err, "Authentication required")
+
+	fake.results = fmt.Sprintf(testBuildResults, obs.BuildStatusFailed)
+	results, err := client.BuildResults(t.Context(), testOBSProject, "kubeadm", "kubectl")
+	require.NoError(t, err)
+	require.Len(t, results.Results, 2)
+	require.Equal(t, obs.PackageStatus{Package: "kubeadm", Code: obs.BuildStatusFailed}, results.Results[1].Statuses[0])
+	require.True(t, results.Finished())
+	require.Contains(t, fake.requests, "GET /build/"+testOBSProject+"/_result?package=kubeadm&package=kubectl")
+
+	logTail, err := client.BuildLogTail(t.Context(), testOBSProject, "rpm", "aarch64", "kubeadm", 13)
+	require.NoError(t, err)
+	require.Equal(t, "build failed\n", logTail)
+
+	logTail, err = client.BuildLogTail(t.Context(), testOBSProject, "rpm", "aarch64", "kubeadm", 1024)
+	require.NoError(t, err)
+	require.Equal(t, testBuildLog, logTail)
+
+	require.NoError(t, client.Release(t.Context(), testOBSProject, "kubeadm"))
+	require.Equal(t, []string{testOBSProject + "/kubeadm"}, fake.releases)
+}
+
+func TestClientPushPackage(t *testing.T) {
+	fake, srv := newFakeOBS(t, "kubeadm")
+	fake.sources[testOBSProject+"/kubeadm"] = map[string][]byte{
+		"kubeadm.spec":                []byte("Version: 1.36.0"),
+		"kubeadm_1.35.0.orig.tar.gz":  []byte("old"),
+		"kubeadm_1.36.0.orig.tar.gz":  []byte("new"),
+		"kubeadm-unchanged.rpmlintrc": []byte("unchanged"),
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kubeadm.spec"), []byte("Version: 1.36.1"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kubeadm_1.36.0.orig.tar.gz"), []byte("new"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kubeadm-unchanged.rpmlintrc"), []byte("unchanged"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden"), []byte("ignored"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "ignored"), 0o755))
+
+	client := obs.NewClient(srv.URL, testOBSUser, testOBSPassword)
+	require.NoError(t, client.PushPackage(t.Context(), testOBSProject, "kubeadm", dir, "1.36.1"))
+
+	require.Equal(t, map[string][]byte{
+		"kubeadm.spec":                []byte("Version: 1.36.1"),
+		"kubeadm_1.36.0.orig.tar.gz":  []byte("new"),
+		"kubeadm-unchanged.rpmlintrc": []byte("unchanged"),
+	}, fake.sources[testOBSProject+"/kubeadm"])
+	require.Equal(t, []string{testOBSProject + "/kubeadm: 1.36.1"}, fake.commits)
+
+	// Only changed files are uploaded
+	require.Contains(t, fake.requests, "PUT /source/"+testOBSProject+"/kubeadm/kubeadm.spec")
+	require.NotContains(t, fake.requests, "PUT /source/"+testOBSProject+"/kubeadm/kubeadm_1.36.0.orig.tar.gz")
+	require.Contains(t, fake.requests, "DELETE /source/"+testOBSProject+"/kubeadm/kubeadm_1.35.0.orig.tar.gz")
+}
+
+func TestResultListFinished(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		results  obs.ResultList
+		finished bool
+	}{
+		{
+			name: "no results",
+		},
+		{
+			name: "dirty repository",
+			results: obs.ResultList{Results: []obs.Result{{
+				Dirty:    true,
+				Statuses: []obs.PackageStatus{{Code: obs.BuildStatusSucceeded}},
+			}}},
+		},
+		{
+			name: "building",
+			results: obs.ResultList{Results: []obs.Result{
+				{Statuses: []obs.PackageStatus{{Code: obs.BuildStatusSucceeded}}},
+				{Statuses: []obs.PackageStatus{{Code: "building"}}},
+			}},
+		},
+		{
+			name: "succeeded",
+			results: obs.ResultList{Results: []obs.Result{
+				{Statuses: []obs.PackageStatus{{Code: obs.BuildStatusSucceeded}}},
+				{Statuses: []obs.PackageStatus{{Code: obs.BuildStatusExcluded}}},
+			}},
+			finished: true,
+		},
+		{
+			name: "failed",
+			results: obs.ResultList{Results: []obs.Result{
+				{Repository: "deb", Arch: "s390x", Statuses: []obs.PackageStatus{{Code: obs.BuildStatusUnresolvable}}},
+				{Statuses: []obs.PackageStatus{{Code: obs.BuildStatusSucceeded}}},
+			}},
+			finished: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.finished, tc.results.Finished())
+		})
+	}
+}
+
+func TestStageAndReleaseWithFakeOBS(t *testing.T) {
+	fake, srv := newFakeOBS(t, "kubeadm", "kubectl")
+	fake.results = fmt.Sprintf(testBuildResults, obs.BuildStatusSucceeded)
+
+	t.Setenv(obs.OBSAPIURLKey, srv.URL)
+	t.Setenv(obs.OBSUsernameKey, "")
+	t.Setenv(obs.OBSPasswordKey, testOBSPassword)
+
+	stageOpts := obs.DefaultStageOptions()
+	stageOpts.Workspace = t.TempDir()
+	stageOpts.NoMock = true
+	stageOpts.Wait = true
+	stageOpts.Packages = []string{"kubeadm"}
+	stageOpts.Project = testOBSProject
+	stageOpts.Version = "1.36.1"
+	stageOpts.WaitSummaryPath = filepath.Join(t.TempDir(), "summary.json")
+
+	stage := obs.NewDefaultStage(stageOpts)
+	stage.SetState(obs.DefaultStageState())
+
+	require.NoError(t, stage.InitOBSRoot())
+	stage.GeneratePackageVersion()
+	require.NoError(t, stage.GenerateOBSProject())
+	require.NoError(t, stage.CheckoutOBSProject())
+
+	pkgDir := filepath.Join(stageOpts.Workspace, "src", "obs", testOBSProject, "kubeadm")
+	require.DirExists(t, filepath.Join(stageOpts.Workspace, "src", "obs", testOBSProject, "kubectl"))
+	require.NoError(t, os.WriteFile(filepath.Join(pkgDir, "kubeadm.spec"), []byte("Version: 1.36.1"), 0o600))
+
+	require.NoError(t, stage.Push())
+	require.NoError(t, stage.Wait())
+	require.FileExists(t, stageOpts.WaitSummaryPath)
+	require.Equal(t, map[string][]byte{"kubeadm.spec": []byte("Version: 1.36.1")}, fake.sources[testOBSProject+"/kubeadm"])
+	require.Equal(t, []string{testOBSProject + "/kubeadm: 1.36.1"}, fake.commits)
+
+	releaseOpts := obs.DefaultReleaseOptions()
+	releaseOpts.Workspace = t.TempDir()
+	releaseOpts.NoMock = true
+	releaseOpts.Packages = []string{"kubeadm", "kubectl"}
+	releaseOpts.Project = testOBSProject
+
+	release := obs.NewDefaultRelease(releaseOpts)
+	release.SetState(obs.DefaultReleaseState())
+
+	require.NoError(t, release.InitOBSRoot())
+	require.NoError(t, release.GenerateOBSProject())
+	require.NoError(t, release.CheckoutOBSProject())
+	require.NoError(t, release.ReleasePackages())
+	require.Equal(t, []string{testOBSProject + "/kubeadm", testOBSProject + "/kubectl"}, fake.releases)
+}
@@ -0,0 +1,360 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadata
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+)
+
+// Constraint is a parsed version constraint, represented as union of version
+// intervals. It supports the syntax of semver.ParseRange without wildcards.
+type Constraint []Interval
+
+// Interval is a range of versions between two bounds.
+type Interval struct {
+	Lower Bound
+	Upper Bound
+}
+
+// Bound is the lower or upper bound of an interval.
+type Bound struct {
+	Version   semver.Version
+	Inclusive bool
+	// Unbounded is true if the interval has no lower or upper bound.
+	Unbounded bool
+}
+
+// unbounded is the interval containing all versions.
+var unbounded = Interval{
+	Lower: Bound{Unbounded: true},
+	Upper: Bound{Unbounded: true},
+}
+
+// constraintOperators are the comparison operators, longest first.
+var constraintOperators = []string{">=", "<=", "!=", "==", ">", "<", "="}
+
+// ParseConstraint parses a version constraint like ">= 1.24.0 < 1.25.0".
+// Comparisons separated by spaces are combined with AND, and "||" combines
+// them with OR.
+func ParseConstraint(constraint string) (Constraint, error) {
+	if _, err := semver.ParseRange(constraint); err != nil {
+		return nil, fmt.Errorf("parsing version constraint %q: %w", constraint, err)
+	}
+
+	result := Constraint{}
+
+	for part := range strings.SplitSeq(constraint, "||") {
+		intervals := Constraint{unbounded}
+		tokens := strings.Fields(part)
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("parsing version constraint %q: empty comparison", constraint)
+		}
+
+		for i := 0; i < len(tokens); i++ {
+			comparison := tokens[i]
+
+			// Operators can be separated from the version by spaces
+			if slices.Contains(constraintOperators, comparison) && i+1 < len(tokens) {
+				i++
+				comparison += tokens[i]
+			}
+
+			c, err := parseComparison(comparison)
+			if err != nil {
+				return nil, fmt.Errorf("parsing version constraint %q: %w", constraint, err)
+			}
+
+			intervals = intervals.Intersect(c)
+		}
+
+		result = append(result, intervals...)
+	}
+
+	return result, nil
+}
+
+// parseComparison parses a single comparison like ">=1.24.0".
+func parseComparison(comparison string) (Constraint, error) {
+	op := ""
+
+	for _, o := range constraintOperators {
+		if strings.HasPrefix(comparison, o) {
+			op = o
+
+			break
+		}
+	}
+
+	v, err := semver.Parse(strings.TrimPrefix(comparison, op))
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case ">=":
+		return Constraint{{Lower: Bound{Version: v, Inclusive: true}, Upper: Bound{Unbounded: true}}}, nil
+	case ">":
+		return Constraint{{Lower: Bound{Version: v}, Upper: Bound{Unbounded: true}}}, nil
+	case "<=":
+		return Constraint{{Lower: Bound{Unbounded: true}, Upper: Bound{Version: v, Inclusive: true}}}, nil
+	case "<":
+		return Constraint{{Lower: Bound{Unbounded: true}, Upper: Bound{Version: v}}}, nil
+	case "!=":
+		return Constraint{
+			{Lower: Bound{Unbounded: true}, Upper: Bound{Version: v}},
+			{Lower: Bound{Version: v}, Upper: Bound{Unbounded: true}},
+		}, nil
+	default:
+		exact := Bound{Version: v, Inclusive: true}
+
+		return Constraint{{Lower: exact, Upper: exact}}, nil
+	}
+}
+
+// Empty returns true if the constraint matches no version.
+func (c Constraint) Empty() bool {
+	return !slices.ContainsFunc(c, func(i Interval) bool { return !i.Empty() })
+}
+
+// Intersect returns the versions matched by both constraints.
+func (c Constraint) Intersect(other Constraint) Constraint {
+	result := Constraint{}
+
+	for _, a := range c {
+		for _, b := range other {
+			if i := a.Intersect(b); !i.Empty() {
+				result = append(result, i)
+			}
+		}
+	}
+
+	return result
+}
+
+// Empty returns true if the interval contains no version. Because there is
+// always a pre-release version between two versions, only intervals with the
+// lower bound above the upper bound and intervals of a single, excluded
+// version are empty.
+func (i Interval) Empty() bool {
+	if i.Lower.Unbounded || i.Upper.Unbounded {
+		return false
+	}
+
+	switch i.Lower.Version.Compare(i.Upper.Version) {
+	case -1:
+		return false
+	case 0:
+		return !i.Lower.Inclusive || !i.Upper.Inclusive
+	default:
+		return true
+	}
+}
+
+// Intersect returns the interval of versions contained in both intervals.
+func (i Interval) Intersect(other Interval) Interval {
+	return Interval{
+		Lower: maxLower(i.Lower, other.Lower),
+		Upper: minUpper(i.Upper, other.Upper),
+	}
+}
+
+// String returns the interval in the constraint syntax.
+func (i Interval) String() string {
+	parts := []string{}
+
+	if !i.Lower.Unbounded && !i.Upper.Unbounded && i.Lower.Version.Equals(i.Upper.Version) {
+		return i.Lower.Version.String()
+	}
+
+	if !i.Lower.Unbounded {
+		op := ">"
+		if i.Lower.Inclusive {
+			op = ">="
+		}
+
+		parts = append(parts, op+" "+i.Lower.Version.String())
+	}
+
+	if !i.Upper.Unbounded {
+		op := "<"
+		if i.Upper.Inclusive {
+			op = "<="
+		}
+
+		parts = append(parts, op+" "+i.Upper.Version.String())
+	}
+
+	if len(parts) == 0 {
+		return "*"
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// maxLower returns the more restrictive of two lower bounds.
+func maxLower(a, b Bound) Bound {
+	switch {
+	case a.Unbounded:
+		return b
+	case b.Unbounded:
+		return a
+	}
+
+	switch a.Version.Compare(b.Version) {
+	case 1:
+		return a
+	case -1:
+		return b
+	default:
+		return Bound{Version: a.Version, Inclusive: a.Inclusive && b.Inclusive}
+	}
+}
+
+// minUpper returns the more restrictive of two upper bounds.
+func minUpper(a, b Bound) Bound {
+	switch {
+	case a.Unbounded:
+		return b
+	case b.Unbounded:
+		return a
+	}
+
+	switch a.Version.Compare(b.Version) {
+	case -1:
+		return a
+	case 1:
+		return b
+	default:
+		return Bound{Version: a.Version, Inclusive: a.Inclusive && b.Inclusive}
+	}
+}
+
+// compareLower orders lower bounds, unbounded and inclusive bounds first.
+func compareLower(a, b Bound) int {
+	switch {
+	case a.Unbounded && b.Unbounded:
+		return 0
+	case a.Unbounded:
+		return -1
+	case b.Unbounded:
+		return 1
+	}
+
+	if c := a.Version.Compare(b.Version); c != 0 {
+		return c
+	}
+
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compareUpper orders upper bounds, exclusive bounds first and unbounded
+// bounds last.
+func compareUpper(a, b Bound) int {
+	switch {
+	case a.Unbounded && b.Unbounded:
+		return 0
+	case a.Unbounded:
+		return 1
+	case b.Unbounded:
+		return -1
+	}
+
+	if c := a.Version.Compare(b.Version); c != 0 {
+		return c
+	}
+
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// firstReleaseAbove returns the lowest release version, which is a version
+// without pre-release, above the upper bound.
+func firstReleaseAbove(upper Bound) semver.Version {
+	v := semver.Version{
+		Major: upper.Version.Major,
+		Minor: upper.Version.Minor,
+		Patch: upper.Version.Patch,
+	}
+
+	// The release of a pre-release version is always above it
+	if len(upper.Version.Pre) > 0 || !upper.Inclusive {
+		return v
+	}
+
+	v.Patch++
+
+	return v
+}
+
+// ReleaseGaps returns the first release version of every gap between the
+// intervals of the constraints, including the release versions above all
+// constraints. Gaps which only contain pre-release versions are ignored,
+// because not every pre-release is packaged.
+func ReleaseGaps(constraints ...Constraint) []semver.Version {
+	intervals := []Interval{}
+	for _, c := range constraints {
+		intervals = append(intervals, c...)
+	}
+
+	if len(intervals) == 0 {
+		return nil
+	}
+
+	slices.SortFunc(intervals, func(a, b Interval) int {
+		return compareLower(a.Lower, b.Lower)
+	})
+
+	gaps := []semver.Version{}
+	upper := intervals[0].Upper
+
+	for _, next := range intervals[1:] {
+		if !upper.Unbounded && !next.Lower.Unbounded {
+			release := firstReleaseAbove(upper)
+			if c := release.Compare(next.Lower.Version); c < 0 || (c == 0 && !next.Lower.Inclusive) {
+				gaps = append(gaps, release)
+			}
+		}
+
+		if compareUpper(next.Upper, upper) > 0 {
+			upper = next.Upper
+		}
+	}
+
+	if !upper.Unbounded {
+		gaps = append(gaps, firstReleaseAbove(upper))
+	}
+
+	return gaps
+}
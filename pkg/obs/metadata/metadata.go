@@ -20,16 +20,27 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 
 	"github.com/sirupsen/logrus"
 
 	"sigs.k8s.io/yaml"
 )
 
+const (
+	// FormatRPM selects the RPM spec templates of a package. Debian packages
+	// get built from the spec file via debbuild if FormatDeb is not selected.
+	FormatRPM = "rpm"
+
+	// FormatDeb selects the Debian source package templates of a package.
+	FormatDeb = "deb"
+)
+
 // PackageMetadata is a struct that contains the following information about a package:
 // - URL from which to download artifacts needed to build the package
 // - Indicator if artifacts are packed in a .tar.gz archive
 // - Dependencies needed to install the package
+// - Package formats whose templates are rendered
 // Package's metadata is versioned based on the given version constraint.
 type PackageMetadata struct {
 	// VersionConstraint is a semver range that defines the version of the package for which the metadata is valid.
@@ -40,6 +51,19 @@ type PackageMetadata struct {
 	SourceTarGz bool `json:"sourceTarGz"`
 	// Dependencies is a list of dependencies needed to install the package.
 	Dependencies []PackageDependency `json:"dependencies,omitempty"`
+	// Formats is a list of package formats whose templates are rendered,
+	// which can be "rpm" and "deb". Defaults to "rpm" if empty.
+	Formats []string `json:"formats,omitempty"`
+}
+
+// HasFormat returns true if the templates of the package format should be
+// rendered.
+func (m *PackageMetadata) HasFormat(format string) bool {
+	if len(m.Formats) == 0 {
+		return format == FormatRPM
+	}
+
+	return slices.Contains(m.Formats, format)
 }
 
 // PackageDependency is a struct that defines a single runtime dependency.
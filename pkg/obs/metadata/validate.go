@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadata
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Validate checks the metadata of all packages and returns every issue found:
+// - version constraints which can't be parsed or match no version
+// - overlapping version constraints of a package
+// - release versions which are not covered by any version constraint of a package
+// - dependencies on packages which are not defined
+// - dependency version constraints which match no version of the dependency
+// - dependency cycles
+// The source URL templates are validated by specs.ValidateSourceURLTemplate.
+func (l PackageMetadataList) Validate() []error {
+	errs := []error{}
+	coverage := map[string]Constraint{}
+
+	for _, name := range slices.Sorted(maps.Keys(l)) {
+		constraints, pkgErrs := l.validateVersionConstraints(name)
+		errs = append(errs, pkgErrs...)
+
+		coverage[name] = slices.Concat(constraints...)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(l)) {
+		errs = append(errs, l.validateDependencies(name, coverage)...)
+	}
+
+	return append(errs, l.validateDependencyCycles()...)
+}
+
+// validateVersionConstraints checks the version constraints of the package
+// for overlaps and gaps. It returns the parsed constraints, skipping the
+// invalid ones.
+func (l PackageMetadataList) validateVersionConstraints(name string) (constraints []Constraint, errs []error) {
+	entries := l[name]
+	if len(entries) == 0 {
+		return nil, []error{fmt.Errorf("package %s: no metadata defined", name)}
+	}
+
+	valid := []string{}
+
+	for _, m := range entries {
+		c, err := ParseConstraint(m.VersionConstraint)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("package %s: %w", name, err))
+
+			continue
+		}
+
+		if c.Empty() {
+			errs = append(errs, fmt.Errorf("package %s: version constraint %q matches no version", name, m.VersionConstraint))
+
+			continue
+		}
+
+		for i, other := range constraints {
+			if overlap := c.Intersect(other); len(overlap) > 0 {
+				errs = append(errs, fmt.Errorf(
+					"package %s: version constraints %q and %q overlap at %q",
+					name, valid[i], m.VersionConstraint, overlap[0].String(),
+				))
+			}
+		}
+
+		constraints = append(constraints, c)
+		valid = append(valid, m.VersionConstraint)
+	}
+
+	for _, gap := range ReleaseGaps(constraints...) {
+		errs = append(errs, fmt.Errorf("package %s: version %s is not covered by any version constraint", name, gap))
+	}
+
+	return constraints, errs
+}
+
+// validateDependencies checks that all dependencies of the package are
+// defined and that their version constraints match at least one version
+// covered by the metadata of the dependency.
+func (l PackageMetadataList) validateDependencies(name string, coverage map[string]Constraint) []error {
+	errs := []error{}
+
+	for _, m := range l[name] {
+		for _, dep := range m.Dependencies {
+			if _, ok := l[dep.Name]; !ok {
+				errs = append(errs, fmt.Errorf(
+					"package %s (%s): dependency %s is not defined", name, m.VersionConstraint, dep.Name,
+				))
+
+				continue
+			}
+
+			// A dependency without version constraint matches any version
+			if strings.TrimSpace(dep.VersionConstraint) == "" {
+				continue
+			}
+
+			c, err := ParseConstraint(dep.VersionConstraint)
+			if err != nil {
+				errs = append(errs, fmt.Errorf(
+					"package %s (%s): dependency %s: %w", name, m.VersionConstraint, dep.Name, err,
+				))
+
+				continue
+			}
+
+			if c.Intersect(coverage[dep.Name]).Empty() {
+				errs = append(errs, fmt.Errorf(
+					"package %s (%s): dependency %s %q matches no version defined in the metadata of %s",
+					name, m.VersionConstraint, dep.Name, dep.VersionConstraint, dep.Name,
+				))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateDependencyCycles returns an error for every dependency cycle,
+// regardless of the package versions the dependencies are defined for.
+func (l PackageMetadataList) validateDependencyCycles() []error {
+	graph := map[string][]string{}
+
+	for name, entries := range l {
+		deps := map[string]bool{}
+
+		for _, m := range entries {
+			for _, dep := range m.Dependencies {
+				if _, ok := l[dep.Name]; ok {
+					deps[dep.Name] = true
+				}
+			}
+		}
+
+		graph[name] = slices.Sorted(maps.Keys(deps))
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+	path := []string{}
+	errs := []error{}
+
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		path = append(path, name)
+
+		for _, dep := range graph[name] {
+			switch state[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				cycle := append(slices.Clone(path[slices.Index(path, dep):]), dep)
+				errs = append(errs, fmt.Errorf("dependency cycle: %s", strings.Join(cycle, " -> ")))
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[name] = visited
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(graph)) {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+
+	return errs
+}
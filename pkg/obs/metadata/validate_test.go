@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadata_test
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/obs/metadata"
+)
+
+func TestParseConstraint(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		constraint string
+		matches    []string
+		misses     []string
+		empty      bool
+		shouldErr  bool
+	}{
+		{
+			name:       "range with spaces after operators",
+			constraint: ">= 1.24.2 < 1.24.5",
+			matches:    []string{"1.24.2", "1.24.4", "1.24.5-rc.0"},
+			misses:     []string{"1.24.1", "1.24.5"},
+		},
+		{
+			name:       "exact version",
+			constraint: "1.25.0",
+			matches:    []string{"1.25.0"},
+			misses:     []string{"1.25.0-rc.1", "1.25.1"},
+		},
+		{
+			name:       "alternatives",
+			constraint: "<1.0.0 || >=2.0.0 !=2.1.0",
+			matches:    []string{"0.9.0", "2.0.0", "2.2.0"},
+			misses:     []string{"1.0.0", "2.1.0"},
+		},
+		{
+			name:       "empty range",
+			constraint: ">= 2.0.0 < 1.0.0",
+			empty:      true,
+		},
+		{
+			name:       "excluded single version",
+			constraint: ">= 1.0.0 < 1.0.0",
+			empty:      true,
+		},
+		{
+			name:       "invalid constraint",
+			constraint: "not-a-constraint",
+			shouldErr:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := metadata.ParseConstraint(tc.constraint)
+			if tc.shouldErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.empty, c.Empty())
+
+			for _, v := range tc.matches {
+				exact, err := metadata.ParseConstraint(v)
+				require.NoError(t, err)
+				require.False(t, c.Intersect(exact).Empty(), "expected %s to match", v)
+			}
+
+			for _, v := range tc.misses {
+				exact, err := metadata.ParseConstraint(v)
+				require.NoError(t, err)
+				require.True(t, c.Intersect(exact).Empty(), "expected %s to not match", v)
+			}
+		})
+	}
+}
+
+func TestReleaseGaps(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		constraints []string
+		expected    []semver.Version
+	}{
+		{
+			name:        "adjacent ranges and exact version",
+			constraints: []string{">= 1.25.1 < 1.28.0", "1.25.0", ">= 1.24.0 < 1.25.0", ">= 1.28.0"},
+			expected:    []semver.Version{},
+		},
+		{
+			name:        "gap between inclusive bounds",
+			constraints: []string{">= 1.0.0 <= 1.2.0", ">= 1.2.2"},
+			expected:    []semver.Version{semver.MustParse("1.2.1")},
+		},
+		{
+			name:        "excluded version",
+			constraints: []string{"< 1.2.0", "> 1.2.0"},
+			expected:    []semver.Version{semver.MustParse("1.2.0")},
+		},
+		{
+			name:        "pre-release gap is ignored",
+			constraints: []string{"< 1.2.0-alpha.1", ">= 1.2.0-beta.0"},
+			expected:    []semver.Version{},
+		},
+		{
+			name:        "upper bound",
+			constraints: []string{">= 1.0.0 < 1.2.0"},
+			expected:    []semver.Version{semver.MustParse("1.2.0")},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			constraints := []metadata.Constraint{}
+
+			for _, s := range tc.constraints {
+				c, err := metadata.ParseConstraint(s)
+				require.NoError(t, err)
+
+				constraints = append(constraints, c)
+			}
+
+			require.Equal(t, tc.expected, metadata.ReleaseGaps(constraints...))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		list     metadata.PackageMetadataList
+		expected []string
+	}{
+		{
+			name: "valid metadata",
+			list: metadata.PackageMetadataList{
+				"kubelet": {
+					{
+						VersionConstraint: ">= 1.24.0 < 1.28.0",
+						Dependencies:      []metadata.PackageDependency{{Name: "kubernetes-cni", VersionConstraint: ">= 1.1.1"}},
+					},
+					{
+						VersionConstraint: ">= 1.28.0",
+						Dependencies:      []metadata.PackageDependency{{Name: "kubernetes-cni"}},
+					},
+				},
+				"kubernetes-cni": {{VersionConstraint: ">= 0.8.7"}},
+			},
+			expected: []string{},
+		},
+		{
+			name: "invalid and empty constraints",
+			list: metadata.PackageMetadataList{
+				"kubectl": {
+					{VersionConstraint: "invalid"},
+					{VersionConstraint: ">= 1.2.0 < 1.1.0"},
+					{VersionConstraint: ">= 1.0.0"},
+				},
+				"kubeadm": {},
+			},
+			expected: []string{
+				`package kubeadm: no metadata defined`,
+				`package kubectl: parsing version constraint "invalid": Could not get version from string: "invalid"`,
+				`package kubectl: version constraint ">= 1.2.0 < 1.1.0" matches no version`,
+			},
+		},
+		{
+			name: "overlaps and gaps",
+			list: metadata.PackageMetadataList{
+				"kubectl": {
+					{VersionConstraint: ">= 1.0.0 < 1.2.0"},
+					{VersionConstraint: ">= 1.1.0 < 1.3.0"},
+					{VersionConstraint: ">= 1.4.0 < 2.0.0"},
+				},
+			},
+			expected: []string{
+				`package kubectl: version constraints ">= 1.0.0 < 1.2.0" and ">= 1.1.0 < 1.3.0" overlap at ">= 1.1.0 < 1.2.0"`,
+				`package kubectl: version 1.3.0 is not covered by any version constraint`,
+				`package kubectl: version 2.0.0 is not covered by any version constraint`,
+			},
+		},
+		{
+			name: "dependencies",
+			list: metadata.PackageMetadataList{
+				"kubeadm": {{
+					VersionConstraint: ">= 1.0.0",
+					Dependencies: []metadata.PackageDependency{
+						{Name: "kubelet", VersionConstraint: "< 1.0.0"},
+						{Name: "cri-tools", VersionConstraint: ">= 1.0.0"},
+						{Name: "kubectl", VersionConstraint: "invalid"},
+					},
+				}},
+				"kubelet": {{VersionConstraint: ">= 1.0.0"}},
+				"kubectl": {{VersionConstraint: ">= 1.0.0"}},
+			},
+			expected: []string{
+				`package kubeadm (>= 1.0.0): dependency kubelet "< 1.0.0" matches no version defined in the metadata of kubelet`,
+				`package kubeadm (>= 1.0.0): dependency cri-tools is not defined`,
+				`package kubeadm (>= 1.0.0): dependency kubectl: parsing version constraint "invalid": Could not get version from string: "invalid"`,
+			},
+		},
+		{
+			name: "dependency cycles",
+			list: metadata.PackageMetadataList{
+				"a": {{VersionConstraint: ">= 1.0.0", Dependencies: []metadata.PackageDependency{{Name: "b"}}}},
+				"b": {
+					{VersionConstraint: "< 1.0.0", Dependencies: []metadata.PackageDependency{{Name: "c"}}},
+					{VersionConstraint: ">= 1.0.0", Dependencies: []metadata.PackageDependency{{Name: "a"}}},
+				},
+				"c": {{VersionConstraint: ">= 1.0.0", Dependencies: []metadata.PackageDependency{{Name: "c"}}}},
+			},
+			expected: []string{
+				`dependency cycle: a -> b -> a`,
+				`dependency cycle: c -> c`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := tc.list.Validate()
+
+			messages := []string{}
+			for _, err := range errs {
+				messages = append(messages, err.Error())
+			}
+
+			require.Equal(t, tc.expected, messages)
+		})
+	}
+}
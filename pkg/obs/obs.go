@@ -78,6 +78,10 @@ const (
 	// OBSUsernameKey is name of the environment variable containing the
 	// username for the OBS account. If empty, obsK8sUsername will be used.
 	OBSUsernameKey = "OBS_USERNAME"
+
+	// OBSAPIURLKey is name of the environment variable containing the URL
+	// of the OBS API. If empty, obsAPIURL will be used.
+	OBSAPIURLKey = "OBS_API_URL"
 )
 
 // Options are settings which will be used by `StageOptions` as well as
@@ -144,6 +148,18 @@ type Options struct {
 
 	// Wait can be used to wait for the OBS build results.
 	Wait bool
+
+	// WaitInterval is the interval between two build results requests when
+	// waiting for the OBS build results.
+	WaitInterval time.Duration
+
+	// WaitTimeout is the maximum duration to wait for the OBS build results.
+	// There is no limit if zero.
+	WaitTimeout time.Duration
+
+	// WaitSummaryPath is the path of the JSON build report written after
+	// waiting for the OBS build results. No report is written if empty.
+	WaitSummaryPath string
 }
 
 // DefaultOptions returns a new `Options` instance.
@@ -162,6 +178,8 @@ func DefaultOptions() *Options {
 		},
 		SpecTemplatePath: defaultSpecTemplatePath,
 		Workspace:        defaultWorkspaceDir,
+		WaitInterval:     defaultWaitInterval,
+		WaitTimeout:      defaultWaitTimeout,
 	}
 }
 
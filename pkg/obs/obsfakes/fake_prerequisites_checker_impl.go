@@ -21,20 +21,10 @@ import (
 	"sync"
 
 	"github.com/shirou/gopsutil/v3/disk"
+	"k8s.io/release/pkg/obs"
 )
 
 type FakePrerequisitesCheckerImpl struct {
-	CommandAvailableStub        func(...string) bool
-	commandAvailableMutex       sync.RWMutex
-	commandAvailableArgsForCall []struct {
-		arg1 []string
-	}
-	commandAvailableReturns struct {
-		result1 bool
-	}
-	commandAvailableReturnsOnCall map[int]struct {
-		result1 bool
-	}
 	IsEnvSetStub        func(string) bool
 	isEnvSetMutex       sync.RWMutex
 	isEnvSetArgsForCall []struct {
@@ -46,19 +36,6 @@ type FakePrerequisitesCheckerImpl struct {
 	isEnvSetReturnsOnCall map[int]struct {
 		result1 bool
 	}
-	OSCOutputStub        func(...string) (string, error)
-	oSCOutputMutex       sync.RWMutex
-	oSCOutputArgsForCall []struct {
-		arg1 []string
-	}
-	oSCOutputReturns struct {
-		result1 string
-		result2 error
-	}
-	oSCOutputReturnsOnCall map[int]struct {
-		result1 string
-		result2 error
-	}
 	UsageStub        func(string) (*disk.UsageStat, error)
 	usageMutex       sync.RWMutex
 	usageArgsForCall []struct {
@@ -72,69 +49,20 @@ type FakePrerequisitesCheckerImpl struct {
 		result1 *disk.UsageStat
 		result2 error
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
-}
-
-func (fake *FakePrerequisitesCheckerImpl) CommandAvailable(arg1 ...string) bool {
-	fake.commandAvailableMutex.Lock()
-	ret, specificReturn := fake.commandAvailableReturnsOnCall[len(fake.commandAvailableArgsForCall)]
-	fake.commandAvailableArgsForCall = append(fake.commandAvailableArgsForCall, struct {
-		arg1 []string
-	}{arg1})
-	stub := fake.CommandAvailableStub
-	fakeReturns := fake.commandAvailableReturns
-	fake.recordInvocation("CommandAvailable", []interface{}{arg1})
-	fake.commandAvailableMutex.Unlock()
-	if stub != nil {
-		return stub(arg1...)
+	WhoamiStub        func() (*obs.Person, error)
+	whoamiMutex       sync.RWMutex
+	whoamiArgsForCall []struct {
 	}
-	if specificReturn {
-		return ret.result1
+	whoamiReturns struct {
+		result1 *obs.Person
+		result2 error
 	}
-	return fakeReturns.result1
-}
-
-func (fake *FakePrerequisitesCheckerImpl) CommandAvailableCallCount() int {
-	fake.commandAvailableMutex.RLock()
-	defer fake.commandAvailableMutex.RUnlock()
-	return len(fake.commandAvailableArgsForCall)
-}
-
-func (fake *FakePrerequisitesCheckerImpl) CommandAvailableCalls(stub func(...string) bool) {
-	fake.commandAvailableMutex.Lock()
-	defer fake.commandAvailableMutex.Unlock()
-	fake.CommandAvailableStub = stub
-}
-
-func (fake *FakePrerequisitesCheckerImpl) CommandAvailableArgsForCall(i int) []string {
-	fake.commandAvailableMutex.RLock()
-	defer fake.commandAvailableMutex.RUnlock()
-	argsForCall := fake.commandAvailableArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *FakePrerequisitesCheckerImpl) CommandAvailableReturns(result1 bool) {
-	fake.commandAvailableMutex.Lock()
-	defer fake.commandAvailableMutex.Unlock()
-	fake.CommandAvailableStub = nil
-	fake.commandAvailableReturns = struct {
-		result1 bool
-	}{result1}
-}
-
-func (fake *FakePrerequisitesCheckerImpl) CommandAvailableReturnsOnCall(i int, result1 bool) {
-	fake.commandAvailableMutex.Lock()
-	defer fake.commandAvailableMutex.Unlock()
-	fake.CommandAvailableStub = nil
-	if fake.commandAvailableReturnsOnCall == nil {
-		fake.commandAvailableReturnsOnCall = make(map[int]struct {
-			result1 bool
-		})
+	whoamiReturnsOnCall map[int]struct {
+		result1 *obs.Person
+		result2 error
 	}
-	fake.commandAvailableReturnsOnCall[i] = struct {
-		result1 bool
-	}{result1}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
 }
 
 func (fake *FakePrerequisitesCheckerImpl) IsEnvSet(arg1 string) bool {
@@ -198,70 +126,6 @@ func (fake *FakePrerequisitesCheckerImpl) IsEnvSetReturnsOnCall(i int, result1 b
 	}{result1}
 }
 
-func (fake *FakePrerequisitesCheckerImpl) OSCOutput(arg1 ...string) (string, error) {
-	fake.oSCOutputMutex.Lock()
-	ret, specificReturn := fake.oSCOutputReturnsOnCall[len(fake.oSCOutputArgsForCall)]
-	fake.oSCOutputArgsForCall = append(fake.oSCOutputArgsForCall, struct {
-		arg1 []string
-	}{arg1})
-	stub := fake.OSCOutputStub
-	fakeReturns := fake.oSCOutputReturns
-	fake.recordInvocation("OSCOutput", []interface{}{arg1})
-	fake.oSCOutputMutex.Unlock()
-	if stub != nil {
-		return stub(arg1...)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2
-	}
-	return fakeReturns.result1, fakeReturns.result2
-}
-
-func (fake *FakePrerequisitesCheckerImpl) OSCOutputCallCount() int {
-	fake.oSCOutputMutex.RLock()
-	defer fake.oSCOutputMutex.RUnlock()
-	return len(fake.oSCOutputArgsForCall)
-}
-
-func (fake *FakePrerequisitesCheckerImpl) OSCOutputCalls(stub func(...string) (string, error)) {
-	fake.oSCOutputMutex.Lock()
-	defer fake.oSCOutputMutex.Unlock()
-	fake.OSCOutputStub = stub
-}
-
-func (fake *FakePrerequisitesCheckerImpl) OSCOutputArgsForCall(i int) []string {
-	fake.oSCOutputMutex.RLock()
-	defer fake.oSCOutputMutex.RUnlock()
-	argsForCall := fake.oSCOutputArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *FakePrerequisitesCheckerImpl) OSCOutputReturns(result1 string, result2 error) {
-	fake.oSCOutputMutex.Lock()
-	defer fake.oSCOutputMutex.Unlock()
-	fake.OSCOutputStub = nil
-	fake.oSCOutputReturns = struct {
-		result1 string
-		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakePrerequisitesCheckerImpl) OSCOutputReturnsOnCall(i int, result1 string, result2 error) {
-	fake.oSCOutputMutex.Lock()
-	defer fake.oSCOutputMutex.Unlock()
-	fake.OSCOutputStub = nil
-	if fake.oSCOutputReturnsOnCall == nil {
-		fake.oSCOutputReturnsOnCall = make(map[int]struct {
-			result1 string
-			result2 error
-		})
-	}
-	fake.oSCOutputReturnsOnCall[i] = struct {
-		result1 string
-		result2 error
-	}{result1, result2}
-}
-
 func (fake *FakePrerequisitesCheckerImpl) Usage(arg1 string) (*disk.UsageStat, error) {
 	fake.usageMutex.Lock()
 	ret, specificReturn := fake.usageReturnsOnCall[len(fake.usageArgsForCall)]
@@ -326,6 +190,62 @@ func (fake *FakePrerequisitesCheckerImpl) UsageReturnsOnCall(i int, result1 *dis
 	}{result1, result2}
 }
 
+func (fake *FakePrerequisitesCheckerImpl) Whoami() (*obs.Person, error) {
+	fake.whoamiMutex.Lock()
+	ret, specificReturn := fake.whoamiReturnsOnCall[len(fake.whoamiArgsForCall)]
+	fake.whoamiArgsForCall = append(fake.whoamiArgsForCall, struct {
+	}{})
+	stub := fake.WhoamiStub
+	fakeReturns := fake.whoamiReturns
+	fake.recordInvocation("Whoami", []interface{}{})
+	fake.whoamiMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePrerequisitesCheckerImpl) WhoamiCallCount() int {
+	fake.whoamiMutex.RLock()
+	defer fake.whoamiMutex.RUnlock()
+	return len(fake.whoamiArgsForCall)
+}
+
+func (fake *FakePrerequisitesCheckerImpl) WhoamiCalls(stub func() (*obs.Person, error)) {
+	fake.whoamiMutex.Lock()
+	defer fake.whoamiMutex.Unlock()
+	fake.WhoamiStub = stub
+}
+
+func (fake *FakePrerequisitesCheckerImpl) WhoamiReturns(result1 *obs.Person, result2 error) {
+	fake.whoamiMutex.Lock()
+	defer fake.whoamiMutex.Unlock()
+	fake.WhoamiStub = nil
+	fake.whoamiReturns = struct {
+		result1 *obs.Person
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePrerequisitesCheckerImpl) WhoamiReturnsOnCall(i int, result1 *obs.Person, result2 error) {
+	fake.whoamiMutex.Lock()
+	defer fake.whoamiMutex.Unlock()
+	fake.WhoamiStub = nil
+	if fake.whoamiReturnsOnCall == nil {
+		fake.whoamiReturnsOnCall = make(map[int]struct {
+			result1 *obs.Person
+			result2 error
+		})
+	}
+	fake.whoamiReturnsOnCall[i] = struct {
+		result1 *obs.Person
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePrerequisitesCheckerImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
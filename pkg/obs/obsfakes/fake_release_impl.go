@@ -22,6 +22,7 @@ import (
 
 	semver "github.com/blang/semver/v4"
 	"k8s.io/release/pkg/gcp/gcb"
+	"k8s.io/release/pkg/obs"
 	"k8s.io/release/pkg/release"
 )
 
@@ -64,18 +65,6 @@ type FakeReleaseImpl struct {
 	checkoutProjectReturnsOnCall map[int]struct {
 		result1 error
 	}
-	CreateOBSConfigFileStub        func(string, string) error
-	createOBSConfigFileMutex       sync.RWMutex
-	createOBSConfigFileArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	createOBSConfigFileReturns struct {
-		result1 error
-	}
-	createOBSConfigFileReturnsOnCall map[int]struct {
-		result1 error
-	}
 	GenerateReleaseVersionStub        func(string, string, string, bool) (*release.Versions, error)
 	generateReleaseVersionMutex       sync.RWMutex
 	generateReleaseVersionArgsForCall []struct {
@@ -103,12 +92,11 @@ type FakeReleaseImpl struct {
 	mkdirAllReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ReleasePackageStub        func(string, string, string) error
+	ReleasePackageStub        func(string, string) error
 	releasePackageMutex       sync.RWMutex
 	releasePackageArgsForCall []struct {
 		arg1 string
 		arg2 string
-		arg3 string
 	}
 	releasePackageReturns struct {
 		result1 error
@@ -116,6 +104,11 @@ type FakeReleaseImpl struct {
 	releasePackageReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetOBSClientStub        func(*obs.Client)
+	setOBSClientMutex       sync.RWMutex
+	setOBSClientArgsForCall []struct {
+		arg1 *obs.Client
+	}
 	SubmitStub        func(*gcb.Options) error
 	submitMutex       sync.RWMutex
 	submitArgsForCall []struct {
@@ -320,68 +313,6 @@ func (fake *FakeReleaseImpl) CheckoutProjectReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
-func (fake *FakeReleaseImpl) CreateOBSConfigFile(arg1 string, arg2 string) error {
-	fake.createOBSConfigFileMutex.Lock()
-	ret, specificReturn := fake.createOBSConfigFileReturnsOnCall[len(fake.createOBSConfigFileArgsForCall)]
-	fake.createOBSConfigFileArgsForCall = append(fake.createOBSConfigFileArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.CreateOBSConfigFileStub
-	fakeReturns := fake.createOBSConfigFileReturns
-	fake.recordInvocation("CreateOBSConfigFile", []interface{}{arg1, arg2})
-	fake.createOBSConfigFileMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1
-	}
-	return fakeReturns.result1
-}
-
-func (fake *FakeReleaseImpl) CreateOBSConfigFileCallCount() int {
-	fake.createOBSConfigFileMutex.RLock()
-	defer fake.createOBSConfigFileMutex.RUnlock()
-	return len(fake.createOBSConfigFileArgsForCall)
-}
-
-func (fake *FakeReleaseImpl) CreateOBSConfigFileCalls(stub func(string, string) error) {
-	fake.createOBSConfigFileMutex.Lock()
-	defer fake.createOBSConfigFileMutex.Unlock()
-	fake.CreateOBSConfigFileStub = stub
-}
-
-func (fake *FakeReleaseImpl) CreateOBSConfigFileArgsForCall(i int) (string, string) {
-	fake.createOBSConfigFileMutex.RLock()
-	defer fake.createOBSConfigFileMutex.RUnlock()
-	argsForCall := fake.createOBSConfigFileArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeReleaseImpl) CreateOBSConfigFileReturns(result1 error) {
-	fake.createOBSConfigFileMutex.Lock()
-	defer fake.createOBSConfigFileMutex.Unlock()
-	fake.CreateOBSConfigFileStub = nil
-	fake.createOBSConfigFileReturns = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakeReleaseImpl) CreateOBSConfigFileReturnsOnCall(i int, result1 error) {
-	fake.createOBSConfigFileMutex.Lock()
-	defer fake.createOBSConfigFileMutex.Unlock()
-	fake.CreateOBSConfigFileStub = nil
-	if fake.createOBSConfigFileReturnsOnCall == nil {
-		fake.createOBSConfigFileReturnsOnCall = make(map[int]struct {
-			result1 error
-		})
-	}
-	fake.createOBSConfigFileReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
-}
-
 func (fake *FakeReleaseImpl) GenerateReleaseVersion(arg1 string, arg2 string, arg3 string, arg4 bool) (*release.Versions, error) {
 	fake.generateReleaseVersionMutex.Lock()
 	ret, specificReturn := fake.generateReleaseVersionReturnsOnCall[len(fake.generateReleaseVersionArgsForCall)]
@@ -510,20 +441,19 @@ func (fake *FakeReleaseImpl) MkdirAllReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeReleaseImpl) ReleasePackage(arg1 string, arg2 string, arg3 string) error {
+func (fake *FakeReleaseImpl) ReleasePackage(arg1 string, arg2 string) error {
 	fake.releasePackageMutex.Lock()
 	ret, specificReturn := fake.releasePackageReturnsOnCall[len(fake.releasePackageArgsForCall)]
 	fake.releasePackageArgsForCall = append(fake.releasePackageArgsForCall, struct {
 		arg1 string
 		arg2 string
-		arg3 string
-	}{arg1, arg2, arg3})
+	}{arg1, arg2})
 	stub := fake.ReleasePackageStub
 	fakeReturns := fake.releasePackageReturns
-	fake.recordInvocation("ReleasePackage", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("ReleasePackage", []interface{}{arg1, arg2})
 	fake.releasePackageMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -537,17 +467,17 @@ func (fake *FakeReleaseImpl) ReleasePackageCallCount() int {
 	return len(fake.releasePackageArgsForCall)
 }
 
-func (fake *FakeReleaseImpl) ReleasePackageCalls(stub func(string, string, string) error) {
+func (fake *FakeReleaseImpl) ReleasePackageCalls(stub func(string, string) error) {
 	fake.releasePackageMutex.Lock()
 	defer fake.releasePackageMutex.Unlock()
 	fake.ReleasePackageStub = stub
 }
 
-func (fake *FakeReleaseImpl) ReleasePackageArgsForCall(i int) (string, string, string) {
+func (fake *FakeReleaseImpl) ReleasePackageArgsForCall(i int) (string, string) {
 	fake.releasePackageMutex.RLock()
 	defer fake.releasePackageMutex.RUnlock()
 	argsForCall := fake.releasePackageArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeReleaseImpl) ReleasePackageReturns(result1 error) {
@@ -573,6 +503,38 @@ func (fake *FakeReleaseImpl) ReleasePackageReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeReleaseImpl) SetOBSClient(arg1 *obs.Client) {
+	fake.setOBSClientMutex.Lock()
+	fake.setOBSClientArgsForCall = append(fake.setOBSClientArgsForCall, struct {
+		arg1 *obs.Client
+	}{arg1})
+	stub := fake.SetOBSClientStub
+	fake.recordInvocation("SetOBSClient", []interface{}{arg1})
+	fake.setOBSClientMutex.Unlock()
+	if stub != nil {
+		fake.SetOBSClientStub(arg1)
+	}
+}
+
+func (fake *FakeReleaseImpl) SetOBSClientCallCount() int {
+	fake.setOBSClientMutex.RLock()
+	defer fake.setOBSClientMutex.RUnlock()
+	return len(fake.setOBSClientArgsForCall)
+}
+
+func (fake *FakeReleaseImpl) SetOBSClientCalls(stub func(*obs.Client)) {
+	fake.setOBSClientMutex.Lock()
+	defer fake.setOBSClientMutex.Unlock()
+	fake.SetOBSClientStub = stub
+}
+
+func (fake *FakeReleaseImpl) SetOBSClientArgsForCall(i int) *obs.Client {
+	fake.setOBSClientMutex.RLock()
+	defer fake.setOBSClientMutex.RUnlock()
+	argsForCall := fake.setOBSClientArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeReleaseImpl) Submit(arg1 *gcb.Options) error {
 	fake.submitMutex.Lock()
 	ret, specificReturn := fake.submitReturnsOnCall[len(fake.submitArgsForCall)]
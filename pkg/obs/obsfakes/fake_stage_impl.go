@@ -22,24 +22,12 @@ import (
 
 	semver "github.com/blang/semver/v4"
 	"k8s.io/release/pkg/gcp/gcb"
+	"k8s.io/release/pkg/obs"
 	"k8s.io/release/pkg/obs/specs"
 	"k8s.io/release/pkg/release"
 )
 
 type FakeStageImpl struct {
-	AddRemoveChangesStub        func(string, string, string) error
-	addRemoveChangesMutex       sync.RWMutex
-	addRemoveChangesArgsForCall []struct {
-		arg1 string
-		arg2 string
-		arg3 string
-	}
-	addRemoveChangesReturns struct {
-		result1 error
-	}
-	addRemoveChangesReturnsOnCall map[int]struct {
-		result1 error
-	}
 	BranchNeedsCreationStub        func(string, string, semver.Version) (bool, error)
 	branchNeedsCreationMutex       sync.RWMutex
 	branchNeedsCreationArgsForCall []struct {
@@ -55,6 +43,36 @@ type FakeStageImpl struct {
 		result1 bool
 		result2 error
 	}
+	BuildLogTailStub        func(string, string, string, string) (string, error)
+	buildLogTailMutex       sync.RWMutex
+	buildLogTailArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	buildLogTailReturns struct {
+		result1 string
+		result2 error
+	}
+	buildLogTailReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	BuildResultsStub        func(string, []string) (*obs.ResultList, error)
+	buildResultsMutex       sync.RWMutex
+	buildResultsArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	buildResultsReturns struct {
+		result1 *obs.ResultList
+		result2 error
+	}
+	buildResultsReturnsOnCall map[int]struct {
+		result1 *obs.ResultList
+		result2 error
+	}
 	CheckPrerequisitesStub        func(string) error
 	checkPrerequisitesMutex       sync.RWMutex
 	checkPrerequisitesArgsForCall []struct {
@@ -78,32 +96,6 @@ type FakeStageImpl struct {
 	checkoutProjectReturnsOnCall map[int]struct {
 		result1 error
 	}
-	CommitChangesStub        func(string, string, string, string) error
-	commitChangesMutex       sync.RWMutex
-	commitChangesArgsForCall []struct {
-		arg1 string
-		arg2 string
-		arg3 string
-		arg4 string
-	}
-	commitChangesReturns struct {
-		result1 error
-	}
-	commitChangesReturnsOnCall map[int]struct {
-		result1 error
-	}
-	CreateOBSConfigFileStub        func(string, string) error
-	createOBSConfigFileMutex       sync.RWMutex
-	createOBSConfigFileArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	createOBSConfigFileReturns struct {
-		result1 error
-	}
-	createOBSConfigFileReturnsOnCall map[int]struct {
-		result1 error
-	}
 	GenerateReleaseVersionStub        func(string, string, string, bool) (*release.Versions, error)
 	generateReleaseVersionMutex       sync.RWMutex
 	generateReleaseVersionArgsForCall []struct {
@@ -142,6 +134,20 @@ type FakeStageImpl struct {
 	mkdirAllReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PushPackageStub        func(string, string, string, string) error
+	pushPackageMutex       sync.RWMutex
+	pushPackageArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	pushPackageReturns struct {
+		result1 error
+	}
+	pushPackageReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemovePackageFilesStub        func(string) error
 	removePackageFilesMutex       sync.RWMutex
 	removePackageFilesArgsForCall []struct {
@@ -153,6 +159,11 @@ type FakeStageImpl struct {
 	removePackageFilesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetOBSClientStub        func(*obs.Client)
+	setOBSClientMutex       sync.RWMutex
+	setOBSClientArgsForCall []struct {
+		arg1 *obs.Client
+	}
 	SubmitStub        func(*gcb.Options) error
 	submitMutex       sync.RWMutex
 	submitArgsForCall []struct {
@@ -164,85 +175,22 @@ type FakeStageImpl struct {
 	submitReturnsOnCall map[int]struct {
 		result1 error
 	}
-	WaitStub        func(string, string) error
-	waitMutex       sync.RWMutex
-	waitArgsForCall []struct {
+	WriteFileStub        func(string, []byte) error
+	writeFileMutex       sync.RWMutex
+	writeFileArgsForCall []struct {
 		arg1 string
-		arg2 string
+		arg2 []byte
 	}
-	waitReturns struct {
+	writeFileReturns struct {
 		result1 error
 	}
-	waitReturnsOnCall map[int]struct {
+	writeFileReturnsOnCall map[int]struct {
 		result1 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeStageImpl) AddRemoveChanges(arg1 string, arg2 string, arg3 string) error {
-	fake.addRemoveChangesMutex.Lock()
-	ret, specificReturn := fake.addRemoveChangesReturnsOnCall[len(fake.addRemoveChangesArgsForCall)]
-	fake.addRemoveChangesArgsForCall = append(fake.addRemoveChangesArgsForCall, struct {
-		arg1 string
-		arg2 string
-		arg3 string
-	}{arg1, arg2, arg3})
-	stub := fake.AddRemoveChangesStub
-	fakeReturns := fake.addRemoveChangesReturns
-	fake.recordInvocation("AddRemoveChanges", []interface{}{arg1, arg2, arg3})
-	fake.addRemoveChangesMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2, arg3)
-	}
-	if specificReturn {
-		return ret.result1
-	}
-	return fakeReturns.result1
-}
-
-func (fake *FakeStageImpl) AddRemoveChangesCallCount() int {
-	fake.addRemoveChangesMutex.RLock()
-	defer fake.addRemoveChangesMutex.RUnlock()
-	return len(fake.addRemoveChangesArgsForCall)
-}
-
-func (fake *FakeStageImpl) AddRemoveChangesCalls(stub func(string, string, string) error) {
-	fake.addRemoveChangesMutex.Lock()
-	defer fake.addRemoveChangesMutex.Unlock()
-	fake.AddRemoveChangesStub = stub
-}
-
-func (fake *FakeStageImpl) AddRemoveChangesArgsForCall(i int) (string, string, string) {
-	fake.addRemoveChangesMutex.RLock()
-	defer fake.addRemoveChangesMutex.RUnlock()
-	argsForCall := fake.addRemoveChangesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
-}
-
-func (fake *FakeStageImpl) AddRemoveChangesReturns(result1 error) {
-	fake.addRemoveChangesMutex.Lock()
-	defer fake.addRemoveChangesMutex.Unlock()
-	fake.AddRemoveChangesStub = nil
-	fake.addRemoveChangesReturns = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakeStageImpl) AddRemoveChangesReturnsOnCall(i int, result1 error) {
-	fake.addRemoveChangesMutex.Lock()
-	defer fake.addRemoveChangesMutex.Unlock()
-	fake.AddRemoveChangesStub = nil
-	if fake.addRemoveChangesReturnsOnCall == nil {
-		fake.addRemoveChangesReturnsOnCall = make(map[int]struct {
-			result1 error
-		})
-	}
-	fake.addRemoveChangesReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
-}
-
 func (fake *FakeStageImpl) BranchNeedsCreation(arg1 string, arg2 string, arg3 semver.Version) (bool, error) {
 	fake.branchNeedsCreationMutex.Lock()
 	ret, specificReturn := fake.branchNeedsCreationReturnsOnCall[len(fake.branchNeedsCreationArgsForCall)]
@@ -309,6 +257,143 @@ func (fake *FakeStageImpl) BranchNeedsCreationReturnsOnCall(i int, result1 bool,
 	}{result1, result2}
 }
 
+func (fake *FakeStageImpl) BuildLogTail(arg1 string, arg2 string, arg3 string, arg4 string) (string, error) {
+	fake.buildLogTailMutex.Lock()
+	ret, specificReturn := fake.buildLogTailReturnsOnCall[len(fake.buildLogTailArgsForCall)]
+	fake.buildLogTailArgsForCall = append(fake.buildLogTailArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.BuildLogTailStub
+	fakeReturns := fake.buildLogTailReturns
+	fake.recordInvocation("BuildLogTail", []interface{}{arg1, arg2, arg3, arg4})
+	fake.buildLogTailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStageImpl) BuildLogTailCallCount() int {
+	fake.buildLogTailMutex.RLock()
+	defer fake.buildLogTailMutex.RUnlock()
+	return len(fake.buildLogTailArgsForCall)
+}
+
+func (fake *FakeStageImpl) BuildLogTailCalls(stub func(string, string, string, string) (string, error)) {
+	fake.buildLogTailMutex.Lock()
+	defer fake.buildLogTailMutex.Unlock()
+	fake.BuildLogTailStub = stub
+}
+
+func (fake *FakeStageImpl) BuildLogTailArgsForCall(i int) (string, string, string, string) {
+	fake.buildLogTailMutex.RLock()
+	defer fake.buildLogTailMutex.RUnlock()
+	argsForCall := fake.buildLogTailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStageImpl) BuildLogTailReturns(result1 string, result2 error) {
+	fake.buildLogTailMutex.Lock()
+	defer fake.buildLogTailMutex.Unlock()
+	fake.BuildLogTailStub = nil
+	fake.buildLogTailReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStageImpl) BuildLogTailReturnsOnCall(i int, result1 string, result2 error) {
+	fake.buildLogTailMutex.Lock()
+	defer fake.buildLogTailMutex.Unlock()
+	fake.BuildLogTailStub = nil
+	if fake.buildLogTailReturnsOnCall == nil {
+		fake.buildLogTailReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.buildLogTailReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStageImpl) BuildResults(arg1 string, arg2 []string) (*obs.ResultList, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.buildResultsMutex.Lock()
+	ret, specificReturn := fake.buildResultsReturnsOnCall[len(fake.buildResultsArgsForCall)]
+	fake.buildResultsArgsForCall = append(fake.buildResultsArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.BuildResultsStub
+	fakeReturns := fake.buildResultsReturns
+	fake.recordInvocation("BuildResults", []interface{}{arg1, arg2Copy})
+	fake.buildResultsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStageImpl) BuildResultsCallCount() int {
+	fake.buildResultsMutex.RLock()
+	defer fake.buildResultsMutex.RUnlock()
+	return len(fake.buildResultsArgsForCall)
+}
+
+func (fake *FakeStageImpl) BuildResultsCalls(stub func(string, []string) (*obs.ResultList, error)) {
+	fake.buildResultsMutex.Lock()
+	defer fake.buildResultsMutex.Unlock()
+	fake.BuildResultsStub = stub
+}
+
+func (fake *FakeStageImpl) BuildResultsArgsForCall(i int) (string, []string) {
+	fake.buildResultsMutex.RLock()
+	defer fake.buildResultsMutex.RUnlock()
+	argsForCall := fake.buildResultsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStageImpl) BuildResultsReturns(result1 *obs.ResultList, result2 error) {
+	fake.buildResultsMutex.Lock()
+	defer fake.buildResultsMutex.Unlock()
+	fake.BuildResultsStub = nil
+	fake.buildResultsReturns = struct {
+		result1 *obs.ResultList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStageImpl) BuildResultsReturnsOnCall(i int, result1 *obs.ResultList, result2 error) {
+	fake.buildResultsMutex.Lock()
+	defer fake.buildResultsMutex.Unlock()
+	fake.BuildResultsStub = nil
+	if fake.buildResultsReturnsOnCall == nil {
+		fake.buildResultsReturnsOnCall = make(map[int]struct {
+			result1 *obs.ResultList
+			result2 error
+		})
+	}
+	fake.buildResultsReturnsOnCall[i] = struct {
+		result1 *obs.ResultList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStageImpl) CheckPrerequisites(arg1 string) error {
 	fake.checkPrerequisitesMutex.Lock()
 	ret, specificReturn := fake.checkPrerequisitesReturnsOnCall[len(fake.checkPrerequisitesArgsForCall)]
@@ -432,132 +517,6 @@ func (fake *FakeStageImpl) CheckoutProjectReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeStageImpl) CommitChanges(arg1 string, arg2 string, arg3 string, arg4 string) error {
-	fake.commitChangesMutex.Lock()
-	ret, specificReturn := fake.commitChangesReturnsOnCall[len(fake.commitChangesArgsForCall)]
-	fake.commitChangesArgsForCall = append(fake.commitChangesArgsForCall, struct {
-		arg1 string
-		arg2 string
-		arg3 string
-		arg4 string
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.CommitChangesStub
-	fakeReturns := fake.commitChangesReturns
-	fake.recordInvocation("CommitChanges", []interface{}{arg1, arg2, arg3, arg4})
-	fake.commitChangesMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
-	}
-	if specificReturn {
-		return ret.result1
-	}
-	return fakeReturns.result1
-}
-
-func (fake *FakeStageImpl) CommitChangesCallCount() int {
-	fake.commitChangesMutex.RLock()
-	defer fake.commitChangesMutex.RUnlock()
-	return len(fake.commitChangesArgsForCall)
-}
-
-func (fake *FakeStageImpl) CommitChangesCalls(stub func(string, string, string, string) error) {
-	fake.commitChangesMutex.Lock()
-	defer fake.commitChangesMutex.Unlock()
-	fake.CommitChangesStub = stub
-}
-
-func (fake *FakeStageImpl) CommitChangesArgsForCall(i int) (string, string, string, string) {
-	fake.commitChangesMutex.RLock()
-	defer fake.commitChangesMutex.RUnlock()
-	argsForCall := fake.commitChangesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
-}
-
-func (fake *FakeStageImpl) CommitChangesReturns(result1 error) {
-	fake.commitChangesMutex.Lock()
-	defer fake.commitChangesMutex.Unlock()
-	fake.CommitChangesStub = nil
-	fake.commitChangesReturns = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakeStageImpl) CommitChangesReturnsOnCall(i int, result1 error) {
-	fake.commitChangesMutex.Lock()
-	defer fake.commitChangesMutex.Unlock()
-	fake.CommitChangesStub = nil
-	if fake.commitChangesReturnsOnCall == nil {
-		fake.commitChangesReturnsOnCall = make(map[int]struct {
-			result1 error
-		})
-	}
-	fake.commitChangesReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakeStageImpl) CreateOBSConfigFile(arg1 string, arg2 string) error {
-	fake.createOBSConfigFileMutex.Lock()
-	ret, specificReturn := fake.createOBSConfigFileReturnsOnCall[len(fake.createOBSConfigFileArgsForCall)]
-	fake.createOBSConfigFileArgsForCall = append(fake.createOBSConfigFileArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.CreateOBSConfigFileStub
-	fakeReturns := fake.createOBSConfigFileReturns
-	fake.recordInvocation("CreateOBSConfigFile", []interface{}{arg1, arg2})
-	fake.createOBSConfigFileMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1
-	}
-	return fakeReturns.result1
-}
-
-func (fake *FakeStageImpl) CreateOBSConfigFileCallCount() int {
-	fake.createOBSConfigFileMutex.RLock()
-	defer fake.createOBSConfigFileMutex.RUnlock()
-	return len(fake.createOBSConfigFileArgsForCall)
-}
-
-func (fake *FakeStageImpl) CreateOBSConfigFileCalls(stub func(string, string) error) {
-	fake.createOBSConfigFileMutex.Lock()
-	defer fake.createOBSConfigFileMutex.Unlock()
-	fake.CreateOBSConfigFileStub = stub
-}
-
-func (fake *FakeStageImpl) CreateOBSConfigFileArgsForCall(i int) (string, string) {
-	fake.createOBSConfigFileMutex.RLock()
-	defer fake.createOBSConfigFileMutex.RUnlock()
-	argsForCall := fake.createOBSConfigFileArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeStageImpl) CreateOBSConfigFileReturns(result1 error) {
-	fake.createOBSConfigFileMutex.Lock()
-	defer fake.createOBSConfigFileMutex.Unlock()
-	fake.CreateOBSConfigFileStub = nil
-	fake.createOBSConfigFileReturns = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakeStageImpl) CreateOBSConfigFileReturnsOnCall(i int, result1 error) {
-	fake.createOBSConfigFileMutex.Lock()
-	defer fake.createOBSConfigFileMutex.Unlock()
-	fake.CreateOBSConfigFileStub = nil
-	if fake.createOBSConfigFileReturnsOnCall == nil {
-		fake.createOBSConfigFileReturnsOnCall = make(map[int]struct {
-			result1 error
-		})
-	}
-	fake.createOBSConfigFileReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
-}
-
 func (fake *FakeStageImpl) GenerateReleaseVersion(arg1 string, arg2 string, arg3 string, arg4 bool) (*release.Versions, error) {
 	fake.generateReleaseVersionMutex.Lock()
 	ret, specificReturn := fake.generateReleaseVersionReturnsOnCall[len(fake.generateReleaseVersionArgsForCall)]
@@ -747,6 +706,70 @@ func (fake *FakeStageImpl) MkdirAllReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStageImpl) PushPackage(arg1 string, arg2 string, arg3 string, arg4 string) error {
+	fake.pushPackageMutex.Lock()
+	ret, specificReturn := fake.pushPackageReturnsOnCall[len(fake.pushPackageArgsForCall)]
+	fake.pushPackageArgsForCall = append(fake.pushPackageArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PushPackageStub
+	fakeReturns := fake.pushPackageReturns
+	fake.recordInvocation("PushPackage", []interface{}{arg1, arg2, arg3, arg4})
+	fake.pushPackageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageImpl) PushPackageCallCount() int {
+	fake.pushPackageMutex.RLock()
+	defer fake.pushPackageMutex.RUnlock()
+	return len(fake.pushPackageArgsForCall)
+}
+
+func (fake *FakeStageImpl) PushPackageCalls(stub func(string, string, string, string) error) {
+	fake.pushPackageMutex.Lock()
+	defer fake.pushPackageMutex.Unlock()
+	fake.PushPackageStub = stub
+}
+
+func (fake *FakeStageImpl) PushPackageArgsForCall(i int) (string, string, string, string) {
+	fake.pushPackageMutex.RLock()
+	defer fake.pushPackageMutex.RUnlock()
+	argsForCall := fake.pushPackageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStageImpl) PushPackageReturns(result1 error) {
+	fake.pushPackageMutex.Lock()
+	defer fake.pushPackageMutex.Unlock()
+	fake.PushPackageStub = nil
+	fake.pushPackageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageImpl) PushPackageReturnsOnCall(i int, result1 error) {
+	fake.pushPackageMutex.Lock()
+	defer fake.pushPackageMutex.Unlock()
+	fake.PushPackageStub = nil
+	if fake.pushPackageReturnsOnCall == nil {
+		fake.pushPackageReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pushPackageReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStageImpl) RemovePackageFiles(arg1 string) error {
 	fake.removePackageFilesMutex.Lock()
 	ret, specificReturn := fake.removePackageFilesReturnsOnCall[len(fake.removePackageFilesArgsForCall)]
@@ -808,6 +831,38 @@ func (fake *FakeStageImpl) RemovePackageFilesReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeStageImpl) SetOBSClient(arg1 *obs.Client) {
+	fake.setOBSClientMutex.Lock()
+	fake.setOBSClientArgsForCall = append(fake.setOBSClientArgsForCall, struct {
+		arg1 *obs.Client
+	}{arg1})
+	stub := fake.SetOBSClientStub
+	fake.recordInvocation("SetOBSClient", []interface{}{arg1})
+	fake.setOBSClientMutex.Unlock()
+	if stub != nil {
+		fake.SetOBSClientStub(arg1)
+	}
+}
+
+func (fake *FakeStageImpl) SetOBSClientCallCount() int {
+	fake.setOBSClientMutex.RLock()
+	defer fake.setOBSClientMutex.RUnlock()
+	return len(fake.setOBSClientArgsForCall)
+}
+
+func (fake *FakeStageImpl) SetOBSClientCalls(stub func(*obs.Client)) {
+	fake.setOBSClientMutex.Lock()
+	defer fake.setOBSClientMutex.Unlock()
+	fake.SetOBSClientStub = stub
+}
+
+func (fake *FakeStageImpl) SetOBSClientArgsForCall(i int) *obs.Client {
+	fake.setOBSClientMutex.RLock()
+	defer fake.setOBSClientMutex.RUnlock()
+	argsForCall := fake.setOBSClientArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeStageImpl) Submit(arg1 *gcb.Options) error {
 	fake.submitMutex.Lock()
 	ret, specificReturn := fake.submitReturnsOnCall[len(fake.submitArgsForCall)]
@@ -869,17 +924,22 @@ func (fake *FakeStageImpl) SubmitReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeStageImpl) Wait(arg1 string, arg2 string) error {
-	fake.waitMutex.Lock()
-	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
-	fake.waitArgsForCall = append(fake.waitArgsForCall, struct {
+func (fake *FakeStageImpl) WriteFile(arg1 string, arg2 []byte) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.writeFileMutex.Lock()
+	ret, specificReturn := fake.writeFileReturnsOnCall[len(fake.writeFileArgsForCall)]
+	fake.writeFileArgsForCall = append(fake.writeFileArgsForCall, struct {
 		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.WaitStub
-	fakeReturns := fake.waitReturns
-	fake.recordInvocation("Wait", []interface{}{arg1, arg2})
-	fake.waitMutex.Unlock()
+		arg2 []byte
+	}{arg1, arg2Copy})
+	stub := fake.WriteFileStub
+	fakeReturns := fake.writeFileReturns
+	fake.recordInvocation("WriteFile", []interface{}{arg1, arg2Copy})
+	fake.writeFileMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -889,44 +949,44 @@ func (fake *FakeStageImpl) Wait(arg1 string, arg2 string) error {
 	return fakeReturns.result1
 }
 
-func (fake *FakeStageImpl) WaitCallCount() int {
-	fake.waitMutex.RLock()
-	defer fake.waitMutex.RUnlock()
-	return len(fake.waitArgsForCall)
+func (fake *FakeStageImpl) WriteFileCallCount() int {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	return len(fake.writeFileArgsForCall)
 }
 
-func (fake *FakeStageImpl) WaitCalls(stub func(string, string) error) {
-	fake.waitMutex.Lock()
-	defer fake.waitMutex.Unlock()
-	fake.WaitStub = stub
+func (fake *FakeStageImpl) WriteFileCalls(stub func(string, []byte) error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = stub
 }
 
-func (fake *FakeStageImpl) WaitArgsForCall(i int) (string, string) {
-	fake.waitMutex.RLock()
-	defer fake.waitMutex.RUnlock()
-	argsForCall := fake.waitArgsForCall[i]
+func (fake *FakeStageImpl) WriteFileArgsForCall(i int) (string, []byte) {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	argsForCall := fake.writeFileArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStageImpl) WaitReturns(result1 error) {
-	fake.waitMutex.Lock()
-	defer fake.waitMutex.Unlock()
-	fake.WaitStub = nil
-	fake.waitReturns = struct {
+func (fake *FakeStageImpl) WriteFileReturns(result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	fake.writeFileReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStageImpl) WaitReturnsOnCall(i int, result1 error) {
-	fake.waitMutex.Lock()
-	defer fake.waitMutex.Unlock()
-	fake.WaitStub = nil
-	if fake.waitReturnsOnCall == nil {
-		fake.waitReturnsOnCall = make(map[int]struct {
+func (fake *FakeStageImpl) WriteFileReturnsOnCall(i int, result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	if fake.writeFileReturnsOnCall == nil {
+		fake.writeFileReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.waitReturnsOnCall[i] = struct {
+	fake.writeFileReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
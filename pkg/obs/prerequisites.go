@@ -17,15 +17,12 @@ limitations under the License.
 package obs
 
 import (
-	"errors"
+	"context"
 	"fmt"
-	"strings"
 
 	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/sirupsen/logrus"
 
-	"sigs.k8s.io/release-sdk/osc"
-	"sigs.k8s.io/release-utils/command"
 	"sigs.k8s.io/release-utils/env"
 )
 
@@ -65,24 +62,20 @@ func (p *PrerequisitesChecker) SetImpl(impl prerequisitesCheckerImpl) {
 
 //counterfeiter:generate . prerequisitesCheckerImpl
 type prerequisitesCheckerImpl interface {
-	CommandAvailable(commands ...string) bool
-	OSCOutput(args ...string) (string, error)
+	Whoami() (*Person, error)
 	IsEnvSet(key string) bool
 	Usage(dir string) (*disk.UsageStat, error)
 }
 
 type defaultPrerequisitesChecker struct{}
 
-func (*defaultPrerequisitesChecker) CommandAvailable(
-	commands ...string,
-) bool {
-	return command.Available(commands...)
-}
+func (*defaultPrerequisitesChecker) Whoami() (*Person, error) {
+	client, err := NewClientFromEnv()
+	if err != nil {
+		return nil, err
+	}
 
-func (*defaultPrerequisitesChecker) OSCOutput(
-	args ...string,
-) (string, error) {
-	return osc.Output("", args...)
+	return client.Whoami(context.Background())
 }
 
 func (*defaultPrerequisitesChecker) IsEnvSet(key string) bool {
@@ -94,34 +87,6 @@ func (*defaultPrerequisitesChecker) Usage(dir string) (*disk.UsageStat, error) {
 }
 
 func (p *PrerequisitesChecker) Run(workdir string) error {
-	// Command checks
-	commands := []string{"osc"}
-	logrus.Infof(
-		"Verifying that the commands %s exist in $PATH.",
-		strings.Join(commands, ", "),
-	)
-
-	if !p.impl.CommandAvailable(commands...) {
-		return errors.New("not all commands available")
-	}
-
-	// osc checks
-	logrus.Info("Verifying OpenBuildService access")
-
-	ver, err := p.impl.OSCOutput("version")
-	if err != nil {
-		return fmt.Errorf("running osc version: %w", err)
-	}
-
-	logrus.Infof("Using osc version: %s", ver)
-
-	user, err := p.impl.OSCOutput("whois")
-	if err != nil {
-		return fmt.Errorf("running osc whois: %w", err)
-	}
-
-	logrus.Infof("Using OpenBuildService user: %s", user)
-
 	// Environment checks
 	if p.opts.CheckOBSPassword {
 		logrus.Infof(
@@ -133,6 +98,16 @@ func (p *PrerequisitesChecker) Run(workdir string) error {
 		}
 	}
 
+	// OBS API checks
+	logrus.Info("Verifying OpenBuildService access")
+
+	user, err := p.impl.Whoami()
+	if err != nil {
+		return fmt.Errorf("verifying OpenBuildService user: %w", err)
+	}
+
+	logrus.Infof("Using OpenBuildService user: %s (%s)", user.Login, user.Email)
+
 	// Disk space check
 	const minDiskSpaceGiB = 10
 
@@ -17,6 +17,7 @@ limitations under the License.
 package obs
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -24,7 +25,6 @@ import (
 	"github.com/blang/semver/v4"
 	"github.com/sirupsen/logrus"
 
-	"sigs.k8s.io/release-sdk/osc"
 	"sigs.k8s.io/release-utils/helpers"
 
 	"k8s.io/release/pkg/gcp/gcb"
@@ -98,7 +98,9 @@ func (d *DefaultRelease) SetState(state *ReleaseState) {
 }
 
 // defaultReleaseImpl is the default internal release client implementation.
-type defaultReleaseImpl struct{}
+type defaultReleaseImpl struct {
+	client *Client
+}
 
 // releaseImpl is the implementation of the release client.
 //
@@ -113,9 +115,9 @@ type releaseImpl interface {
 	BranchNeedsCreation(
 		branch, releaseType string, buildVersion semver.Version,
 	) (bool, error)
-	CreateOBSConfigFile(username, password string) error
+	SetOBSClient(client *Client)
 	CheckoutProject(workspaceDir, project string) error
-	ReleasePackage(workspaceDir, project, packageName string) error
+	ReleasePackage(project, packageName string) error
 }
 
 func (d *defaultReleaseImpl) Submit(options *gcb.Options) error {
@@ -146,21 +148,19 @@ func (d *defaultReleaseImpl) GenerateReleaseVersion(
 	)
 }
 
-// CreateOBSConfigFile creates `~/.oscrc` file which contains the OBS API URL
-// and credentials for the k8s-release-bot user.
-func (d *defaultReleaseImpl) CreateOBSConfigFile(username, password string) error {
-	return osc.CreateOSCConfigFile(obsAPIURL, username, password)
+// SetOBSClient sets the client used to access the OBS API.
+func (d *defaultReleaseImpl) SetOBSClient(client *Client) {
+	d.client = client
 }
 
-// CheckoutProject runs `osc checkout` in the project directory.
+// CheckoutProject creates the package directories of the project.
 func (d *defaultReleaseImpl) CheckoutProject(workspaceDir, project string) error {
-	// TODO(xmudrii) - followup: figure out how to stream output.
-	return osc.OSC(filepath.Join(workspaceDir, obsRoot), "checkout", project)
+	return checkoutProject(context.Background(), d.client, workspaceDir, project)
 }
 
-// ReleasePackage runs `osc release` in the package directory.
-func (d *defaultReleaseImpl) ReleasePackage(workspaceDir, project, packageName string) error {
-	return osc.OSC(filepath.Join(workspaceDir, obsRoot, project, packageName), "release")
+// ReleasePackage releases the successful builds of the package.
+func (d *defaultReleaseImpl) ReleasePackage(project, packageName string) error {
+	return d.client.Release(context.Background(), project, packageName)
 }
 
 func (d *DefaultRelease) Submit(stream bool) error {
@@ -183,21 +183,14 @@ func (d *DefaultRelease) InitState() {
 	}
 }
 
-// InitOBSRoot creates the OBS root directory and the OBS config file.
+// InitOBSRoot creates the OBS root directory and the OBS API client.
 func (d *DefaultRelease) InitOBSRoot() error {
-	password := os.Getenv(OBSPasswordKey)
-	if password == "" {
-		return fmt.Errorf("%s environment variable not set", OBSPasswordKey)
-	}
-
-	username := os.Getenv(OBSUsernameKey)
-	if username == "" {
-		username = obsK8sUsername
+	client, err := NewClientFromEnv()
+	if err != nil {
+		return fmt.Errorf("creating obs client: %w", err)
 	}
 
-	if err := d.impl.CreateOBSConfigFile(username, password); err != nil {
-		return fmt.Errorf("creating obs config file: %w", err)
-	}
+	d.impl.SetOBSClient(client)
 
 	return d.impl.MkdirAll(filepath.Join(d.options.Workspace, obsRoot))
 }
@@ -307,7 +300,7 @@ func (d *DefaultRelease) ReleasePackages() error {
 	}
 
 	for _, pkg := range d.options.Packages {
-		if err := d.impl.ReleasePackage(d.options.Workspace, d.state.obsProject, pkg); err != nil {
+		if err := d.impl.ReleasePackage(d.state.obsProject, pkg); err != nil {
 			return fmt.Errorf("releasing package %s from project %s: %w", pkg, d.state.obsProject, err)
 		}
 	}
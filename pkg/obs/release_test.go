@@ -59,7 +59,7 @@ func TestInitOBSRootRelease(t *testing.T) {
 			require.Error(t, err)
 		} else {
 			require.NoError(t, err)
-			require.Equal(t, 1, mock.CreateOBSConfigFileCallCount())
+			require.Equal(t, 1, mock.SetOBSClientCallCount())
 			require.Equal(t, 1, mock.MkdirAllCallCount())
 		}
 	}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repoindex
+
+import (
+	"bytes"
+	"cmp"
+	"compress/gzip"
+	"crypto/md5"  //nolint:gosec // required by the apt repository format
+	"crypto/sha1" //nolint:gosec // required by the apt repository format
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Files of the flat apt repository, see
+// https://wiki.debian.org/DebianRepository/Format#Flat_Repository_Format
+const (
+	aptPackagesFile  = "Packages"
+	aptPackagesGzip  = "Packages.gz"
+	aptReleaseFile   = "Release"
+	aptInReleaseFile = "InRelease"
+	aptReleaseSig    = "Release.gpg"
+	aptReleaseKey    = "Release.key"
+
+	// aptDateFormat is the format of the Date field of the Release file.
+	aptDateFormat = "Mon, 02 Jan 2006 15:04:05 UTC"
+)
+
+// aptIndexFields are the fields added to the control file fields in the
+// Packages file. Existing fields with the same name are replaced.
+var aptIndexFields = []string{"Filename", "Size", "MD5sum", "SHA1", "SHA256"}
+
+// writeAptMetadata writes the metadata of a flat apt repository, which
+// clients add via `deb [signed-by=...] <url> /`.
+func (r *RepoIndex) writeAptMetadata(debs []*DebPackage, signer *Signer, date time.Time) error {
+	packages := aptPackages(debs)
+
+	packagesGzip, err := gzipData(packages)
+	if err != nil {
+		return fmt.Errorf("compressing %s: %w", aptPackagesFile, err)
+	}
+
+	release := r.aptRelease(debs, date, map[string][]byte{
+		aptPackagesFile: packages,
+		aptPackagesGzip: packagesGzip,
+	})
+
+	files := map[string][]byte{
+		aptPackagesFile: packages,
+		aptPackagesGzip: packagesGzip,
+		aptReleaseFile:  release,
+	}
+
+	if signer != nil {
+		inRelease, err := signer.ClearSign(release)
+		if err != nil {
+			return fmt.Errorf("signing %s: %w", aptInReleaseFile, err)
+		}
+
+		releaseSig, err := signer.DetachSign(release)
+		if err != nil {
+			return fmt.Errorf("signing %s: %w", aptReleaseFile, err)
+		}
+
+		publicKey, err := signer.PublicKey()
+		if err != nil {
+			return err
+		}
+
+		files[aptInReleaseFile] = inRelease
+		files[aptReleaseSig] = releaseSig
+		files[aptReleaseKey] = publicKey
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		if err := r.writeFile(name, files[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// aptPackages returns the Packages file of the packages, ordered by name,
+// version and architecture.
+func aptPackages(debs []*DebPackage) []byte {
+	sorted := slices.Clone(debs)
+	slices.SortFunc(sorted, func(a, b *DebPackage) int {
+		return cmp.Or(
+			strings.Compare(a.Name(), b.Name()),
+			strings.Compare(a.Version(), b.Version()),
+			strings.Compare(a.Architecture(), b.Architecture()),
+			strings.Compare(a.Filename, b.Filename),
+		)
+	})
+
+	buf := &bytes.Buffer{}
+
+	for i, deb := range sorted {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+
+		for _, field := range deb.Control {
+			if slices.ContainsFunc(aptIndexFields, func(name string) bool {
+				return strings.EqualFold(name, field.Name)
+			}) {
+				continue
+			}
+
+			fmt.Fprintf(buf, "%s: %s\n", field.Name, field.Value)
+		}
+
+		fmt.Fprintf(buf, "Filename: %s\n", deb.Filename)
+		fmt.Fprintf(buf, "Size: %d\n", deb.Size)
+		fmt.Fprintf(buf, "MD5sum: %s\n", deb.MD5)
+		fmt.Fprintf(buf, "SHA1: %s\n", deb.SHA1)
+		fmt.Fprintf(buf, "SHA256: %s\n", deb.SHA256)
+	}
+
+	return buf.Bytes()
+}
+
+// aptRelease returns the Release file of the repository, which contains the
+// checksums of the index files.
+func (r *RepoIndex) aptRelease(debs []*DebPackage, date time.Time, indexFiles map[string][]byte) []byte {
+	archs := []string{}
+
+	for _, deb := range debs {
+		if arch := deb.Architecture(); arch != "all" && !slices.Contains(archs, arch) {
+			archs = append(archs, arch)
+		}
+	}
+
+	slices.Sort(archs)
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "Origin: %s\n", r.options.Origin)
+	fmt.Fprintf(buf, "Label: %s\n", r.options.Label)
+	fmt.Fprintf(buf, "Date: %s\n", date.UTC().Format(aptDateFormat))
+
+	if len(archs) > 0 {
+		fmt.Fprintf(buf, "Architectures: %s\n", strings.Join(archs, " "))
+	}
+
+	if r.options.Description != "" {
+		fmt.Fprintf(buf, "Description: %s\n", r.options.Description)
+	}
+
+	names := slices.Sorted(maps.Keys(indexFiles))
+
+	for _, hash := range []struct {
+		field string
+		sum   func([]byte) string
+	}{
+		{"MD5Sum", func(data []byte) string {
+			sum := md5.Sum(data) //nolint:gosec // required by the apt repository format
+
+			return hex.EncodeToString(sum[:])
+		}},
+		{"SHA1", func(data []byte) string {
+			sum := sha1.Sum(data) //nolint:gosec // required by the apt repository format
+
+			return hex.EncodeToString(sum[:])
+		}},
+		{"SHA256", sha256Hex},
+	} {
+		fmt.Fprintf(buf, "%s:\n", hash.field)
+
+		for _, name := range names {
+			fmt.Fprintf(buf, " %s %d %s\n", hash.sum(indexFiles[name]), len(indexFiles[name]), name)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// gzipData compresses the data without a file name and modification time,
+// so that the output is reproducible.
+func gzipData(data []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
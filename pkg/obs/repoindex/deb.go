@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repoindex
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/md5"  //nolint:gosec // required by the apt repository format
+	"crypto/sha1" //nolint:gosec // required by the apt repository format
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+const (
+	// arMagic is the global header of an ar archive.
+	arMagic = "!<arch>\n"
+
+	// arHeaderSize is the size of an ar file header.
+	arHeaderSize = 60
+
+	// debControlMember is the prefix of the control archive member.
+	debControlMember = "control.tar"
+)
+
+// ControlField is a field of a Debian control file paragraph.
+type ControlField struct {
+	Name  string
+	Value string
+}
+
+// DebPackage is a Debian binary package.
+type DebPackage struct {
+	// Control contains the fields of the package control file, in the order
+	// of the control file.
+	Control []ControlField
+
+	// Filename is the path of the package relative to the repository root.
+	Filename string
+
+	Size   int64
+	MD5    string
+	SHA1   string
+	SHA256 string
+}
+
+// Field returns the value of the control field or an empty string if the
+// field doesn't exist.
+func (p *DebPackage) Field(name string) string {
+	for _, f := range p.Control {
+		if strings.EqualFold(f.Name, name) {
+			return f.Value
+		}
+	}
+
+	return ""
+}
+
+// Name returns the package name.
+func (p *DebPackage) Name() string {
+	return p.Field("Package")
+}
+
+// Version returns the package version.
+func (p *DebPackage) Version() string {
+	return p.Field("Version")
+}
+
+// Architecture returns the package architecture.
+func (p *DebPackage) Architecture() string {
+	return p.Field("Architecture")
+}
+
+// ParseDebPackage reads the control file and checksums of a .deb package.
+func ParseDebPackage(file string) (*DebPackage, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading package: %w", err)
+	}
+
+	control, err := readDebControl(data)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := ParseControl(control)
+	if err != nil {
+		return nil, fmt.Errorf("parsing control file: %w", err)
+	}
+
+	pkg := &DebPackage{
+		Control: fields,
+		Size:    int64(len(data)),
+	}
+
+	for _, required := range []string{"Package", "Version", "Architecture"} {
+		if pkg.Field(required) == "" {
+			return nil, fmt.Errorf("control file has no %s field", required)
+		}
+	}
+
+	md5Sum := md5.Sum(data)   //nolint:gosec // required by the apt repository format
+	sha1Sum := sha1.Sum(data) //nolint:gosec // required by the apt repository format
+	pkg.MD5 = hex.EncodeToString(md5Sum[:])
+	pkg.SHA1 = hex.EncodeToString(sha1Sum[:])
+	pkg.SHA256 = sha256Hex(data)
+
+	return pkg, nil
+}
+
+// readDebControl extracts the control file from the control archive member
+// of the ar archive.
+func readDebControl(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, []byte(arMagic)) {
+		return nil, errors.New("not a Debian package: missing ar archive header")
+	}
+
+	offset := len(arMagic)
+	for offset+arHeaderSize <= len(data) {
+		header := data[offset : offset+arHeaderSize]
+		name := strings.TrimSuffix(strings.TrimSpace(string(header[0:16])), "/")
+
+		size, err := strconv.ParseInt(strings.TrimSpace(string(header[48:58])), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid size of ar member %s: %w", name, err)
+		}
+
+		start := offset + arHeaderSize
+		end := start + int(size)
+
+		if size < 0 || end > len(data) {
+			return nil, fmt.Errorf("ar member %s exceeds the archive", name)
+		}
+
+		if strings.HasPrefix(name, debControlMember) {
+			return readControlArchive(name, data[start:end])
+		}
+
+		// Members are aligned to even offsets
+		offset = end + end%2
+	}
+
+	return nil, errors.New("not a Debian package: missing control archive")
+}
+
+// readControlArchive decompresses the control archive and returns the
+// control file.
+func readControlArchive(name string, data []byte) ([]byte, error) {
+	var r io.Reader = bytes.NewReader(data)
+
+	switch path.Ext(name) {
+	case ".tar":
+	case ".gz":
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing %s: %w", name, err)
+		}
+		defer gz.Close()
+
+		r = gz
+	case ".xz":
+		xzr, err := xz.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing %s: %w", name, err)
+		}
+
+		r = xzr
+	case ".zst":
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing %s: %w", name, err)
+		}
+		defer zr.Close()
+
+		r = zr
+	default:
+		return nil, fmt.Errorf("unsupported control archive %s", name)
+	}
+
+	tr := tar.NewReader(r)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("control file not found in %s", name)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", name, err)
+		}
+
+		if path.Clean(header.Name) == "control" {
+			control, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, fmt.Errorf("reading control file: %w", err)
+			}
+
+			return control, nil
+		}
+	}
+}
+
+// ParseControl parses a single paragraph of a Debian control file.
+func ParseControl(data []byte) ([]ControlField, error) {
+	fields := []ControlField{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.TrimSpace(line) == "" {
+			if len(fields) > 0 {
+				break
+			}
+
+			continue
+		}
+
+		if line[0] == ' ' || line[0] == '\t' {
+			if len(fields) == 0 {
+				return nil, fmt.Errorf("continuation line without field: %q", line)
+			}
+
+			fields[len(fields)-1].Value += "\n" + line
+
+			continue
+		}
+
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid field: %q", line)
+		}
+
+		fields = append(fields, ControlField{
+			Name:  name,
+			Value: strings.TrimSpace(value),
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
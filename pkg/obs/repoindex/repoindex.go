@@ -0,0 +1,238 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repoindex
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SigningKeyPassphraseKey is name of the environment variable with the
+	// passphrase of the signing key.
+	SigningKeyPassphraseKey = "REPO_INDEX_SIGNING_KEY_PASSPHRASE"
+
+	// defaultOrigin is the default origin and label of the apt repository.
+	defaultOrigin = "Kubernetes"
+
+	// repodataDir is the directory of the yum repository metadata.
+	repodataDir = "repodata"
+)
+
+// Options defines options for generating the repository metadata.
+type Options struct {
+	// Path is the directory containing the .deb and .rpm packages. The
+	// repository metadata is written to this directory.
+	Path string
+
+	// SigningKeyPath is a path to an armored OpenPGP private key, used to
+	// sign the repository metadata. The metadata is not signed if empty.
+	SigningKeyPath string
+
+	// SigningKeyPassphrase is the passphrase of the signing key, if the key
+	// is encrypted.
+	SigningKeyPassphrase string
+
+	// Origin is the origin of the apt repository.
+	Origin string
+
+	// Label is the label of the apt repository.
+	Label string
+
+	// Description is the description of the apt repository.
+	Description string
+}
+
+// DefaultOptions returns a new Options instance.
+func DefaultOptions() *Options {
+	return &Options{
+		Path:   ".",
+		Origin: defaultOrigin,
+		Label:  defaultOrigin,
+	}
+}
+
+// String returns a string representation for the `Options` type.
+func (o *Options) String() string {
+	return fmt.Sprintf(
+		"Path: %q, SigningKey: %q, Origin: %s, Label: %s",
+		o.Path, o.SigningKeyPath, o.Origin, o.Label,
+	)
+}
+
+// Validate verifies if all parameters in the `Options` instance are valid.
+func (o *Options) Validate() error {
+	info, err := os.Stat(o.Path)
+	if err != nil {
+		return fmt.Errorf("repository directory doesn't exist: %w", err)
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("repository path %s is not a directory", o.Path)
+	}
+
+	if o.SigningKeyPath != "" {
+		if _, err := os.Stat(o.SigningKeyPath); err != nil {
+			return fmt.Errorf("signing key doesn't exist: %w", err)
+		}
+	}
+
+	if o.Origin == "" || o.Label == "" {
+		return errors.New("origin and label are required")
+	}
+
+	return nil
+}
+
+// RepoIndex generates apt and yum repository metadata for a directory of
+// packages.
+type RepoIndex struct {
+	options *Options
+	now     func() time.Time
+}
+
+// New returns a new RepoIndex instance.
+func New(opts *Options) *RepoIndex {
+	return &RepoIndex{
+		options: opts,
+		now:     time.Now,
+	}
+}
+
+// Run generates the apt repository metadata if the directory contains .deb
+// packages and the yum repository metadata if it contains .rpm packages.
+func (r *RepoIndex) Run() error {
+	var signer *Signer
+
+	if r.options.SigningKeyPath != "" {
+		s, err := NewSigner(r.options.SigningKeyPath, r.options.SigningKeyPassphrase)
+		if err != nil {
+			return fmt.Errorf("loading signing key: %w", err)
+		}
+
+		signer = s
+	}
+
+	debs, rpms, err := r.findPackages()
+	if err != nil {
+		return fmt.Errorf("finding packages: %w", err)
+	}
+
+	if len(debs) == 0 && len(rpms) == 0 {
+		return fmt.Errorf("no .deb or .rpm packages found in %s", r.options.Path)
+	}
+
+	date := r.now().UTC()
+
+	if len(debs) > 0 {
+		logrus.Infof("Generating apt repository metadata for %d packages", len(debs))
+
+		if err := r.writeAptMetadata(debs, signer, date); err != nil {
+			return fmt.Errorf("generating apt repository metadata: %w", err)
+		}
+	}
+
+	if len(rpms) > 0 {
+		logrus.Infof("Generating yum repository metadata for %d packages", len(rpms))
+
+		if err := r.writeYumMetadata(rpms, signer, date); err != nil {
+			return fmt.Errorf("generating yum repository metadata: %w", err)
+		}
+	}
+
+	logrus.Infof("Repository metadata has successfully been written to %s", r.options.Path)
+
+	return nil
+}
+
+// findPackages parses all .deb and .rpm files in the repository directory,
+// ordered by path.
+func (r *RepoIndex) findPackages() (debs []*DebPackage, rpms []*RPMPackage, err error) {
+	err = filepath.WalkDir(r.options.Path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(d.Name(), ".") && path != r.options.Path {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(r.options.Path, path)
+		if err != nil {
+			return err
+		}
+
+		switch filepath.Ext(path) {
+		case ".deb":
+			pkg, err := ParseDebPackage(path)
+			if err != nil {
+				return fmt.Errorf("parsing %s: %w", rel, err)
+			}
+
+			pkg.Filename = filepath.ToSlash(rel)
+			debs = append(debs, pkg)
+		case ".rpm":
+			if strings.HasSuffix(path, ".src.rpm") {
+				logrus.Infof("Skipping source package %s", rel)
+
+				return nil
+			}
+
+			pkg, err := ParseRPMPackage(path)
+			if err != nil {
+				return fmt.Errorf("parsing %s: %w", rel, err)
+			}
+
+			pkg.Location = filepath.ToSlash(rel)
+			rpms = append(rpms, pkg)
+		}
+
+		return nil
+	})
+
+	return debs, rpms, err
+}
+
+// writeFile writes the file relative to the repository directory.
+func (r *RepoIndex) writeFile(name string, data []byte) error {
+	path := filepath.Join(r.options.Path, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating directory for %s: %w", name, err)
+	}
+
+	//nolint:gosec // repository metadata is served publicly
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+
+	return nil
+}
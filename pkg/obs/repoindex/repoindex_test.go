@@ -0,0 +1,545 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repoindex
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/clearsign"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+	"github.com/ulikunitz/xz"
+)
+
+const testControl = `Package: %s
+Version: 1.30.0-1.1
+Architecture: %s
+Maintainer: Kubernetes Authors <dev@kubernetes.io>
+Installed-Size: 1024
+Depends: iptables (>= 1.4.21), kubernetes-cni (>= 1.2.0)
+Description: Node agent for Kubernetes clusters
+ Node agent for Kubernetes clusters.
+ .
+ Second paragraph.
+`
+
+// writeTestDeb writes a .deb package whose control archive is compressed
+// with the given extension.
+func writeTestDeb(t *testing.T, path, control, compression string) {
+	t.Helper()
+
+	controlTar := &bytes.Buffer{}
+	tw := tar.NewWriter(controlTar)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./", Typeflag: tar.TypeDir, Mode: 0o755}))
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./control", Mode: 0o644, Size: int64(len(control))}))
+	_, err := tw.Write([]byte(control))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	compressed := &bytes.Buffer{}
+
+	var w io.WriteCloser
+
+	switch compression {
+	case "":
+		w = nopWriteCloser{compressed}
+	case ".gz":
+		w = gzip.NewWriter(compressed)
+	case ".xz":
+		w, err = xz.NewWriter(compressed)
+		require.NoError(t, err)
+	case ".zst":
+		w, err = zstd.NewWriter(compressed)
+		require.NoError(t, err)
+	}
+
+	_, err = w.Write(controlTar.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	ar := &bytes.Buffer{}
+	ar.WriteString(arMagic)
+
+	for _, member := range []struct {
+		name string
+		data []byte
+	}{
+		{"debian-binary", []byte("2.0\n")},
+		{"control.tar" + compression, compressed.Bytes()},
+		{"data.tar.gz", []byte("data")},
+	} {
+		fmt.Fprintf(ar, "%-16s%-12d%-6d%-6d%-8o%-10d`\n", member.name+"/", 0, 0, 0, 0o644, len(member.data))
+		ar.Write(member.data)
+
+		if len(member.data)%2 == 1 {
+			ar.WriteString("\n")
+		}
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, ar.Bytes(), 0o600))
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// rpmTestTag is a tag of an RPM header written by writeTestRPM. Supported
+// values are string, []string, []uint16, []int32 and []int64.
+type rpmTestTag struct {
+	tag   uint32
+	value any
+}
+
+// rpmTestHeader encodes the header structure of the tags.
+func rpmTestHeader(t *testing.T, tags []rpmTestTag) []byte {
+	t.Helper()
+
+	index := &bytes.Buffer{}
+	store := &bytes.Buffer{}
+
+	for _, tag := range tags {
+		var (
+			typ   uint32
+			count int
+			data  = &bytes.Buffer{}
+			align int
+		)
+
+		switch v := tag.value.(type) {
+		case string:
+			typ, count = rpmTypeString, 1
+			data.WriteString(v + "\x00")
+		case []string:
+			typ, count = rpmTypeStringArray, len(v)
+			for _, s := range v {
+				data.WriteString(s + "\x00")
+			}
+		case []uint16:
+			typ, count, align = rpmTypeInt16, len(v), 2
+			require.NoError(t, binary.Write(data, binary.BigEndian, v))
+		case []int32:
+			typ, count, align = rpmTypeInt32, len(v), 4
+			require.NoError(t, binary.Write(data, binary.BigEndian, v))
+		case []int64:
+			typ, count, align = rpmTypeInt64, len(v), 8
+			require.NoError(t, binary.Write(data, binary.BigEndian, v))
+		default:
+			t.Fatalf("unsupported tag value %T", v)
+		}
+
+		for align > 0 && store.Len()%align != 0 {
+			store.WriteByte(0)
+		}
+
+		require.NoError(t, binary.Write(index, binary.BigEndian, []uint32{
+			tag.tag, typ, uint32(store.Len()), uint32(count),
+		}))
+		store.Write(data.Bytes())
+	}
+
+	header := &bytes.Buffer{}
+	header.Write(rpmHeaderMagic)
+	header.Write(make([]byte, 4))
+	require.NoError(t, binary.Write(header, binary.BigEndian, []uint32{
+		uint32(len(tags)), uint32(store.Len()),
+	}))
+	header.Write(index.Bytes())
+	header.Write(store.Bytes())
+
+	return header.Bytes()
+}
+
+// writeTestRPM writes an .rpm package with the header tags and returns the
+// start of the main header.
+func writeTestRPM(t *testing.T, path string, tags []rpmTestTag) int {
+	t.Helper()
+
+	rpm := &bytes.Buffer{}
+	rpm.Write(rpmLeadMagic)
+	rpm.Write(make([]byte, rpmLeadSize-len(rpmLeadMagic)))
+	rpm.Write(rpmTestHeader(t, []rpmTestTag{
+		{rpmSigTagPayloadSize, []int32{4096}},
+		{1004, "md5"},
+	}))
+
+	for rpm.Len()%8 != 0 {
+		rpm.WriteByte(0)
+	}
+
+	headerStart := rpm.Len()
+	rpm.Write(rpmTestHeader(t, tags))
+	rpm.WriteString("payload")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, rpm.Bytes(), 0o600))
+
+	return headerStart
+}
+
+func testRPMTags(name, arch string) []rpmTestTag {
+	return []rpmTestTag{
+		{rpmTagName, name},
+		{rpmTagVersion, "1.30.0"},
+		{rpmTagRelease, "1.1"},
+		{rpmTagSummary, "Node agent for Kubernetes clusters"},
+		{rpmTagDescription, "Node agent for Kubernetes clusters."},
+		{rpmTagBuildTime, []int32{1700000000}},
+		{rpmTagBuildHost, "obs"},
+		{rpmTagSize, []int32{2048}},
+		{rpmTagLicense, "Apache-2.0"},
+		{rpmTagPackager, "Kubernetes Authors <dev@kubernetes.io>"},
+		{rpmTagURL, "https://kubernetes.io"},
+		{rpmTagArch, arch},
+		{rpmTagFileModes, []uint16{0o100755, 0o040755, 0o100644, 0o100644}},
+		{rpmTagSourceRPM, name + "-1.30.0-1.1.src.rpm"},
+		{rpmTagProvideName, []string{name, name + "(x86-64)"}},
+		{rpmTagRequireFlags, []int32{rpmSenseGreater | rpmSenseEqual, rpmSenseRPMLib | rpmSenseLess | rpmSenseEqual, rpmSenseScriptPost}},
+		{rpmTagRequireName, []string{"kubernetes-cni", "rpmlib(CompressedFileNames)", "/bin/sh"}},
+		{rpmTagRequireVersion, []string{"1.2.0", "3.0.4-1", ""}},
+		{rpmTagProvideFlags, []int32{rpmSenseEqual, rpmSenseEqual}},
+		{rpmTagProvideVersion, []string{"1.30.0-1.1", "1:1.30.0-1.1"}},
+		{rpmTagDirIndexes, []int32{0, 1, 2, 3}},
+		{rpmTagBaseNames, []string{name, "manifests", "kubelet", "README.md"}},
+		{rpmTagDirNames, []string{"/usr/bin/", "/etc/kubernetes/", "/etc/sysconfig/", "/usr/share/doc/" + name + "/"}},
+	}
+}
+
+// writeTestKey writes an armored private key, encrypted with the passphrase
+// if not empty.
+func writeTestKey(t *testing.T, path, passphrase string) *openpgp.Entity {
+	t.Helper()
+
+	entity, err := openpgp.NewEntity("Test", "", "test@kubernetes.io", nil)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	w, err := armor.Encode(buf, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+
+	if passphrase != "" {
+		require.NoError(t, entity.EncryptPrivateKeys([]byte(passphrase), nil))
+	}
+
+	require.NoError(t, entity.SerializePrivateWithoutSigning(w, nil))
+	require.NoError(t, w.Close())
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+
+	return entity
+}
+
+func readTestFile(t *testing.T, path string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestParseControl(t *testing.T) {
+	for _, tc := range []struct {
+		control   string
+		expected  []ControlField
+		shouldErr bool
+	}{
+		{ // single paragraph with continuation lines
+			control: "\nPackage: kubelet\nDescription: Node agent\n More text\n .\n",
+			expected: []ControlField{
+				{Name: "Package", Value: "kubelet"},
+				{Name: "Description", Value: "Node agent\n More text\n ."},
+			},
+		},
+		{ // only the first paragraph is parsed
+			control:  "Package: kubelet\n\nPackage: kubeadm\n",
+			expected: []ControlField{{Name: "Package", Value: "kubelet"}},
+		},
+		{ // continuation line without field
+			control:   " invalid\n",
+			shouldErr: true,
+		},
+		{ // line without colon
+			control:   "Package kubelet\n",
+			shouldErr: true,
+		},
+	} {
+		fields, err := ParseControl([]byte(tc.control))
+		if tc.shouldErr {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, fields)
+		}
+	}
+}
+
+func TestParseDebPackage(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, compression := range []string{"", ".gz", ".xz", ".zst"} {
+		path := filepath.Join(dir, "kubelet"+compression+".deb")
+		writeTestDeb(t, path, fmt.Sprintf(testControl, "kubelet", "amd64"), compression)
+
+		pkg, err := ParseDebPackage(path)
+		require.NoError(t, err)
+		require.Equal(t, "kubelet", pkg.Name())
+		require.Equal(t, "1.30.0-1.1", pkg.Version())
+		require.Equal(t, "amd64", pkg.Architecture())
+		require.Equal(t, "Node agent for Kubernetes clusters\n Node agent for Kubernetes clusters.\n .\n Second paragraph.", pkg.Field("description"))
+		require.Len(t, pkg.Control, 7)
+		require.Equal(t, int64(len(readTestFile(t, path))), pkg.Size)
+		require.Len(t, pkg.SHA256, 64)
+	}
+
+	// Missing required field
+	path := filepath.Join(dir, "invalid.deb")
+	writeTestDeb(t, path, "Package: kubelet\n", ".gz")
+	_, err := ParseDebPackage(path)
+	require.ErrorContains(t, err, "no Version field")
+
+	// No ar archive
+	require.NoError(t, os.WriteFile(path, []byte("not a package"), 0o600))
+	_, err = ParseDebPackage(path)
+	require.ErrorContains(t, err, "not a Debian package")
+}
+
+func TestParseRPMPackage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubelet.rpm")
+	headerStart := writeTestRPM(t, path, testRPMTags("kubelet", "x86_64"))
+
+	pkg, err := ParseRPMPackage(path)
+	require.NoError(t, err)
+
+	require.Equal(t, "kubelet", pkg.Name)
+	require.Equal(t, "0", pkg.Epoch)
+	require.Equal(t, "1.30.0", pkg.Version)
+	require.Equal(t, "1.1", pkg.Release)
+	require.Equal(t, "x86_64", pkg.Arch)
+	require.Equal(t, "Apache-2.0", pkg.License)
+	require.Equal(t, "kubelet-1.30.0-1.1.src.rpm", pkg.SourceRPM)
+	require.Equal(t, int64(1700000000), pkg.BuildTime)
+	require.Equal(t, int64(2048), pkg.InstalledSize)
+	require.Equal(t, int64(4096), pkg.ArchiveSize)
+	require.Equal(t, int64(headerStart), pkg.HeaderStart)
+	require.Equal(t, int64(len(readTestFile(t, path))-len("payload")), pkg.HeaderEnd)
+
+	require.Equal(t, []RPMDependency{
+		{Name: "kubelet", Flags: "EQ", Epoch: "0", Version: "1.30.0", Release: "1.1"},
+		{Name: "kubelet(x86-64)", Flags: "EQ", Epoch: "1", Version: "1.30.0", Release: "1.1"},
+	}, pkg.Provides)
+	require.Equal(t, []RPMDependency{
+		{Name: "kubernetes-cni", Flags: "GE", Epoch: "0", Version: "1.2.0"},
+		{Name: "/bin/sh", Pre: true},
+	}, pkg.Requires)
+	require.Empty(t, pkg.Conflicts)
+
+	require.Equal(t, []RPMFile{
+		{Path: "/usr/bin/kubelet"},
+		{Path: "/etc/kubernetes/manifests", Dir: true},
+		{Path: "/etc/sysconfig/kubelet"},
+	}, pkg.Files)
+
+	// Source packages
+	tags := testRPMTags("kubelet", "x86_64")
+	writeTestRPM(t, path, append(tags[:13:13], tags[14:]...))
+	_, err = ParseRPMPackage(path)
+	require.ErrorContains(t, err, "source packages are not supported")
+
+	// No RPM package
+	require.NoError(t, os.WriteFile(path, []byte("not a package"), 0o600))
+	_, err = ParseRPMPackage(path)
+	require.ErrorContains(t, err, "not an RPM package")
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	keyPath := filepath.Join(t.TempDir(), "key.asc")
+	entity := writeTestKey(t, keyPath, "secret")
+	keyring := openpgp.EntityList{entity}
+	date := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	writeTestDeb(t, filepath.Join(dir, "amd64", "kubelet_1.30.0-1.1_amd64.deb"), fmt.Sprintf(testControl, "kubelet", "amd64"), ".xz")
+	writeTestDeb(t, filepath.Join(dir, "arm64", "kubelet_1.30.0-1.1_arm64.deb"), fmt.Sprintf(testControl, "kubelet", "arm64"), ".zst")
+	writeTestDeb(t, filepath.Join(dir, "all", "kubeadm_1.30.0-1.1_all.deb"), fmt.Sprintf(testControl, "kubeadm", "all"), ".gz")
+	writeTestRPM(t, filepath.Join(dir, "x86_64", "kubelet-1.30.0-1.1.x86_64.rpm"), testRPMTags("kubelet", "x86_64"))
+	writeTestRPM(t, filepath.Join(dir, "src", "kubelet-1.30.0-1.1.src.rpm"), nil)
+	writeTestDeb(t, filepath.Join(dir, ".hidden", "ignored.deb"), "invalid", "")
+
+	opts := DefaultOptions()
+	opts.Path = dir
+	opts.SigningKeyPath = keyPath
+	opts.SigningKeyPassphrase = "secret"
+	opts.Description = "Kubernetes packages"
+	require.NoError(t, opts.Validate())
+
+	sut := New(opts)
+	sut.now = func() time.Time { return date }
+	require.NoError(t, sut.Run())
+
+	// apt
+	packages := string(readTestFile(t, filepath.Join(dir, aptPackagesFile)))
+	stanzas := strings.Split(packages, "\n\n")
+	require.Len(t, stanzas, 3)
+	require.True(t, strings.HasPrefix(stanzas[0], "Package: kubeadm\n"))
+	require.Contains(t, stanzas[0], "Filename: all/kubeadm_1.30.0-1.1_all.deb\n")
+	require.Contains(t, stanzas[1], "Architecture: amd64\n")
+	require.Contains(t, stanzas[1], " .\n Second paragraph.\nFilename: amd64/kubelet_1.30.0-1.1_amd64.deb\nSize: ")
+	require.Contains(t, stanzas[2], "Filename: arm64/kubelet_1.30.0-1.1_arm64.deb\n")
+
+	packagesGzip, err := gzipData([]byte(packages))
+	require.NoError(t, err)
+	require.Equal(t, packagesGzip, readTestFile(t, filepath.Join(dir, aptPackagesGzip)))
+
+	release := readTestFile(t, filepath.Join(dir, aptReleaseFile))
+	require.True(t, strings.HasPrefix(string(release), "Origin: Kubernetes\nLabel: Kubernetes\n"+
+		"Date: Fri, 02 Jan 2026 03:04:05 UTC\nArchitectures: amd64 arm64\nDescription: Kubernetes packages\nMD5Sum:\n"))
+	require.Contains(t, string(release), fmt.Sprintf("SHA256:\n %s %d Packages\n %s %d Packages.gz\n",
+		sha256Hex([]byte(packages)), len(packages), sha256Hex(packagesGzip), len(packagesGzip)))
+
+	block, _ := clearsign.Decode(readTestFile(t, filepath.Join(dir, aptInReleaseFile)))
+	require.NotNil(t, block)
+	// The line break before the signature is not part of the signed text
+	require.Equal(t, bytes.TrimSuffix(release, []byte("\n")), block.Plaintext)
+	_, err = block.VerifySignature(keyring, nil)
+	require.NoError(t, err)
+
+	_, err = openpgp.CheckArmoredDetachedSignature(
+		keyring, bytes.NewReader(release), bytes.NewReader(readTestFile(t, filepath.Join(dir, aptReleaseSig))), nil,
+	)
+	require.NoError(t, err)
+
+	publicKey, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(readTestFile(t, filepath.Join(dir, aptReleaseKey))))
+	require.NoError(t, err)
+	require.Len(t, publicKey, 1)
+	require.Equal(t, entity.PrimaryKey.KeyId, publicKey[0].PrimaryKey.KeyId)
+	require.Nil(t, publicKey[0].PrivateKey)
+
+	// yum
+	repomdData := readTestFile(t, filepath.Join(dir, repodataDir, yumRepomdFile))
+	repomd := &yumRepomd{}
+	require.NoError(t, xml.Unmarshal(repomdData, repomd))
+	require.Equal(t, date.Unix(), repomd.Revision)
+	require.Len(t, repomd.Data, 1)
+	require.Equal(t, "repodata/primary.xml.gz", repomd.Data[0].Location.Href)
+
+	primaryGzip := readTestFile(t, filepath.Join(dir, repodataDir, yumPrimaryFile))
+	require.Equal(t, sha256Hex(primaryGzip), repomd.Data[0].Checksum.Value)
+	require.Equal(t, len(primaryGzip), repomd.Data[0].Size)
+
+	gz, err := gzip.NewReader(bytes.NewReader(primaryGzip))
+	require.NoError(t, err)
+	primary, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	require.Equal(t, sha256Hex(primary), repomd.Data[0].OpenChecksum.Value)
+
+	require.Contains(t, string(primary), `<metadata xmlns="http://linux.duke.edu/metadata/common" xmlns:rpm="http://linux.duke.edu/metadata/rpm" packages="1">`)
+	require.Contains(t, string(primary), `<version epoch="0" ver="1.30.0" rel="1.1"></version>`)
+	require.Contains(t, string(primary), `<location href="x86_64/kubelet-1.30.0-1.1.x86_64.rpm"></location>`)
+	require.Contains(t, string(primary), `<rpm:entry name="kubernetes-cni" flags="GE" epoch="0" ver="1.2.0"></rpm:entry>`)
+	require.Contains(t, string(primary), `<rpm:entry name="/bin/sh" pre="1"></rpm:entry>`)
+	require.Contains(t, string(primary), `<file type="dir">/etc/kubernetes/manifests</file>`)
+	require.NotContains(t, string(primary), "rpmlib")
+	require.NotContains(t, string(primary), "rpm:conflicts")
+
+	_, err = openpgp.CheckArmoredDetachedSignature(
+		keyring, bytes.NewReader(repomdData), bytes.NewReader(readTestFile(t, filepath.Join(dir, repodataDir, yumRepomdSig))), nil,
+	)
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(dir, repodataDir, yumRepomdKey))
+}
+
+func TestRunWithoutSigningKey(t *testing.T) {
+	dir := t.TempDir()
+	writeTestDeb(t, filepath.Join(dir, "kubectl.deb"), fmt.Sprintf(testControl, "kubectl", "amd64"), ".gz")
+
+	opts := DefaultOptions()
+	opts.Path = dir
+	require.NoError(t, New(opts).Run())
+
+	require.FileExists(t, filepath.Join(dir, aptReleaseFile))
+	require.NoFileExists(t, filepath.Join(dir, aptInReleaseFile))
+	require.NoFileExists(t, filepath.Join(dir, aptReleaseSig))
+	require.NoDirExists(t, filepath.Join(dir, repodataDir))
+
+	// No packages
+	opts.Path = t.TempDir()
+	require.ErrorContains(t, New(opts).Run(), "no .deb or .rpm packages found")
+
+	// Wrong passphrase
+	keyPath := filepath.Join(t.TempDir(), "key.asc")
+	writeTestKey(t, keyPath, "secret")
+	opts.Path = dir
+	opts.SigningKeyPath = keyPath
+	opts.SigningKeyPassphrase = "wrong"
+	require.ErrorContains(t, New(opts).Run(), "decrypting key")
+}
+
+func TestValidate(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+
+	for _, tc := range []struct {
+		modify    func(*Options)
+		shouldErr bool
+	}{
+		{ // default options
+			modify: func(*Options) {},
+		},
+		{ // directory doesn't exist
+			modify:    func(o *Options) { o.Path = "does-not-exist" },
+			shouldErr: true,
+		},
+		{ // path is not a directory
+			modify:    func(o *Options) { o.Path = file },
+			shouldErr: true,
+		},
+		{ // signing key doesn't exist
+			modify:    func(o *Options) { o.SigningKeyPath = "does-not-exist" },
+			shouldErr: true,
+		},
+		{ // no origin
+			modify:    func(o *Options) { o.Origin = "" },
+			shouldErr: true,
+		},
+	} {
+		opts := DefaultOptions()
+		opts.Path = t.TempDir()
+		tc.modify(opts)
+
+		err := opts.Validate()
+		if tc.shouldErr {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,487 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repoindex
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// RPM file format constants, as described in
+// https://rpm-software-management.github.io/rpm/manual/format.html
+const (
+	rpmLeadSize        = 96
+	rpmIndexEntrySize  = 16
+	rpmHeaderIntroSize = 16
+)
+
+var (
+	rpmLeadMagic   = []byte{0xed, 0xab, 0xee, 0xdb}
+	rpmHeaderMagic = []byte{0x8e, 0xad, 0xe8, 0x01}
+)
+
+// RPM header data types.
+const (
+	rpmTypeChar        = 1
+	rpmTypeInt8        = 2
+	rpmTypeInt16       = 3
+	rpmTypeInt32       = 4
+	rpmTypeInt64       = 5
+	rpmTypeString      = 6
+	rpmTypeStringArray = 8
+	rpmTypeI18NString  = 9
+)
+
+// RPM header tags used for the repository metadata.
+const (
+	rpmTagName            = 1000
+	rpmTagVersion         = 1001
+	rpmTagRelease         = 1002
+	rpmTagEpoch           = 1003
+	rpmTagSummary         = 1004
+	rpmTagDescription     = 1005
+	rpmTagBuildTime       = 1006
+	rpmTagBuildHost       = 1007
+	rpmTagSize            = 1009
+	rpmTagVendor          = 1011
+	rpmTagLicense         = 1014
+	rpmTagPackager        = 1015
+	rpmTagGroup           = 1016
+	rpmTagURL             = 1020
+	rpmTagArch            = 1022
+	rpmTagFileModes       = 1030
+	rpmTagSourceRPM       = 1044
+	rpmTagArchiveSize     = 1046
+	rpmTagProvideName     = 1047
+	rpmTagRequireFlags    = 1048
+	rpmTagRequireName     = 1049
+	rpmTagRequireVersion  = 1050
+	rpmTagConflictFlags   = 1053
+	rpmTagConflictName    = 1054
+	rpmTagConflictVersion = 1055
+	rpmTagObsoleteName    = 1090
+	rpmTagProvideFlags    = 1112
+	rpmTagProvideVersion  = 1113
+	rpmTagObsoleteFlags   = 1114
+	rpmTagObsoleteVersion = 1115
+	rpmTagDirIndexes      = 1116
+	rpmTagBaseNames       = 1117
+	rpmTagDirNames        = 1118
+	rpmTagLongSize        = 5009
+
+	// rpmTagLongArchiveSize is used in both, the signature and main header.
+	rpmTagLongArchiveSize = 271
+
+	// rpmSigTagPayloadSize is the archive size in the signature header.
+	rpmSigTagPayloadSize = 1007
+)
+
+// RPM dependency flags.
+const (
+	rpmSenseLess       = 1 << 1
+	rpmSenseGreater    = 1 << 2
+	rpmSenseEqual      = 1 << 3
+	rpmSensePrereq     = 1 << 6
+	rpmSenseScriptPre  = 1 << 9
+	rpmSenseScriptPost = 1 << 10
+	rpmSenseRPMLib     = 1 << 24
+
+	rpmSenseCompare = rpmSenseLess | rpmSenseGreater | rpmSenseEqual
+	rpmSensePre     = rpmSensePrereq | rpmSenseScriptPre | rpmSenseScriptPost
+)
+
+// rpmFlags maps the comparison flags to the repository metadata flags.
+var rpmFlags = map[uint32]string{
+	rpmSenseLess:                    "LT",
+	rpmSenseGreater:                 "GT",
+	rpmSenseEqual:                   "EQ",
+	rpmSenseLess | rpmSenseEqual:    "LE",
+	rpmSenseGreater | rpmSenseEqual: "GE",
+}
+
+const (
+	fileModeTypeMask = 0o170000
+	fileModeDir      = 0o040000
+)
+
+// RPMPackage is a binary RPM package.
+type RPMPackage struct {
+	Name        string
+	Epoch       string
+	Version     string
+	Release     string
+	Arch        string
+	Summary     string
+	Description string
+	Packager    string
+	URL         string
+	License     string
+	Vendor      string
+	Group       string
+	BuildHost   string
+	SourceRPM   string
+	BuildTime   int64
+
+	// Location is the path of the package relative to the repository root.
+	Location string
+
+	// FileTime is the modification time of the package file.
+	FileTime int64
+
+	PackageSize   int64
+	InstalledSize int64
+	ArchiveSize   int64
+
+	// HeaderStart and HeaderEnd are the byte range of the main header.
+	HeaderStart int64
+	HeaderEnd   int64
+
+	SHA256 string
+
+	Provides  []RPMDependency
+	Requires  []RPMDependency
+	Conflicts []RPMDependency
+	Obsoletes []RPMDependency
+
+	// Files are the files of the package which belong into the primary
+	// metadata, like executables and configuration files.
+	Files []RPMFile
+}
+
+// RPMDependency is a dependency or capability of an RPM package.
+type RPMDependency struct {
+	Name    string
+	Flags   string
+	Epoch   string
+	Version string
+	Release string
+	Pre     bool
+}
+
+// RPMFile is a file of an RPM package.
+type RPMFile struct {
+	Path string
+	Dir  bool
+}
+
+// ParseRPMPackage reads the headers and checksum of an .rpm package.
+func ParseRPMPackage(file string) (*RPMPackage, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading package: %w", err)
+	}
+
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading package: %w", err)
+	}
+
+	if len(data) < rpmLeadSize || !bytes.HasPrefix(data, rpmLeadMagic) {
+		return nil, errors.New("not an RPM package: missing lead")
+	}
+
+	signature, sigEnd, err := parseRPMHeader(data, rpmLeadSize)
+	if err != nil {
+		return nil, fmt.Errorf("parsing signature header: %w", err)
+	}
+
+	// The signature header is padded to a multiple of 8 bytes
+	headerStart := sigEnd + (8-sigEnd%8)%8
+
+	header, headerEnd, err := parseRPMHeader(data, headerStart)
+	if err != nil {
+		return nil, fmt.Errorf("parsing header: %w", err)
+	}
+
+	if header.string(rpmTagSourceRPM) == "" {
+		return nil, errors.New("source packages are not supported")
+	}
+
+	pkg := &RPMPackage{
+		Name:        header.string(rpmTagName),
+		Epoch:       strconv.FormatInt(header.int(rpmTagEpoch), 10),
+		Version:     header.string(rpmTagVersion),
+		Release:     header.string(rpmTagRelease),
+		Arch:        header.string(rpmTagArch),
+		Summary:     header.string(rpmTagSummary),
+		Description: header.string(rpmTagDescription),
+		Packager:    header.string(rpmTagPackager),
+		URL:         header.string(rpmTagURL),
+		License:     header.string(rpmTagLicense),
+		Vendor:      header.string(rpmTagVendor),
+		Group:       header.string(rpmTagGroup),
+		BuildHost:   header.string(rpmTagBuildHost),
+		SourceRPM:   header.string(rpmTagSourceRPM),
+		BuildTime:   header.int(rpmTagBuildTime),
+
+		FileTime:      info.ModTime().Unix(),
+		PackageSize:   int64(len(data)),
+		InstalledSize: max(header.int(rpmTagSize), header.int(rpmTagLongSize)),
+		ArchiveSize: max(
+			header.int(rpmTagArchiveSize), header.int(rpmTagLongArchiveSize),
+			signature.int(rpmSigTagPayloadSize), signature.int(rpmTagLongArchiveSize),
+		),
+		HeaderStart: int64(headerStart),
+		HeaderEnd:   int64(headerEnd),
+		SHA256:      sha256Hex(data),
+
+		Provides:  header.dependencies(rpmTagProvideName, rpmTagProvideFlags, rpmTagProvideVersion),
+		Requires:  header.dependencies(rpmTagRequireName, rpmTagRequireFlags, rpmTagRequireVersion),
+		Conflicts: header.dependencies(rpmTagConflictName, rpmTagConflictFlags, rpmTagConflictVersion),
+		Obsoletes: header.dependencies(rpmTagObsoleteName, rpmTagObsoleteFlags, rpmTagObsoleteVersion),
+		Files:     header.primaryFiles(),
+	}
+
+	if pkg.Name == "" || pkg.Version == "" || pkg.Arch == "" {
+		return nil, errors.New("header has no name, version or architecture")
+	}
+
+	return pkg, nil
+}
+
+// rpmIndexEntry is an entry of the index of an RPM header.
+type rpmIndexEntry struct {
+	typ    uint32
+	offset uint32
+	count  uint32
+}
+
+// rpmHeader is a parsed RPM header structure.
+type rpmHeader struct {
+	index map[uint32]rpmIndexEntry
+	store []byte
+}
+
+// parseRPMHeader parses the header structure at the offset and returns the
+// end offset of the header.
+func parseRPMHeader(data []byte, offset int) (*rpmHeader, int, error) {
+	if offset+rpmHeaderIntroSize > len(data) || !bytes.HasPrefix(data[offset:], rpmHeaderMagic) {
+		return nil, 0, errors.New("missing header magic")
+	}
+
+	indexCount := int(binary.BigEndian.Uint32(data[offset+8:]))
+	storeSize := int(binary.BigEndian.Uint32(data[offset+12:]))
+	indexStart := offset + rpmHeaderIntroSize
+	storeStart := indexStart + indexCount*rpmIndexEntrySize
+	end := storeStart + storeSize
+
+	if indexCount < 0 || storeSize < 0 || end > len(data) {
+		return nil, 0, errors.New("header exceeds the file")
+	}
+
+	header := &rpmHeader{
+		index: make(map[uint32]rpmIndexEntry, indexCount),
+		store: data[storeStart:end],
+	}
+
+	for i := range indexCount {
+		entry := data[indexStart+i*rpmIndexEntrySize:]
+		header.index[binary.BigEndian.Uint32(entry)] = rpmIndexEntry{
+			typ:    binary.BigEndian.Uint32(entry[4:]),
+			offset: binary.BigEndian.Uint32(entry[8:]),
+			count:  binary.BigEndian.Uint32(entry[12:]),
+		}
+	}
+
+	return header, end, nil
+}
+
+// strings returns the values of a string, string array or i18n string tag.
+func (h *rpmHeader) strings(tag uint32) []string {
+	entry, ok := h.index[tag]
+	if !ok || int(entry.offset) > len(h.store) {
+		return nil
+	}
+
+	switch entry.typ {
+	case rpmTypeString, rpmTypeStringArray, rpmTypeI18NString:
+	default:
+		return nil
+	}
+
+	count := int(entry.count)
+	if entry.typ == rpmTypeString {
+		count = 1
+	}
+
+	values := make([]string, 0, count)
+	rest := h.store[entry.offset:]
+
+	for range count {
+		value, remaining, ok := bytes.Cut(rest, []byte{0})
+		if !ok {
+			break
+		}
+
+		values = append(values, string(value))
+		rest = remaining
+	}
+
+	return values
+}
+
+// string returns the first value of a string tag. For i18n strings, this is
+// the untranslated value.
+func (h *rpmHeader) string(tag uint32) string {
+	values := h.strings(tag)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// ints returns the values of an integer tag.
+func (h *rpmHeader) ints(tag uint32) []int64 {
+	entry, ok := h.index[tag]
+	if !ok {
+		return nil
+	}
+
+	var size int
+
+	switch entry.typ {
+	case rpmTypeChar, rpmTypeInt8:
+		size = 1
+	case rpmTypeInt16:
+		size = 2
+	case rpmTypeInt32:
+		size = 4
+	case rpmTypeInt64:
+		size = 8
+	default:
+		return nil
+	}
+
+	start := int(entry.offset)
+	if start+int(entry.count)*size > len(h.store) {
+		return nil
+	}
+
+	values := make([]int64, 0, entry.count)
+
+	for i := range int(entry.count) {
+		b := h.store[start+i*size:]
+
+		switch size {
+		case 1:
+			values = append(values, int64(b[0]))
+		case 2:
+			values = append(values, int64(binary.BigEndian.Uint16(b)))
+		case 4:
+			values = append(values, int64(binary.BigEndian.Uint32(b)))
+		case 8:
+			values = append(values, int64(binary.BigEndian.Uint64(b))) //nolint:gosec // sizes fit into int64
+		}
+	}
+
+	return values
+}
+
+// int returns the first value of an integer tag or 0 if the tag doesn't
+// exist.
+func (h *rpmHeader) int(tag uint32) int64 {
+	values := h.ints(tag)
+	if len(values) == 0 {
+		return 0
+	}
+
+	return values[0]
+}
+
+// dependencies returns the dependencies of the name, flags and version tags.
+// Dependencies on rpmlib features are omitted, like createrepo does.
+func (h *rpmHeader) dependencies(nameTag, flagsTag, versionTag uint32) []RPMDependency {
+	names := h.strings(nameTag)
+	flags := h.ints(flagsTag)
+	versions := h.strings(versionTag)
+	deps := []RPMDependency{}
+
+	for i, name := range names {
+		var flag uint32
+		if i < len(flags) {
+			flag = uint32(flags[i]) //nolint:gosec // flags are stored as uint32
+		}
+
+		if flag&rpmSenseRPMLib != 0 || strings.HasPrefix(name, "rpmlib(") {
+			continue
+		}
+
+		dep := RPMDependency{
+			Name: name,
+			Pre:  flag&rpmSensePre != 0,
+		}
+
+		if i < len(versions) && versions[i] != "" {
+			dep.Flags = rpmFlags[flag&rpmSenseCompare]
+			dep.Epoch, dep.Version, dep.Release = splitEVR(versions[i])
+		}
+
+		deps = append(deps, dep)
+	}
+
+	return deps
+}
+
+// primaryFiles returns the files which are part of the primary metadata:
+// files in /etc, executables and /usr/lib/sendmail.
+func (h *rpmHeader) primaryFiles() []RPMFile {
+	baseNames := h.strings(rpmTagBaseNames)
+	dirNames := h.strings(rpmTagDirNames)
+	dirIndexes := h.ints(rpmTagDirIndexes)
+	modes := h.ints(rpmTagFileModes)
+	files := []RPMFile{}
+
+	for i, baseName := range baseNames {
+		if i >= len(dirIndexes) || int(dirIndexes[i]) >= len(dirNames) {
+			break
+		}
+
+		path := dirNames[dirIndexes[i]] + baseName
+		if !strings.HasPrefix(path, "/etc/") && !strings.Contains(path, "bin/") && path != "/usr/lib/sendmail" {
+			continue
+		}
+
+		files = append(files, RPMFile{
+			Path: path,
+			Dir:  i < len(modes) && modes[i]&fileModeTypeMask == fileModeDir,
+		})
+	}
+
+	return files
+}
+
+// splitEVR splits a version in the format [epoch:]version[-release].
+func splitEVR(evr string) (epoch, version, release string) {
+	epoch = "0"
+
+	if e, rest, ok := strings.Cut(evr, ":"); ok {
+		epoch, evr = e, rest
+	}
+
+	version = evr
+	if i := strings.LastIndex(evr, "-"); i >= 0 {
+		version, release = evr[:i], evr[i+1:]
+	}
+
+	return epoch, version, release
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repoindex
+
+import (
+	"bytes"
+	"crypto"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+)
+
+// Signer signs repository metadata with an OpenPGP key.
+type Signer struct {
+	entity *openpgp.Entity
+}
+
+// NewSigner loads the armored OpenPGP private key, as exported by
+// `gpg --armor --export-secret-keys`, and decrypts it with the passphrase if
+// it is encrypted.
+func NewSigner(keyPath, passphrase string) (*Signer, error) {
+	f, err := os.Open(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("opening key: %w", err)
+	}
+	defer f.Close()
+
+	entities, err := openpgp.ReadArmoredKeyRing(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading key: %w", err)
+	}
+
+	if len(entities) != 1 {
+		return nil, fmt.Errorf("expected exactly one key in %s, got %d", keyPath, len(entities))
+	}
+
+	entity := entities[0]
+	if entity.PrivateKey == nil {
+		return nil, fmt.Errorf("%s does not contain a private key", keyPath)
+	}
+
+	if err := entity.DecryptPrivateKeys([]byte(passphrase)); err != nil {
+		return nil, fmt.Errorf("decrypting key: %w", err)
+	}
+
+	return &Signer{entity: entity}, nil
+}
+
+// ClearSign returns the cleartext signed data, as used for the InRelease
+// file. The message is assembled as described in RFC 4880, section 7 instead
+// of using the clearsign package, because it omits the armor checksum, which
+// older gpgv versions used by apt require.
+func (s *Signer) ClearSign(data []byte) ([]byte, error) {
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+
+	text := strings.Join(lines, "\n")
+
+	signature := &bytes.Buffer{}
+	if err := openpgp.ArmoredDetachSignText(
+		signature, s.entity, strings.NewReader(text), &packet.Config{DefaultHash: crypto.SHA256},
+	); err != nil {
+		return nil, fmt.Errorf("signing data: %w", err)
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("-----BEGIN PGP SIGNED MESSAGE-----\nHash: SHA256\n\n")
+
+	for _, line := range lines {
+		// Dash-escape lines which could be confused with armor headers
+		if strings.HasPrefix(line, "-") {
+			buf.WriteString("- ")
+		}
+
+		buf.WriteString(line + "\n")
+	}
+
+	buf.Write(signature.Bytes())
+	buf.WriteString("\n")
+
+	return buf.Bytes(), nil
+}
+
+// DetachSign returns an armored detached signature of the data.
+func (s *Signer) DetachSign(data []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := openpgp.ArmoredDetachSign(buf, s.entity, bytes.NewReader(data), nil); err != nil {
+		return nil, fmt.Errorf("signing data: %w", err)
+	}
+
+	buf.WriteString("\n")
+
+	return buf.Bytes(), nil
+}
+
+// PublicKey returns the armored public key, which clients use to verify the
+// repository metadata.
+func (s *Signer) PublicKey() ([]byte, error) {
+	buf := &bytes.Buffer{}
+
+	w, err := armor.Encode(buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating armor encoder: %w", err)
+	}
+
+	if err := s.entity.Serialize(w); err != nil {
+		return nil, fmt.Errorf("serializing public key: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("serializing public key: %w", err)
+	}
+
+	buf.WriteString("\n")
+
+	return buf.Bytes(), nil
+}
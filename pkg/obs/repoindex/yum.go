@@ -0,0 +1,327 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repoindex
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Files of the yum repository metadata.
+const (
+	yumRepomdFile    = "repomd.xml"
+	yumRepomdSig     = "repomd.xml.asc"
+	yumRepomdKey     = "repomd.xml.key"
+	yumPrimaryFile   = "primary.xml.gz"
+	yumChecksumType  = "sha256"
+	yumCommonXMLNS   = "http://linux.duke.edu/metadata/common"
+	yumRepoXMLNS     = "http://linux.duke.edu/metadata/repo"
+	yumRPMXMLNS      = "http://linux.duke.edu/metadata/rpm"
+	yumPackageType   = "rpm"
+	yumPrimaryType   = "primary"
+	yumFileTypeDir   = "dir"
+	yumPkgIDChecksum = "YES"
+)
+
+// yumPrimary is the primary metadata of a yum repository.
+type yumPrimary struct {
+	XMLName  xml.Name     `xml:"metadata"`
+	XMLNS    string       `xml:"xmlns,attr"`
+	XMLNSRPM string       `xml:"xmlns:rpm,attr"`
+	Count    int          `xml:"packages,attr"`
+	Packages []yumPackage `xml:"package"`
+}
+
+type yumPackage struct {
+	Type        string      `xml:"type,attr"`
+	Name        string      `xml:"name"`
+	Arch        string      `xml:"arch"`
+	Version     yumVersion  `xml:"version"`
+	Checksum    yumChecksum `xml:"checksum"`
+	Summary     string      `xml:"summary"`
+	Description string      `xml:"description"`
+	Packager    string      `xml:"packager"`
+	URL         string      `xml:"url"`
+	Time        yumTime     `xml:"time"`
+	Size        yumSize     `xml:"size"`
+	Location    yumLocation `xml:"location"`
+	Format      yumFormat   `xml:"format"`
+}
+
+type yumVersion struct {
+	Epoch   string `xml:"epoch,attr"`
+	Version string `xml:"ver,attr"`
+	Release string `xml:"rel,attr"`
+}
+
+type yumChecksum struct {
+	Type  string `xml:"type,attr"`
+	PkgID string `xml:"pkgid,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+type yumTime struct {
+	File  int64 `xml:"file,attr"`
+	Build int64 `xml:"build,attr"`
+}
+
+type yumSize struct {
+	Package   int64 `xml:"package,attr"`
+	Installed int64 `xml:"installed,attr"`
+	Archive   int64 `xml:"archive,attr"`
+}
+
+type yumLocation struct {
+	Href string `xml:"href,attr"`
+}
+
+type yumFormat struct {
+	License     string         `xml:"rpm:license"`
+	Vendor      string         `xml:"rpm:vendor"`
+	Group       string         `xml:"rpm:group"`
+	BuildHost   string         `xml:"rpm:buildhost"`
+	SourceRPM   string         `xml:"rpm:sourcerpm"`
+	HeaderRange yumHeaderRange `xml:"rpm:header-range"`
+	Provides    *yumEntries    `xml:"rpm:provides"`
+	Requires    *yumEntries    `xml:"rpm:requires"`
+	Conflicts   *yumEntries    `xml:"rpm:conflicts"`
+	Obsoletes   *yumEntries    `xml:"rpm:obsoletes"`
+	Files       []yumFile      `xml:"file"`
+}
+
+type yumHeaderRange struct {
+	Start int64 `xml:"start,attr"`
+	End   int64 `xml:"end,attr"`
+}
+
+type yumEntries struct {
+	Entries []yumEntry `xml:"rpm:entry"`
+}
+
+type yumEntry struct {
+	Name    string `xml:"name,attr"`
+	Flags   string `xml:"flags,attr,omitempty"`
+	Epoch   string `xml:"epoch,attr,omitempty"`
+	Version string `xml:"ver,attr,omitempty"`
+	Release string `xml:"rel,attr,omitempty"`
+	Pre     string `xml:"pre,attr,omitempty"`
+}
+
+type yumFile struct {
+	Type string `xml:"type,attr,omitempty"`
+	Path string `xml:",chardata"`
+}
+
+// yumRepomd is the index of the yum repository metadata.
+type yumRepomd struct {
+	XMLName  xml.Name        `xml:"repomd"`
+	XMLNS    string          `xml:"xmlns,attr"`
+	XMLNSRPM string          `xml:"xmlns:rpm,attr"`
+	Revision int64           `xml:"revision"`
+	Data     []yumRepomdData `xml:"data"`
+}
+
+type yumRepomdData struct {
+	Type         string      `xml:"type,attr"`
+	Checksum     yumChecksum `xml:"checksum"`
+	OpenChecksum yumChecksum `xml:"open-checksum"`
+	Location     yumLocation `xml:"location"`
+	Timestamp    int64       `xml:"timestamp"`
+	Size         int         `xml:"size"`
+	OpenSize     int         `xml:"open-size"`
+}
+
+// writeYumMetadata writes the repodata directory of a yum repository, which
+// clients add via `baseurl=<url>`.
+func (r *RepoIndex) writeYumMetadata(rpms []*RPMPackage, signer *Signer, date time.Time) error {
+	primary, err := yumPrimaryXML(rpms)
+	if err != nil {
+		return fmt.Errorf("generating primary metadata: %w", err)
+	}
+
+	primaryGzip, err := gzipData(primary)
+	if err != nil {
+		return fmt.Errorf("compressing primary metadata: %w", err)
+	}
+
+	primaryHref := path.Join(repodataDir, yumPrimaryFile)
+	repomd, err := marshalYumXML(&yumRepomd{
+		XMLNS:    yumRepoXMLNS,
+		XMLNSRPM: yumRPMXMLNS,
+		Revision: date.Unix(),
+		Data: []yumRepomdData{{
+			Type:         yumPrimaryType,
+			Checksum:     yumChecksum{Type: yumChecksumType, Value: sha256Hex(primaryGzip)},
+			OpenChecksum: yumChecksum{Type: yumChecksumType, Value: sha256Hex(primary)},
+			Location:     yumLocation{Href: primaryHref},
+			Timestamp:    date.Unix(),
+			Size:         len(primaryGzip),
+			OpenSize:     len(primary),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("generating %s: %w", yumRepomdFile, err)
+	}
+
+	if err := r.writeFile(primaryHref, primaryGzip); err != nil {
+		return err
+	}
+
+	if err := r.writeFile(path.Join(repodataDir, yumRepomdFile), repomd); err != nil {
+		return err
+	}
+
+	if signer == nil {
+		return nil
+	}
+
+	signature, err := signer.DetachSign(repomd)
+	if err != nil {
+		return fmt.Errorf("signing %s: %w", yumRepomdFile, err)
+	}
+
+	publicKey, err := signer.PublicKey()
+	if err != nil {
+		return err
+	}
+
+	if err := r.writeFile(path.Join(repodataDir, yumRepomdSig), signature); err != nil {
+		return err
+	}
+
+	return r.writeFile(path.Join(repodataDir, yumRepomdKey), publicKey)
+}
+
+// yumPrimaryXML returns the primary metadata of the packages, ordered by
+// name, version and architecture.
+func yumPrimaryXML(rpms []*RPMPackage) ([]byte, error) {
+	sorted := slices.Clone(rpms)
+	slices.SortFunc(sorted, func(a, b *RPMPackage) int {
+		return cmp.Or(
+			strings.Compare(a.Name, b.Name),
+			strings.Compare(a.Version, b.Version),
+			strings.Compare(a.Release, b.Release),
+			strings.Compare(a.Arch, b.Arch),
+			strings.Compare(a.Location, b.Location),
+		)
+	})
+
+	primary := &yumPrimary{
+		XMLNS:    yumCommonXMLNS,
+		XMLNSRPM: yumRPMXMLNS,
+		Count:    len(sorted),
+		Packages: make([]yumPackage, 0, len(sorted)),
+	}
+
+	for _, rpm := range sorted {
+		files := make([]yumFile, 0, len(rpm.Files))
+		for _, f := range rpm.Files {
+			file := yumFile{Path: f.Path}
+			if f.Dir {
+				file.Type = yumFileTypeDir
+			}
+
+			files = append(files, file)
+		}
+
+		primary.Packages = append(primary.Packages, yumPackage{
+			Type:        yumPackageType,
+			Name:        rpm.Name,
+			Arch:        rpm.Arch,
+			Version:     yumVersion{Epoch: rpm.Epoch, Version: rpm.Version, Release: rpm.Release},
+			Checksum:    yumChecksum{Type: yumChecksumType, PkgID: yumPkgIDChecksum, Value: rpm.SHA256},
+			Summary:     rpm.Summary,
+			Description: rpm.Description,
+			Packager:    rpm.Packager,
+			URL:         rpm.URL,
+			Time:        yumTime{File: rpm.FileTime, Build: rpm.BuildTime},
+			Size: yumSize{
+				Package:   rpm.PackageSize,
+				Installed: rpm.InstalledSize,
+				Archive:   rpm.ArchiveSize,
+			},
+			Location: yumLocation{Href: rpm.Location},
+			Format: yumFormat{
+				License:     rpm.License,
+				Vendor:      rpm.Vendor,
+				Group:       rpm.Group,
+				BuildHost:   rpm.BuildHost,
+				SourceRPM:   rpm.SourceRPM,
+				HeaderRange: yumHeaderRange{Start: rpm.HeaderStart, End: rpm.HeaderEnd},
+				Provides:    yumDependencies(rpm.Provides),
+				Requires:    yumDependencies(rpm.Requires),
+				Conflicts:   yumDependencies(rpm.Conflicts),
+				Obsoletes:   yumDependencies(rpm.Obsoletes),
+				Files:       files,
+			},
+		})
+	}
+
+	return marshalYumXML(primary)
+}
+
+// yumDependencies converts the dependencies to metadata entries. It returns
+// nil for no dependencies, which omits the element.
+func yumDependencies(deps []RPMDependency) *yumEntries {
+	if len(deps) == 0 {
+		return nil
+	}
+
+	entries := &yumEntries{Entries: make([]yumEntry, 0, len(deps))}
+
+	for _, dep := range deps {
+		entry := yumEntry{
+			Name:    dep.Name,
+			Flags:   dep.Flags,
+			Epoch:   dep.Epoch,
+			Version: dep.Version,
+			Release: dep.Release,
+		}
+
+		if dep.Pre {
+			entry.Pre = "1"
+		}
+
+		entries.Entries = append(entries.Entries, entry)
+	}
+
+	return entries
+}
+
+// marshalYumXML returns the indented XML document of the metadata.
+func marshalYumXML(v any) ([]byte, error) {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// sha256Hex returns the hex encoded SHA256 checksum of the data.
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	template "github.com/google/safetext/yamltemplate"
@@ -42,6 +43,23 @@ type PackageDefinition struct {
 
 	SpecTemplatePath string
 	SpecOutputPath   string
+
+	// BuildDate is the date used for the Debian changelog entry.
+	BuildDate time.Time
+}
+
+// debArchitectures maps the architectures to Debian architectures if they
+// differ.
+var debArchitectures = map[string]string{
+	consts.ArchitecturePPC64: "ppc64el",
+}
+
+// debRelations maps the semver comparison operators to Debian relations if
+// they differ.
+var debRelations = map[string]string{
+	"<":  "<<",
+	">":  ">>",
+	"==": "=",
 }
 
 // PackageVariation is a variation of the same package. Variation currently
@@ -59,6 +77,82 @@ func (p *PackageDefinition) RPMVersion() string {
 	return strings.ReplaceAll(p.Version, "-", "~")
 }
 
+// HasFormat returns true if the templates of the package format should be
+// rendered. Packages without metadata only render the RPM templates.
+func (p *PackageDefinition) HasFormat(format string) bool {
+	if p.Metadata == nil {
+		return format == metadata.FormatRPM
+	}
+
+	return p.Metadata.HasFormat(format)
+}
+
+// DebVersion returns the upstream version of the Debian package. The
+// pre-release separator "-" is replaced with "~", which makes pre-releases
+// sort before the final release, as described in the Debian policy:
+// https://www.debian.org/doc/debian-policy/ch-controlfields.html#version
+// Further hyphens, like in CI versions, are kept because the Debian revision
+// is always appended.
+func (p *PackageDefinition) DebVersion() string {
+	return strings.Replace(p.Version, "-", "~", 1)
+}
+
+// DebArchitectures returns the Debian architectures of the package
+// variations, separated by spaces.
+func (p *PackageDefinition) DebArchitectures() string {
+	archs := make([]string, 0, len(p.Variations))
+	for _, v := range p.Variations {
+		arch, ok := debArchitectures[v.Architecture]
+		if !ok {
+			arch = v.Architecture
+		}
+
+		archs = append(archs, arch)
+	}
+
+	return strings.Join(archs, " ")
+}
+
+// DebDepends returns the dependencies of the package in the format of the
+// Debian control file. Version constraints with multiple comparisons, like
+// ">= 1.0.0 < 2.0.0", are split into one relation per comparison.
+func (p *PackageDefinition) DebDepends() string {
+	if p.Metadata == nil {
+		return ""
+	}
+
+	relations := []string{}
+
+	for _, dep := range p.Metadata.Dependencies {
+		fields := strings.Fields(dep.VersionConstraint)
+		switch len(fields) {
+		case 0:
+			relations = append(relations, dep.Name)
+
+			continue
+		case 1:
+			fields = []string{"=", fields[0]}
+		}
+
+		for i := 0; i+1 < len(fields); i += 2 {
+			op, ok := debRelations[fields[i]]
+			if !ok {
+				op = fields[i]
+			}
+
+			relations = append(relations, fmt.Sprintf("%s (%s %s)", dep.Name, op, fields[i+1]))
+		}
+	}
+
+	return strings.Join(relations, ", ")
+}
+
+// DebChangelogDate returns the build date in the format of the Debian
+// changelog.
+func (p *PackageDefinition) DebChangelogDate() string {
+	return p.BuildDate.Format(time.RFC1123Z)
+}
+
 // ConstructPackageDefinition creates a new instance of PackageDefinition based
 // on provided options.
 func (s *Specs) ConstructPackageDefinition() (*PackageDefinition, error) {
@@ -75,6 +169,7 @@ func (s *Specs) ConstructPackageDefinition() (*PackageDefinition, error) {
 
 		SpecTemplatePath: s.options.SpecTemplatePath,
 		SpecOutputPath:   s.options.SpecOutputPath,
+		BuildDate:        time.Now().UTC(),
 	}
 
 	logrus.Infof("Writing output to %s", pkgDef.SpecOutputPath)
@@ -329,3 +329,49 @@ func createCNIMetadata() metadata.PackageMetadataList {
 		},
 	}
 }
+
+func TestDebianFields(t *testing.T) {
+	for _, tc := range []struct {
+		pkgDef        *specs.PackageDefinition
+		version       string
+		architectures string
+		depends       string
+	}{
+		{ // release without dependencies
+			pkgDef: &specs.PackageDefinition{
+				Version: "1.30.0",
+				Variations: []specs.PackageVariation{
+					{Architecture: "amd64"}, {Architecture: "ppc64le"},
+				},
+				Metadata: &metadata.PackageMetadata{},
+			},
+			version:       "1.30.0",
+			architectures: "amd64 ppc64el",
+		},
+		{ // pre-release with dependencies
+			pkgDef: &specs.PackageDefinition{
+				Version:    "1.30.0-rc.1",
+				Variations: []specs.PackageVariation{{Architecture: "arm64"}},
+				Metadata: &metadata.PackageMetadata{
+					Dependencies: []metadata.PackageDependency{
+						{Name: "kubelet", VersionConstraint: ">= 1.19.0"},
+						{Name: "kubernetes-cni", VersionConstraint: ">= 1.1.1 < 2.0.0"},
+						{Name: "cri-tools", VersionConstraint: "1.30.0"},
+						{Name: "conntrack"},
+					},
+				},
+			},
+			version:       "1.30.0~rc.1",
+			architectures: "arm64",
+			depends:       "kubelet (>= 1.19.0), kubernetes-cni (>= 1.1.1), kubernetes-cni (<< 2.0.0), cri-tools (= 1.30.0), conntrack",
+		},
+		{ // CI version without metadata
+			pkgDef:  &specs.PackageDefinition{Version: "1.31.0-alpha.0.12-abcdef"},
+			version: "1.31.0~alpha.0.12-abcdef",
+		},
+	} {
+		require.Equal(t, tc.version, tc.pkgDef.DebVersion())
+		require.Equal(t, tc.architectures, tc.pkgDef.DebArchitectures())
+		require.Equal(t, tc.depends, tc.pkgDef.DebDepends())
+	}
+}
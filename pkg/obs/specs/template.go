@@ -25,8 +25,14 @@ import (
 	"text/template"
 
 	"github.com/sirupsen/logrus"
+
+	"k8s.io/release/pkg/obs/metadata"
 )
 
+// debianTemplateDir is the directory of the Debian source package templates
+// within the package template directory.
+const debianTemplateDir = "debian"
+
 type work struct {
 	src    string
 	dst    string
@@ -53,20 +59,43 @@ func (s *Specs) BuildSpecs(pkgDef *PackageDefinition, specOnly bool) (err error)
 			return err
 		}
 
-		specFile := filepath.Join(pkgDef.SpecOutputPath, pkgDef.Name, templateFile[len(tplDir):])
+		relPath := templateFile[len(tplDir):]
+		specFile := filepath.Join(pkgDef.SpecOutputPath, pkgDef.Name, relPath)
 
 		if specFile == pkgDef.SpecOutputPath {
 			return nil
 		}
 
-		if f.IsDir() {
+		debianDir := filepath.Join(tplDir, debianTemplateDir)
+
+		switch {
+		case templateFile == debianDir:
+			// Debian templates are flattened, so the directory is not needed
+			return nil
+		case f.IsDir():
 			return s.Mkdir(specFile, f.Mode())
-		}
+		case filepath.Dir(templateFile) == debianDir:
+			if !pkgDef.HasFormat(metadata.FormatDeb) {
+				return nil
+			}
+
+			// OBS debtransform expects the debian directory files next to the
+			// .dsc file, prefixed with "debian."
+			specFile = filepath.Join(pkgDef.SpecOutputPath, debianTemplateDir+"."+f.Name())
+		case filepath.Ext(templateFile) == ".dsc":
+			if !pkgDef.HasFormat(metadata.FormatDeb) {
+				return nil
+			}
+
+			specFile = filepath.Join(pkgDef.SpecOutputPath, relPath)
+		case filepath.Ext(templateFile) == ".spec" || filepath.Ext(templateFile) == ".rpmlintrc":
+			if !pkgDef.HasFormat(metadata.FormatRPM) {
+				return nil
+			}
 
-		if filepath.Ext(templateFile) == ".spec" || filepath.Ext(templateFile) == ".rpmlintrc" {
 			// Spec is intentionally saved outside package dir, which is later on archived
-			specFile = filepath.Join(pkgDef.SpecOutputPath, templateFile[len(tplDir):])
-		} else if specOnly {
+			specFile = filepath.Join(pkgDef.SpecOutputPath, relPath)
+		case specOnly:
 			// If we're only building spec files, but encounter a non-spec file, skip it
 			return nil
 		}
@@ -18,10 +18,14 @@ package specs
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"k8s.io/release/pkg/obs/metadata"
 	"k8s.io/release/pkg/obs/specs/specsfakes"
 )
 
@@ -101,3 +105,91 @@ func TestBuildSpecs(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildSpecsFormats(t *testing.T) {
+	templates := t.TempDir()
+	for name, content := range map[string]string{
+		"kubectl/kubectl.spec":     "Version: {{ .RPMVersion }}\n",
+		"kubectl/kubectl.dsc":      "Version: {{ .DebVersion }}-{{ .Revision }}\n",
+		"kubectl/README.md":        "kubectl\n",
+		"kubectl/debian/changelog": "kubectl ({{ .DebVersion }}-{{ .Revision }}) -- {{ .DebChangelogDate }}\n",
+		"kubectl/debian/control":   "Architecture: {{ .DebArchitectures }}\n",
+		"kubectl/debian/rules":     "#!/usr/bin/make -f\n",
+	} {
+		path := filepath.Join(templates, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	for _, tc := range []struct {
+		formats  []string
+		specOnly bool
+		expected map[string]string
+	}{
+		{ // default formats
+			expected: map[string]string{
+				"kubectl.spec":      "Version: 1.30.0~rc.1\n",
+				"kubectl/README.md": "kubectl\n",
+			},
+		},
+		{ // RPM and Debian
+			formats: []string{metadata.FormatRPM, metadata.FormatDeb},
+			expected: map[string]string{
+				"kubectl.spec":      "Version: 1.30.0~rc.1\n",
+				"kubectl.dsc":       "Version: 1.30.0~rc.1-2\n",
+				"debian.changelog":  "kubectl (1.30.0~rc.1-2) -- Fri, 02 Jan 2026 03:04:05 +0000\n",
+				"debian.control":    "Architecture: amd64 ppc64el\n",
+				"debian.rules":      "#!/usr/bin/make -f\n",
+				"kubectl/README.md": "kubectl\n",
+			},
+		},
+		{ // Debian only
+			formats:  []string{metadata.FormatDeb},
+			specOnly: true,
+			expected: map[string]string{
+				"kubectl.dsc":      "Version: 1.30.0~rc.1-2\n",
+				"debian.changelog": "kubectl (1.30.0~rc.1-2) -- Fri, 02 Jan 2026 03:04:05 +0000\n",
+				"debian.control":   "Architecture: amd64 ppc64el\n",
+				"debian.rules":     "#!/usr/bin/make -f\n",
+			},
+		},
+	} {
+		output := t.TempDir()
+		pkgDef := &PackageDefinition{
+			Name:     "kubectl",
+			Version:  "1.30.0-rc.1",
+			Revision: "2",
+			Variations: []PackageVariation{
+				{Architecture: "amd64"}, {Architecture: "ppc64le"},
+			},
+			Metadata:         &metadata.PackageMetadata{Formats: tc.formats},
+			SpecTemplatePath: templates,
+			SpecOutputPath:   output,
+			BuildDate:        time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		}
+
+		sut := &Specs{}
+		sut.SetImpl(&defaultImpl{})
+		require.NoError(t, sut.BuildSpecs(pkgDef, tc.specOnly))
+
+		written := map[string]string{}
+		require.NoError(t, filepath.WalkDir(output, func(path string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			rel, err := filepath.Rel(output, path)
+			written[rel] = string(content)
+
+			return err
+		}))
+
+		require.Equal(t, tc.expected, written)
+		require.NoDirExists(t, filepath.Join(output, "kubectl", "debian"))
+	}
+}
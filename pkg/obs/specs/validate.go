@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package specs
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"path/filepath"
+	"slices"
+
+	"k8s.io/release/pkg/consts"
+)
+
+// sourceURLSamples are the versions and architectures used to render source
+// URL templates for validation. The first one is the reference, the second
+// one only differs in the version and the third one only in the
+// architecture, so that templates which ignore either of them can be
+// detected.
+var sourceURLSamples = []struct {
+	version string
+	arch    string
+}{
+	{version: "1.0.0", arch: consts.ArchitectureAMD64},
+	{version: "2.0.0", arch: consts.ArchitectureAMD64},
+	{version: "1.0.0", arch: consts.ArchitectureARM64},
+}
+
+// ValidateMetadata loads the metadata.yaml file from the template directory
+// and returns all issues found in it, including invalid source URL templates.
+// The returned error is only set if the metadata can't be loaded.
+func (s *Specs) ValidateMetadata(templateDir string) ([]error, error) {
+	m, err := s.LoadPackageMetadata(filepath.Join(templateDir, "metadata.yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("validating metadata: %w", err)
+	}
+
+	errs := m.Validate()
+
+	for _, name := range slices.Sorted(maps.Keys(m)) {
+		for _, pkgMetadata := range m[name] {
+			if err := s.ValidateSourceURLTemplate(name, pkgMetadata.SourceURLTemplate); err != nil {
+				errs = append(errs, fmt.Errorf("package %s (%s): %w", name, pkgMetadata.VersionConstraint, err))
+			}
+		}
+	}
+
+	return errs, nil
+}
+
+// ValidateSourceURLTemplate renders the source URL template of the package
+// for the release channel and verifies that it results in an absolute URL,
+// which depends on the package version and architecture. Unknown placeholders
+// and functions fail to render.
+func (s *Specs) ValidateSourceURLTemplate(packageName, sourceURLTemplate string) error {
+	if sourceURLTemplate == "" {
+		return errors.New("source URL template is empty")
+	}
+
+	rendered := []string{}
+
+	for _, sample := range sourceURLSamples {
+		source, err := s.GetPackageSource(
+			sourceURLTemplate, "", packageName, sample.version, sample.arch, consts.ChannelTypeRelease,
+		)
+		if err != nil {
+			return fmt.Errorf("rendering source URL template: %w", err)
+		}
+
+		u, err := url.Parse(source)
+		if err != nil {
+			return fmt.Errorf("parsing source URL %q: %w", source, err)
+		}
+
+		if !u.IsAbs() || u.Host == "" {
+			return fmt.Errorf("source URL %q is not an absolute URL", source)
+		}
+
+		rendered = append(rendered, source)
+	}
+
+	errs := []error{}
+
+	if rendered[0] == rendered[1] {
+		errs = append(errs, fmt.Errorf("source URL %q does not depend on the package version", rendered[0]))
+	}
+
+	if rendered[0] == rendered[2] {
+		errs = append(errs, fmt.Errorf("source URL %q does not depend on the architecture", rendered[0]))
+	}
+
+	return errors.Join(errs...)
+}
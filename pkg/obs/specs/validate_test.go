@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package specs_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/obs/metadata"
+	"k8s.io/release/pkg/obs/specs"
+	"k8s.io/release/pkg/obs/specs/specsfakes"
+)
+
+func TestValidateSourceURLTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		template string
+		errorMsg string
+	}{
+		{
+			name:     "kubernetes release URL",
+			template: "{{ KubernetesURL }}",
+		},
+		{
+			name:     "URL with version and architecture",
+			template: "https://github.com/containernetworking/plugins/releases/download/v{{ .PackageVersion }}/cni-plugins-linux-{{ .Architecture }}-v{{ .PackageVersion }}.tgz",
+		},
+		{
+			name:     "empty template",
+			template: "",
+			errorMsg: "source URL template is empty",
+		},
+		{
+			name:     "unknown placeholder",
+			template: "https://example.com/{{ .Version }}",
+			errorMsg: "rendering source URL template",
+		},
+		{
+			name:     "unknown function",
+			template: "{{ DownloadURL }}",
+			errorMsg: "rendering source URL template",
+		},
+		{
+			name:     "relative URL",
+			template: "{{ .PackageVersion }}/{{ .Architecture }}",
+			errorMsg: "is not an absolute URL",
+		},
+		{
+			name:     "static URL",
+			template: "https://example.com/{{ .PackageName }}.tar.gz",
+			errorMsg: "does not depend on the package version\n" +
+				"source URL \"https://example.com/kubectl.tar.gz\" does not depend on the architecture",
+		},
+		{
+			name:     "hard-coded architecture",
+			template: "https://github.com/kubernetes-sigs/cri-tools/releases/download/v{{ .PackageVersion }}/crictl-linux-amd64.tar.gz",
+			errorMsg: "does not depend on the architecture",
+		},
+		{
+			name:     "hard-coded version",
+			template: "https://github.com/kubernetes-sigs/cri-tools/releases/download/v1.30.0/crictl-linux-{{ .Architecture }}.tar.gz",
+			errorMsg: "does not depend on the package version",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sut := specs.New(&specs.Options{})
+
+			err := sut.ValidateSourceURLTemplate("kubectl", tc.template)
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorContains(t, err, tc.errorMsg)
+		})
+	}
+}
+
+func TestValidateMetadata(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		prepare        func(mock *specsfakes.FakeImpl)
+		expectedIssues int
+		shouldErr      bool
+	}{
+		{
+			name: "valid metadata",
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.LoadPackageMetadataReturns(metadata.PackageMetadataList{
+					"kubectl": {{VersionConstraint: ">= 1.0.0", SourceURLTemplate: "{{ KubernetesURL }}"}},
+				}, nil)
+			},
+		},
+		{
+			name: "invalid constraints and template",
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.LoadPackageMetadataReturns(metadata.PackageMetadataList{
+					"kubectl": {
+						{VersionConstraint: ">= 1.0.0", SourceURLTemplate: "{{ KubernetesURL }}"},
+						{VersionConstraint: ">= 1.1.0", SourceURLTemplate: "{{ .Version }}"},
+					},
+				}, nil)
+			},
+			expectedIssues: 2,
+		},
+		{
+			name: "loading metadata fails",
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.LoadPackageMetadataReturns(nil, errors.New("not found"))
+			},
+			shouldErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sut := specs.New(&specs.Options{})
+			mock := &specsfakes.FakeImpl{}
+			tc.prepare(mock)
+			sut.SetImpl(mock)
+
+			issues, err := sut.ValidateMetadata(t.TempDir())
+			if tc.shouldErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, issues, tc.expectedIssues)
+		})
+	}
+}
@@ -17,15 +17,16 @@ limitations under the License.
 package obs
 
 import (
+	"context"
 	"fmt"
-	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/sirupsen/logrus"
 
-	"sigs.k8s.io/release-sdk/osc"
 	"sigs.k8s.io/release-utils/helpers"
 
 	"k8s.io/release/pkg/gcp/gcb"
@@ -116,7 +117,9 @@ func (d *DefaultStage) State() *StageState {
 }
 
 // defaultStageImpl is the default internal stage client implementation.
-type defaultStageImpl struct{}
+type defaultStageImpl struct {
+	client *Client
+}
 
 // stageImpl is the implementation of the stage client.
 //
@@ -134,11 +137,12 @@ type stageImpl interface {
 		branch, releaseType string, buildVersion semver.Version,
 	) (bool, error)
 	GenerateSpecsAndArtifacts(options *specs.Options) error
-	CreateOBSConfigFile(username, password string) error
+	SetOBSClient(client *Client)
 	CheckoutProject(workspaceDir, project string) error
-	AddRemoveChanges(workspaceDir, project, packageName string) error
-	CommitChanges(workspaceDir, project, packageName, message string) error
-	Wait(project, packageName string) error
+	PushPackage(workspaceDir, project, packageName, message string) error
+	BuildResults(project string, packages []string) (*ResultList, error)
+	BuildLogTail(project, repository, arch, packageName string) (string, error)
+	WriteFile(path string, data []byte) error
 }
 
 func (d *defaultStageImpl) Submit(options *gcb.Options) error {
@@ -153,28 +157,23 @@ func (d *defaultStageImpl) MkdirAll(path string) error {
 	return os.MkdirAll(path, os.ModePerm)
 }
 
-// RemoveNonHiddenFiles removes everything in the package directory except
-// `.osc` directory which contains the package metadata.
+// RemovePackageFiles removes everything in the package directory.
 func (d *defaultStageImpl) RemovePackageFiles(path string) error {
-	return filepath.Walk(path, func(fullPath string, f os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return fmt.Errorf("reading package directory: %w", err)
+	}
 
-		// Make sure we don't delete the root directory
-		if path == fullPath {
-			return nil
-		}
+	for _, entry := range entries {
+		fullPath := filepath.Join(path, entry.Name())
+		logrus.Infof("Removing path: %s", fullPath)
 
-		if filepath.Base(fullPath) == ".osc" {
-			return fs.SkipDir
+		if err := os.RemoveAll(fullPath); err != nil {
+			return fmt.Errorf("removing %s: %w", fullPath, err)
 		}
+	}
 
-		logrus.Infof("Removing path: %s", fullPath)
-
-		//nolint:gosec // G122: paths come from walking a trusted, locally-prepared package directory
-		return os.RemoveAll(fullPath)
-	})
+	return nil
 }
 
 func (d *defaultStageImpl) BranchNeedsCreation(
@@ -199,33 +198,36 @@ func (d *defaultStageImpl) GenerateSpecsAndArtifacts(options *specs.Options) err
 	return specs.New(options).Run()
 }
 
-// CreateOBSConfigFile creates `~/.oscrc` file which contains the OBS API URL
-// and credentials for the k8s-release-bot user.
-func (d *defaultStageImpl) CreateOBSConfigFile(username, password string) error {
-	return osc.CreateOSCConfigFile(obsAPIURL, username, password)
+// SetOBSClient sets the client used to access the OBS API.
+func (d *defaultStageImpl) SetOBSClient(client *Client) {
+	d.client = client
 }
 
-// CheckoutProject runs `osc checkout` in the project directory.
+// CheckoutProject creates the package directories of the project.
 func (d *defaultStageImpl) CheckoutProject(workspaceDir, project string) error {
-	// TODO(xmudrii) - followup: figure out how to stream output.
-	return osc.OSC(filepath.Join(workspaceDir, obsRoot), "checkout", project)
+	return checkoutProject(context.Background(), d.client, workspaceDir, project)
 }
 
-// AddRemovePackage run `osc addremove` in the project directory.
-func (d *defaultStageImpl) AddRemoveChanges(workspaceDir, project, packageName string) error {
-	// TODO(xmudrii) - followup: figure out how to stream output.
-	return osc.OSC(filepath.Join(workspaceDir, obsRoot, project, packageName), "addremove")
+// PushPackage uploads the files of the package directory and commits them.
+func (d *defaultStageImpl) PushPackage(workspaceDir, project, packageName, message string) error {
+	return d.client.PushPackage(
+		context.Background(), project, packageName,
+		filepath.Join(workspaceDir, obsRoot, project, packageName), message,
+	)
 }
 
-// CommitChanges runs `osc commit` in the package directory.
-func (d *defaultStageImpl) CommitChanges(workspaceDir, project, packageName, message string) error {
-	// TODO(xmudrii) - followup: figure out how to stream output.
-	return osc.OSC(filepath.Join(workspaceDir, obsRoot, project, packageName), "commit", "-m", message)
+// BuildResults returns the build results of the packages.
+func (d *defaultStageImpl) BuildResults(project string, packages []string) (*ResultList, error) {
+	return d.client.BuildResults(context.Background(), project, packages...)
 }
 
-// Wait runs `osc results -w -F` for the package.
-func (d *defaultStageImpl) Wait(project, packageName string) error {
-	return osc.WaitResults(project, packageName)
+// BuildLogTail returns the end of the build log of the package.
+func (d *defaultStageImpl) BuildLogTail(project, repository, arch, packageName string) (string, error) {
+	return d.client.BuildLogTail(context.Background(), project, repository, arch, packageName, buildLogTailSize)
+}
+
+func (d *defaultStageImpl) WriteFile(path string, data []byte) error {
+	return os.WriteFile(path, data, 0o644)
 }
 
 func (d *DefaultStage) Submit(stream bool) error {
@@ -244,6 +246,9 @@ func (d *DefaultStage) Submit(stream bool) error {
 	options.OBSProject = d.options.Project
 	options.PackageSource = d.options.PackageSource
 	options.OBSWait = d.options.Wait
+	options.OBSWaitInterval = d.options.WaitInterval
+	options.OBSWaitTimeout = d.options.WaitTimeout
+	options.OBSWaitSummary = d.options.WaitSummaryPath
 
 	return d.impl.Submit(options)
 }
@@ -252,21 +257,14 @@ func (d *DefaultStage) InitState() {
 	d.state = &StageState{DefaultState()}
 }
 
-// InitOBSRoot creates the OBS root directory and the OBS config file.
+// InitOBSRoot creates the OBS root directory and the OBS API client.
 func (d *DefaultStage) InitOBSRoot() error {
-	password := os.Getenv(OBSPasswordKey)
-	if password == "" {
-		return fmt.Errorf("%s environment variable not set", OBSPasswordKey)
+	client, err := NewClientFromEnv()
+	if err != nil {
+		return fmt.Errorf("creating obs client: %w", err)
 	}
 
-	username := os.Getenv(OBSUsernameKey)
-	if username == "" {
-		username = obsK8sUsername
-	}
-
-	if err := d.impl.CreateOBSConfigFile(username, password); err != nil {
-		return fmt.Errorf("creating obs config file: %w", err)
-	}
+	d.impl.SetOBSClient(client)
 
 	return d.impl.MkdirAll(filepath.Join(d.options.Workspace, obsRoot))
 }
@@ -417,19 +415,18 @@ func (d *DefaultStage) Push() error {
 	}
 
 	for _, pkg := range d.options.Packages {
-		if err := d.impl.AddRemoveChanges(d.options.Workspace, d.state.obsProject, pkg); err != nil {
-			return fmt.Errorf("adding/removing package files: %w", err)
-		}
-
-		if err := d.impl.CommitChanges(d.options.Workspace, d.state.obsProject, pkg, d.state.packageVersion); err != nil {
-			return fmt.Errorf("committing packages: %w", err)
+		if err := d.impl.PushPackage(d.options.Workspace, d.state.obsProject, pkg, d.state.packageVersion); err != nil {
+			return fmt.Errorf("pushing package %s: %w", pkg, err)
 		}
 	}
 
 	return nil
 }
 
-// Wait waits for the OBS build results to succeed.
+// Wait polls the OBS build results of all packages until every build
+// finished or the wait timeout is reached and logs the build status matrix
+// whenever it changes. The build log tails of failed builds are added to the
+// build report, which gets written to the wait summary path if set.
 func (d *DefaultStage) Wait() error {
 	if !d.options.Wait {
 		logrus.Info("Will not wait for the OBS build results")
@@ -445,23 +442,103 @@ func (d *DefaultStage) Wait() error {
 
 	const retries = 3
 
-	for _, pkg := range d.options.Packages {
-		var tryError error
+	var (
+		report     *BuildReport
+		lastMatrix string
+		tryError   error
+		tries      int
+		timedOut   bool
+	)
+
+	startedAt := time.Now()
 
-		for try := range retries {
-			logrus.Infof("Waiting for package: %s (try %d)", pkg, try)
+	for {
+		results, err := d.impl.BuildResults(d.state.obsProject, d.options.Packages)
+		if err != nil {
+			tries++
+			tryError = err
+			logrus.Errorf("Unable to get build results (try %d): %v", tries, err)
+
+			if tries == retries {
+				return fmt.Errorf("get build results of project %s: %w", d.state.obsProject, tryError)
+			}
+		} else {
+			tries = 0
+			report = NewBuildReport(d.state.obsProject, results)
 
-			tryError = d.impl.Wait(d.state.obsProject, pkg)
-			if tryError == nil {
+			if matrix := report.Matrix(); matrix != lastMatrix {
+				logrus.Infof("Build results of project %s:\n%s", d.state.obsProject, matrix)
+				lastMatrix = matrix
+			}
+
+			if results.Finished() {
 				break
 			}
+		}
+
+		if d.options.WaitTimeout > 0 && time.Since(startedAt)+d.options.WaitInterval > d.options.WaitTimeout {
+			if report == nil {
+				return fmt.Errorf(
+					"timed out after %s waiting for the build results of project %s: %w",
+					d.options.WaitTimeout, d.state.obsProject, tryError,
+				)
+			}
+
+			timedOut = true
+
+			break
+		}
+
+		time.Sleep(d.options.WaitInterval)
+	}
+
+	report.StartedAt = startedAt
+	report.FinishedAt = time.Now()
+	// Builds which did not finish in time are not reported as failed
+	report.Succeeded = report.Succeeded && !timedOut
+
+	for _, build := range report.Failed() {
+		logTail, err := d.impl.BuildLogTail(d.state.obsProject, build.Repository, build.Arch, build.Package)
+		if err != nil {
+			logrus.Warnf("Unable to get build log of %s (%s/%s): %v", build.Package, build.Repository, build.Arch, err)
 
-			logrus.Errorf("Unable to wait for package %s: %v", pkg, tryError)
+			continue
 		}
 
-		if tryError != nil {
-			return fmt.Errorf("wait for package %s: %w", pkg, tryError)
+		build.LogTail = tailLines(logTail, buildLogTailLines)
+		logrus.Errorf(
+			"Build of %s (%s/%s) %s, end of the build log:\n%s",
+			build.Package, build.Repository, build.Arch, build.Status, build.LogTail,
+		)
+	}
+
+	if d.options.WaitSummaryPath != "" {
+		data, err := report.JSON()
+		if err != nil {
+			return err
 		}
+
+		if err := d.impl.WriteFile(d.options.WaitSummaryPath, data); err != nil {
+			return fmt.Errorf("writing build report: %w", err)
+		}
+
+		logrus.Infof("Wrote build report to %s", d.options.WaitSummaryPath)
+	}
+
+	if timedOut {
+		return fmt.Errorf(
+			"timed out after %s waiting for the builds of project %s to finish",
+			d.options.WaitTimeout, d.state.obsProject,
+		)
+	}
+
+	if !report.Succeeded {
+		failed := []string{}
+		for _, build := range report.Failed() {
+			failed = append(failed, fmt.Sprintf("%s (%s/%s: %s)", build.Package, build.Repository, build.Arch, build.Status))
+		}
+
+		return fmt.Errorf("builds failed: %s", strings.Join(failed, ", "))
 	}
 
 	return nil
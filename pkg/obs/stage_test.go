@@ -17,10 +17,13 @@ limitations under the License.
 package obs_test
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -64,7 +67,7 @@ func TestInitOBSRoot(t *testing.T) {
 			require.Error(t, err)
 		} else {
 			require.NoError(t, err)
-			require.Equal(t, 1, mock.CreateOBSConfigFileCallCount())
+			require.Equal(t, 1, mock.SetOBSClientCallCount())
 		}
 	}
 }
@@ -101,6 +104,27 @@ func TestSubmit(t *testing.T) {
 	}
 }
 
+func TestSubmitWaitOptions(t *testing.T) {
+	opts := obs.DefaultStageOptions()
+	opts.Wait = true
+	opts.WaitInterval = time.Minute
+	opts.WaitTimeout = time.Hour
+	opts.WaitSummaryPath = "summary.json"
+	sut := obs.NewDefaultStage(opts)
+
+	mock := &obsfakes.FakeStageImpl{}
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.Submit(false))
+	require.Equal(t, 1, mock.SubmitCallCount())
+
+	gcbOpts := mock.SubmitArgsForCall(0)
+	require.True(t, gcbOpts.OBSWait)
+	require.Equal(t, time.Minute, gcbOpts.OBSWaitInterval)
+	require.Equal(t, time.Hour, gcbOpts.OBSWaitTimeout)
+	require.Equal(t, "summary.json", gcbOpts.OBSWaitSummary)
+}
+
 func TestCheckPrerequisitesStage(t *testing.T) {
 	for _, tc := range []struct {
 		prepare     func(*obsfakes.FakeStageImpl)
@@ -280,15 +304,9 @@ func TestPushStage(t *testing.T) {
 			prepare:     func(*obsfakes.FakeStageImpl) {},
 			shouldError: false,
 		},
-		{ // AddRemoveChanges fails
-			prepare: func(mock *obsfakes.FakeStageImpl) {
-				mock.AddRemoveChangesReturns(err)
-			},
-			shouldError: true,
-		},
-		{ // CommitChanges fails
+		{ // PushPackage fails
 			prepare: func(mock *obsfakes.FakeStageImpl) {
-				mock.CommitChangesReturns(err)
+				mock.PushPackageReturns(err)
 			},
 			shouldError: true,
 		},
@@ -311,45 +329,156 @@ func TestPushStage(t *testing.T) {
 	}
 }
 
+func testResultList(statuses ...string) *obs.ResultList {
+	results := &obs.ResultList{}
+	for i, status := range statuses {
+		results.Results = append(results.Results, obs.Result{
+			Repository: "deb",
+			Arch:       fmt.Sprintf("arch%d", i),
+			Statuses:   []obs.PackageStatus{{Package: "kubeadm", Code: status}},
+		})
+	}
+
+	return results
+}
+
 func TestWaitStage(t *testing.T) {
 	for _, tc := range []struct {
-		prepare     func(*obsfakes.FakeStageImpl)
-		shouldError bool
+		name          string
+		prepare       func(*obsfakes.FakeStageImpl)
+		shouldError   bool
+		expectedCalls int
 	}{
-		{ // success
-			prepare:     func(*obsfakes.FakeStageImpl) {},
-			shouldError: false,
+		{
+			name: "success",
+			prepare: func(mock *obsfakes.FakeStageImpl) {
+				mock.BuildResultsReturns(testResultList(obs.BuildStatusSucceeded, obs.BuildStatusExcluded), nil)
+			},
+			expectedCalls: 1,
 		},
-		{ // Wait always fails
+		{
+			name: "success after building",
 			prepare: func(mock *obsfakes.FakeStageImpl) {
-				mock.WaitReturns(err)
+				mock.BuildResultsReturnsOnCall(0, testResultList("scheduled", "building"), nil)
+				mock.BuildResultsReturnsOnCall(1, testResultList(obs.BuildStatusSucceeded, "building"), nil)
+				mock.BuildResultsReturnsOnCall(2, testResultList(obs.BuildStatusSucceeded, obs.BuildStatusSucceeded), nil)
 			},
-			shouldError: true,
+			expectedCalls: 3,
 		},
-		{ // Wait fails once
+		{
+			name: "build results fail once",
 			prepare: func(mock *obsfakes.FakeStageImpl) {
-				mock.WaitReturnsOnCall(0, err)
+				mock.BuildResultsReturnsOnCall(0, nil, err)
+				mock.BuildResultsReturnsOnCall(1, testResultList(obs.BuildStatusSucceeded), nil)
 			},
-			shouldError: false,
+			expectedCalls: 2,
+		},
+		{
+			name: "build results always fail",
+			prepare: func(mock *obsfakes.FakeStageImpl) {
+				mock.BuildResultsReturns(nil, err)
+			},
+			shouldError:   true,
+			expectedCalls: 3,
+		},
+		{
+			name: "build failed",
+			prepare: func(mock *obsfakes.FakeStageImpl) {
+				mock.BuildResultsReturns(testResultList(obs.BuildStatusSucceeded, obs.BuildStatusFailed), nil)
+				mock.BuildLogTailReturns("line1\nline2\n", nil)
+			},
+			shouldError:   true,
+			expectedCalls: 1,
 		},
 	} {
-		opts := obs.DefaultStageOptions()
-		opts.NoMock = true
-		opts.Wait = true
-		sut := obs.NewDefaultStage(opts)
-		sut.SetState(obs.DefaultStageState())
+		t.Run(tc.name, func(t *testing.T) {
+			opts := obs.DefaultStageOptions()
+			opts.NoMock = true
+			opts.Wait = true
+			opts.WaitInterval = 0
+			opts.WaitSummaryPath = "summary.json"
+			sut := obs.NewDefaultStage(opts)
+			sut.SetState(obs.DefaultStageState())
+
+			mock := &obsfakes.FakeStageImpl{}
+			tc.prepare(mock)
+			sut.SetImpl(mock)
+
+			err := sut.Wait()
+			if tc.shouldError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+			}
+
+			require.Equal(t, tc.expectedCalls, mock.BuildResultsCallCount())
+		})
+	}
+}
 
-		mock := &obsfakes.FakeStageImpl{}
-		tc.prepare(mock)
-		sut.SetImpl(mock)
+func TestWaitStageTimeout(t *testing.T) {
+	opts := obs.DefaultStageOptions()
+	opts.NoMock = true
+	opts.Wait = true
+	opts.WaitInterval = time.Millisecond
+	opts.WaitTimeout = 5 * time.Millisecond
+	opts.WaitSummaryPath = "summary.json"
+	sut := obs.NewDefaultStage(opts)
+	sut.SetState(obs.DefaultStageState())
 
-		err := sut.Wait()
-		if tc.shouldError {
-			require.Error(t, err)
-		} else {
-			require.NoError(t, err)
-		}
-	}
+	mock := &obsfakes.FakeStageImpl{}
+	mock.BuildResultsReturns(testResultList(obs.BuildStatusSucceeded, "building"), nil)
+	sut.SetImpl(mock)
+
+	require.ErrorContains(t, sut.Wait(), "timed out after 5ms waiting for the builds")
+	require.Positive(t, mock.BuildResultsCallCount())
+
+	// The report of the unfinished builds is written nevertheless
+	require.Equal(t, 1, mock.WriteFileCallCount())
+	_, data := mock.WriteFileArgsForCall(0)
+	require.Contains(t, string(data), `"succeeded": false`)
+
+	// Failing build results requests are not retried beyond the timeout
+	mock = &obsfakes.FakeStageImpl{}
+	mock.BuildResultsReturns(nil, err)
+	sut.SetImpl(mock)
+
+	opts.WaitInterval = time.Hour
+	require.ErrorContains(t, sut.Wait(), "timed out after 5ms waiting for the build results")
+	require.Equal(t, 1, mock.BuildResultsCallCount())
+}
+
+func TestWaitStageBuildReport(t *testing.T) {
+	opts := obs.DefaultStageOptions()
+	opts.NoMock = true
+	opts.Wait = true
+	opts.WaitSummaryPath = "summary.json"
+	sut := obs.NewDefaultStage(opts)
+	sut.SetState(obs.DefaultStageState())
+
+	mock := &obsfakes.FakeStageImpl{}
+	mock.BuildResultsReturns(testResultList(obs.BuildStatusSucceeded, obs.BuildStatusFailed, obs.BuildStatusUnresolvable), nil)
+	mock.BuildLogTailReturnsOnCall(0, "line1\nline2\n", nil)
+	mock.BuildLogTailReturnsOnCall(1, "", err)
+	sut.SetImpl(mock)
+
+	require.ErrorContains(t, sut.Wait(), "builds failed: kubeadm (deb/arch1: failed), kubeadm (deb/arch2: unresolvable)")
+	require.Equal(t, 2, mock.BuildLogTailCallCount())
+
+	_, repository, arch, pkg := mock.BuildLogTailArgsForCall(0)
+	require.Equal(t, []string{"deb", "arch1", "kubeadm"}, []string{repository, arch, pkg})
+
+	require.Equal(t, 1, mock.WriteFileCallCount())
+	path, data := mock.WriteFileArgsForCall(0)
+	require.Equal(t, "summary.json", path)
+
+	report := &obs.BuildReport{}
+	require.NoError(t, json.Unmarshal(data, report))
+	require.False(t, report.Succeeded)
+	require.Len(t, report.Builds, 3)
+	require.Equal(t, "line1\nline2", report.Builds[1].LogTail)
+	require.Empty(t, report.Builds[2].LogTail)
 }
 
 func preconfigureStageOptions(t *testing.T) *obs.StageOptions {
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package obs
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	// defaultWaitInterval is the interval between two build results
+	// requests when waiting for the builds.
+	defaultWaitInterval = 30 * time.Second
+
+	// defaultWaitTimeout is the maximum duration to wait for the builds,
+	// which is below the timeout of the Google Cloud Build job.
+	defaultWaitTimeout = 3 * time.Hour
+
+	// buildLogTailSize is the size of the build log tail fetched for
+	// failed builds.
+	buildLogTailSize = 8 * 1024
+
+	// buildLogTailLines is the number of lines of the build log tail
+	// printed and stored in the build report.
+	buildLogTailLines = 50
+
+	// buildStatusMissing is shown in the matrix if a package is not built
+	// for an architecture of a repository.
+	buildStatusMissing = "-"
+)
+
+// BuildReport is the summary of the OBS builds of the staged packages.
+type BuildReport struct {
+	Project    string          `json:"project"`
+	StartedAt  time.Time       `json:"startedAt"`
+	FinishedAt time.Time       `json:"finishedAt"`
+	Succeeded  bool            `json:"succeeded"`
+	Builds     []*PackageBuild `json:"builds"`
+}
+
+// PackageBuild is the build of a package for a repository and architecture.
+type PackageBuild struct {
+	Package    string `json:"package"`
+	Repository string `json:"repository"`
+	Arch       string `json:"arch"`
+	Status     string `json:"status"`
+	Details    string `json:"details,omitempty"`
+
+	// LogTail is the end of the build log of failed builds.
+	LogTail string `json:"logTail,omitempty"`
+}
+
+// Failed returns true if the build did not produce a package.
+func (b *PackageBuild) Failed() bool {
+	return slices.Contains(failedBuildStatuses, b.Status)
+}
+
+// NewBuildReport creates a report of the build results, ordered by package,
+// repository and architecture.
+func NewBuildReport(project string, results *ResultList) *BuildReport {
+	report := &BuildReport{Project: project, Builds: []*PackageBuild{}}
+
+	for _, result := range results.Results {
+		for _, status := range result.Statuses {
+			report.Builds = append(report.Builds, &PackageBuild{
+				Package:    status.Package,
+				Repository: result.Repository,
+				Arch:       result.Arch,
+				Status:     status.Code,
+				Details:    strings.TrimSpace(status.Details),
+			})
+		}
+	}
+
+	slices.SortFunc(report.Builds, func(a, b *PackageBuild) int {
+		return cmp.Or(
+			strings.Compare(a.Package, b.Package),
+			strings.Compare(a.Repository, b.Repository),
+			strings.Compare(a.Arch, b.Arch),
+		)
+	})
+
+	report.Succeeded = len(report.Failed()) == 0
+
+	return report
+}
+
+// Failed returns the failed builds.
+func (r *BuildReport) Failed() []*PackageBuild {
+	failed := []*PackageBuild{}
+
+	for _, build := range r.Builds {
+		if build.Failed() {
+			failed = append(failed, build)
+		}
+	}
+
+	return failed
+}
+
+// Matrix renders the build status of every package and repository per
+// architecture as table.
+func (r *BuildReport) Matrix() string {
+	archs := []string{}
+	rows := [][2]string{}
+	statuses := map[[3]string]string{}
+
+	for _, build := range r.Builds {
+		if !slices.Contains(archs, build.Arch) {
+			archs = append(archs, build.Arch)
+		}
+
+		row := [2]string{build.Package, build.Repository}
+		if !slices.Contains(rows, row) {
+			rows = append(rows, row)
+		}
+
+		statuses[[3]string{build.Package, build.Repository, build.Arch}] = build.Status
+	}
+
+	slices.Sort(archs)
+
+	sb := &strings.Builder{}
+	w := tabwriter.NewWriter(sb, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "PACKAGE\tREPOSITORY\t%s\n", strings.Join(archs, "\t"))
+
+	for _, row := range rows {
+		cells := []string{row[0], row[1]}
+
+		for _, arch := range archs {
+			status, ok := statuses[[3]string{row[0], row[1], arch}]
+			if !ok {
+				status = buildStatusMissing
+			}
+
+			cells = append(cells, status)
+		}
+
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+
+	w.Flush()
+
+	return sb.String()
+}
+
+// JSON returns the indented JSON of the report.
+func (r *BuildReport) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal build report: %w", err)
+	}
+
+	return append(data, '\n'), nil
+}
+
+// tailLines returns the last n lines of the text.
+func tailLines(text string, n int) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package obs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/obs"
+)
+
+func TestBuildReport(t *testing.T) {
+	report := obs.NewBuildReport(testOBSProject, &obs.ResultList{Results: []obs.Result{
+		{
+			Repository: "rpm",
+			Arch:       "x86_64",
+			Statuses: []obs.PackageStatus{
+				{Package: "kubectl", Code: obs.BuildStatusSucceeded},
+				{Package: "kubeadm", Code: obs.BuildStatusFailed, Details: " exit code 1 "},
+			},
+		},
+		{
+			Repository: "deb",
+			Arch:       "x86_64",
+			Statuses: []obs.PackageStatus{
+				{Package: "kubeadm", Code: "building"},
+			},
+		},
+		{
+			Repository: "deb",
+			Arch:       "aarch64",
+			Statuses: []obs.PackageStatus{
+				{Package: "kubeadm", Code: "scheduled"},
+			},
+		},
+	}})
+
+	require.False(t, report.Succeeded)
+	require.Len(t, report.Builds, 4)
+	require.Equal(t, []*obs.PackageBuild{{
+		Package: "kubeadm", Repository: "rpm", Arch: "x86_64", Status: obs.BuildStatusFailed, Details: "exit code 1",
+	}}, report.Failed())
+
+	require.Equal(t, `PACKAGE  REPOSITORY  aarch64    x86_64
+kubeadm  deb         scheduled  building
+kubeadm  rpm         -          failed
+kubectl  rpm         -          succeeded
+`, report.Matrix())
+
+	report = obs.NewBuildReport(testOBSProject, &obs.ResultList{})
+	require.True(t, report.Succeeded)
+	require.Equal(t, "PACKAGE  REPOSITORY  \n", report.Matrix())
+}
@@ -17,14 +17,22 @@ limitations under the License.
 package release
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/crane"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 
@@ -41,13 +49,17 @@ type Images struct {
 	imageImpl
 
 	signer *sign.Signer
+	policy *ImageVerificationPolicy
 }
 
 // NewImages creates a new Images instance.
 func NewImages() *Images {
+	policy := DefaultImageVerificationPolicy()
+
 	return &Images{
 		imageImpl: &defaultImageImpl{},
-		signer:    sign.New(sign.Default()),
+		signer:    sign.New(policy.signerOptions()),
+		policy:    policy,
 	}
 }
 
@@ -61,8 +73,10 @@ func (i *Images) SetImpl(impl imageImpl) {
 //counterfeiter:generate . imageImpl
 type imageImpl interface {
 	Execute(cmd string, args ...string) error
-	ExecuteOutput(cmd string, args ...string) (string, error)
 	RepoTagFromTarball(path string) (string, error)
+	TarballConfigName(path string) (v1.Hash, error)
+	RemoteIndexManifest(reference string) (*v1.IndexManifest, error)
+	RemoteConfigName(reference string) (v1.Hash, error)
 	SignImage(*sign.Signer, string) error
 	VerifyImage(*sign.Signer, string) error
 }
@@ -73,25 +87,48 @@ func (*defaultImageImpl) Execute(cmd string, args ...string) error {
 	return command.New(cmd, args...).RunSilentSuccess()
 }
 
-func (*defaultImageImpl) ExecuteOutput(cmd string, args ...string) (string, error) {
-	res, err := command.New(cmd, args...).RunSilentSuccessOutput()
+func (*defaultImageImpl) RepoTagFromTarball(path string) (string, error) {
+	manifest, err := tarball.LoadManifest(func() (io.ReadCloser, error) {
+		return os.Open(path)
+	})
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("load tarball manifest: %w", err)
+	}
+
+	if len(manifest) == 0 || len(manifest[0].RepoTags) == 0 {
+		return "", fmt.Errorf("no repo tags found in tarball %s", path)
 	}
 
-	return res.OutputTrimNL(), nil
+	return manifest[0].RepoTags[0], nil
 }
 
-func (*defaultImageImpl) RepoTagFromTarball(path string) (string, error) {
-	tagOutput, err := command.
-		New("tar", "xf", path, "manifest.json", "-O").
-		Pipe("jq", "-r", ".[0].RepoTags[0]").
-		RunSilentSuccessOutput()
+func (*defaultImageImpl) TarballConfigName(path string) (v1.Hash, error) {
+	img, err := tarball.ImageFromPath(path, nil)
 	if err != nil {
-		return "", err
+		return v1.Hash{}, fmt.Errorf("load image from tarball: %w", err)
 	}
 
-	return tagOutput.OutputTrimNL(), nil
+	return img.ConfigName()
+}
+
+func (*defaultImageImpl) RemoteIndexManifest(reference string) (*v1.IndexManifest, error) {
+	manifestBytes, err := crane.Manifest(reference)
+	if err != nil {
+		return nil, fmt.Errorf("get remote manifest: %w", err)
+	}
+
+	return v1.ParseIndexManifest(bytes.NewReader(manifestBytes))
+}
+
+func (*defaultImageImpl) RemoteConfigName(reference string) (v1.Hash, error) {
+	configBytes, err := crane.Config(reference)
+	if err != nil {
+		return v1.Hash{}, fmt.Errorf("get remote config: %w", err)
+	}
+
+	hash, _, err := v1.SHA256(bytes.NewReader(configBytes))
+
+	return hash, err
 }
 
 func (*defaultImageImpl) SignImage(signer *sign.Signer, reference string) error {
@@ -100,18 +137,42 @@ func (*defaultImageImpl) SignImage(signer *sign.Signer, reference string) error
 	return err
 }
 
-func (*defaultImageImpl) VerifyImage(_ *sign.Signer, _ string) error {
-	// TODO: bypassing this for now due to the fail in the promotion process
-	// that sign the images. We will release the Feb/2023 patch releases without full
-	// signatures but we will sign those in a near future in a deatached process
-	// revert this change when the patches are out
-	// _, err := signer.VerifyImage(reference)
-	// return err
+func (*defaultImageImpl) VerifyImage(signer *sign.Signer, reference string) error {
+	obj, err := signer.VerifyImage(reference)
+	if err != nil {
+		return err
+	}
+
+	// The signer ignores unsigned images, but we require a signature.
+	if obj == nil {
+		return errors.New("image is not signed")
+	}
+
 	return nil
 }
 
 var tagRegex = regexp.MustCompile(`^.+/(.+):.+$`)
 
+// imageOS is the expected operating system of all container images.
+const imageOS = "linux"
+
+// archVariants are the accepted platform variants per architecture. An empty
+// variant means that the variant is not set.
+var archVariants = map[string][]string{
+	"amd64":   {""},
+	"arm":     {"v7"},
+	"arm64":   {"", "v8"},
+	"ppc64le": {""},
+	"s390x":   {""},
+}
+
+// indexMediaTypes maps the supported manifest list media types to the
+// expected media type of their entries.
+var indexMediaTypes = map[types.MediaType]types.MediaType{
+	types.DockerManifestList: types.DockerManifestSchema2,
+	types.OCIImageIndex:      types.OCIManifestSchema1,
+}
+
 // publishWorkers is the number of concurrent workers for pushing and
 // signing arch-specific container images.
 const publishWorkers = 5
@@ -199,20 +260,22 @@ func (i *Images) Publish(registry, version, buildPath string) error {
 }
 
 // Validates that image manifests have been pushed to a specified remote
-// registry.
+// registry and that their entries point to the locally built images in
+// buildPath. The signatures of all images and manifest lists are verified
+// against the verification policy and reported together.
 func (i *Images) Validate(registry, version, buildPath string) error {
 	logrus.Infof("Validating image manifests in %s", registry)
 
 	version = i.normalizeVersion(version)
 
+	refs := []string{}
+	tarballs := map[string]string{}
+
 	manifestImages, err := i.GetManifestImages(
 		registry, version, buildPath,
-		func(_, _, image string) error {
-			logrus.Infof("Verifying that image is signed: %s", image)
-
-			if err := i.VerifyImage(i.signer, image); err != nil {
-				return fmt.Errorf("verify signed image: %w", err)
-			}
+		func(path, _, image string) error {
+			refs = append(refs, image)
+			tarballs[image] = path
 
 			return nil
 		},
@@ -223,58 +286,38 @@ func (i *Images) Validate(registry, version, buildPath string) error {
 
 	logrus.Infof("Got manifest images %+v", manifestImages)
 
-	for image, arches := range manifestImages {
-		imageVersion := fmt.Sprintf("%s:%s", image, version)
-
-		manifestBytes, err := crane.Manifest(imageVersion)
-		if err != nil {
-			return fmt.Errorf("get remote manifest from %s: %w", imageVersion, err)
-		}
-
-		logrus.Info("Verifying that image manifest list is signed")
+	images := slices.Sorted(maps.Keys(manifestImages))
+	for _, image := range images {
+		refs = append(refs, fmt.Sprintf("%s:%s", image, version))
+	}
 
-		if err := i.VerifyImage(i.signer, imageVersion); err != nil {
-			return fmt.Errorf("verify signed manifest list: %w", err)
-		}
+	report := i.VerifySignatures(refs...)
+	logrus.Infof("Signature verification report: %s", report)
 
-		manifest := string(manifestBytes)
+	for _, image := range images {
+		imageVersion := fmt.Sprintf("%s:%s", image, version)
 
-		manifestFile, err := os.CreateTemp("", "manifest-")
+		digests, err := i.manifestDigests(imageVersion, manifestImages[image])
 		if err != nil {
-			return fmt.Errorf("create temp file for manifest: %w", err)
-		}
-
-		if _, err := manifestFile.WriteString(manifest); err != nil {
-			return fmt.Errorf("write manifest to %s: %w", manifestFile.Name(), err)
+			return fmt.Errorf("validate manifest list %s: %w", imageVersion, err)
 		}
 
-		for _, arch := range arches {
-			logrus.Infof(
-				"Checking image digest for %s on %s architecture", image, arch,
-			)
-
-			digest, err := i.ExecuteOutput(
-				"jq", "--arg", "a", arch, "-r",
-				".manifests[] | select(.platform.architecture == $a) | .digest",
-				manifestFile.Name(),
-			)
-			if err != nil {
-				return fmt.Errorf("get digest from manifest file %s for arch %s: %w", manifestFile.Name(), arch, err)
-			}
+		for _, arch := range manifestImages[image] {
+			digest := digests[arch]
+			archImage := fmt.Sprintf("%s-%s:%s", image, arch, version)
 
-			if digest == "" {
+			if err := i.validateImageConfig(
+				fmt.Sprintf("%s@%s", image, digest), tarballs[archImage],
+			); err != nil {
 				return fmt.Errorf(
-					"could not find the image digest for %s on %s",
-					imageVersion, arch,
+					"validate %s on %s architecture: %w", imageVersion, arch, err,
 				)
 			}
-
-			logrus.Infof("Digest for %s on %s: %s", imageVersion, arch, digest)
 		}
+	}
 
-		if err := os.RemoveAll(manifestFile.Name()); err != nil { //nolint:gosec // G703 - temp file path is safe
-			return fmt.Errorf("remove manifest file: %w", err)
-		}
+	if err := report.Err(); err != nil {
+		return fmt.Errorf("verify image signatures: %w", err)
 	}
 
 	return nil
@@ -305,48 +348,8 @@ func (i *Images) Exists(registry, version string, fast bool) (bool, error) {
 	for _, image := range manifestImages {
 		imageVersion := fmt.Sprintf("%s/%s:%s", registry, image, version)
 
-		manifestBytes, err := crane.Manifest(imageVersion)
-		if err != nil {
-			return false, fmt.Errorf("get remote manifest from %s: %w", imageVersion, err)
-		}
-
-		manifest := string(manifestBytes)
-
-		manifestFile, err := os.CreateTemp("", "manifest-")
-		if err != nil {
-			return false, fmt.Errorf("create temp file for manifest: %w", err)
-		}
-
-		if _, err := manifestFile.WriteString(manifest); err != nil {
-			return false, fmt.Errorf("write manifest to %s: %w", manifestFile.Name(), err)
-		}
-
-		for _, arch := range arches {
-			logrus.Infof(
-				"Checking image digest for %s on %s architecture", image, arch,
-			)
-
-			digest, err := i.ExecuteOutput(
-				"jq", "--arg", "a", arch, "-r",
-				".manifests[] | select(.platform.architecture == $a) | .digest",
-				manifestFile.Name(),
-			)
-			if err != nil {
-				return false, fmt.Errorf("get digest from manifest file %s for arch %s: %w", manifestFile.Name(), arch, err)
-			}
-
-			if digest == "" {
-				return false, fmt.Errorf(
-					"could not find the image digest for %s on %s",
-					imageVersion, arch,
-				)
-			}
-
-			logrus.Infof("Digest for %s on %s: %s", imageVersion, arch, digest)
-		}
-
-		if err := os.RemoveAll(manifestFile.Name()); err != nil { //nolint:gosec // G703 - temp file path is safe
-			return false, fmt.Errorf("remove manifest file: %w", err)
+		if _, err := i.manifestDigests(imageVersion, arches); err != nil {
+			return false, fmt.Errorf("validate manifest list %s: %w", imageVersion, err)
 		}
 	}
 
@@ -537,6 +540,109 @@ func (i *Images) createPushSignManifest(image string, arches []string, version s
 	return nil
 }
 
+// manifestDigests retrieves the remote manifest list of the provided image
+// reference and returns the image digests for every architecture. It
+// verifies that each architecture entry has the expected platform and that
+// the media types of the manifest list and its entries are consistent.
+func (i *Images) manifestDigests(
+	reference string, arches []string,
+) (map[string]v1.Hash, error) {
+	index, err := i.RemoteIndexManifest(reference)
+	if err != nil {
+		return nil, fmt.Errorf("get remote manifest list: %w", err)
+	}
+
+	// The media type is optional for OCI image indexes.
+	indexMediaType := index.MediaType
+	if indexMediaType == "" {
+		indexMediaType = types.OCIImageIndex
+	}
+
+	manifestMediaType, ok := indexMediaTypes[indexMediaType]
+	if !ok {
+		return nil, fmt.Errorf(
+			"unsupported manifest list media type %q", index.MediaType,
+		)
+	}
+
+	digests := make(map[string]v1.Hash, len(arches))
+
+	for _, arch := range arches {
+		logrus.Infof(
+			"Checking image digest for %s on %s architecture", reference, arch,
+		)
+
+		var desc *v1.Descriptor
+
+		for j := range index.Manifests {
+			if p := index.Manifests[j].Platform; p != nil && p.Architecture == arch {
+				desc = &index.Manifests[j]
+
+				break
+			}
+		}
+
+		if desc == nil {
+			return nil, fmt.Errorf(
+				"could not find the image digest for %s on %s", reference, arch,
+			)
+		}
+
+		if desc.Platform.OS != imageOS {
+			return nil, fmt.Errorf(
+				"unexpected OS %q for %s on %s", desc.Platform.OS, reference, arch,
+			)
+		}
+
+		if !slices.Contains(archVariants[arch], desc.Platform.Variant) {
+			return nil, fmt.Errorf(
+				"unexpected variant %q for %s on %s",
+				desc.Platform.Variant, reference, arch,
+			)
+		}
+
+		if desc.MediaType != manifestMediaType {
+			return nil, fmt.Errorf(
+				"media type %q of %s on %s does not match manifest list media type %q",
+				desc.MediaType, reference, arch, indexMediaType,
+			)
+		}
+
+		logrus.Infof("Digest for %s on %s: %s", reference, arch, desc.Digest)
+		digests[arch] = desc.Digest
+	}
+
+	return digests, nil
+}
+
+// validateImageConfig verifies that the remote image reference has the same
+// config digest as the locally built image tarball. Manifest digests cannot
+// be compared, because the layers get recompressed when pushing.
+func (i *Images) validateImageConfig(reference, tarballPath string) error {
+	if tarballPath == "" {
+		return fmt.Errorf("no local image tarball found for %s", reference)
+	}
+
+	localConfig, err := i.TarballConfigName(tarballPath)
+	if err != nil {
+		return fmt.Errorf("get config digest of %s: %w", tarballPath, err)
+	}
+
+	remoteConfig, err := i.RemoteConfigName(reference)
+	if err != nil {
+		return fmt.Errorf("get config digest of %s: %w", reference, err)
+	}
+
+	if localConfig != remoteConfig {
+		return fmt.Errorf(
+			"config digest %s of %s does not match %s of local image %s",
+			remoteConfig, reference, localConfig, tarballPath,
+		)
+	}
+
+	return nil
+}
+
 // normalizeVersion normalizes an container image version by replacing all invalid characters.
 func (i *Images) normalizeVersion(version string) string {
 	return strings.ReplaceAll(version, "+", "_")
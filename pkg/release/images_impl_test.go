@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package release
+
+import (
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+)
+
+const localRegistryVersion = "v1.20.0"
+
+// pushLocalRegistryImage pushes the image and a manifest list containing it
+// as amd64 entry to the local registry and returns the registry host.
+func pushLocalRegistryImage(t *testing.T, img v1.Image) string {
+	t.Helper()
+
+	server := httptest.NewServer(registry.New(
+		registry.Logger(log.New(io.Discard, "", 0)),
+	))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	archRef, err := name.ParseReference(u.Host + "/kube-proxy-amd64:" + localRegistryVersion)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(archRef, img))
+
+	index := mutate.AppendManifests(
+		mutate.IndexMediaType(empty.Index, types.DockerManifestList),
+		mutate.IndexAddendum{
+			Add: img,
+			Descriptor: v1.Descriptor{
+				Platform: &v1.Platform{OS: "linux", Architecture: "amd64"},
+			},
+		},
+	)
+
+	indexRef, err := name.ParseReference(u.Host + "/kube-proxy:" + localRegistryVersion)
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(indexRef, index))
+
+	return u.Host
+}
+
+// writeImageTarball writes the image as tarball into the build path.
+func writeImageTarball(t *testing.T, buildPath string, img v1.Image) string {
+	t.Helper()
+
+	archPath := filepath.Join(buildPath, ImagesPath, "amd64")
+	require.NoError(t, os.MkdirAll(archPath, os.FileMode(0o755)))
+
+	tag, err := name.NewTag("registry.k8s.io/kube-proxy-amd64:" + localRegistryVersion)
+	require.NoError(t, err)
+
+	path := filepath.Join(archPath, "kube-proxy.tar")
+	require.NoError(t, tarball.WriteToFile(path, tag, img))
+
+	return path
+}
+
+func TestDefaultImageImplTarball(t *testing.T) {
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+
+	path := writeImageTarball(t, t.TempDir(), img)
+	sut := &defaultImageImpl{}
+
+	tag, err := sut.RepoTagFromTarball(path)
+	require.NoError(t, err)
+	require.Equal(t, "registry.k8s.io/kube-proxy-amd64:"+localRegistryVersion, tag)
+
+	configName, err := sut.TarballConfigName(path)
+	require.NoError(t, err)
+
+	expected, err := img.ConfigName()
+	require.NoError(t, err)
+	require.Equal(t, expected, configName)
+
+	_, err = sut.RepoTagFromTarball(filepath.Join(t.TempDir(), "missing.tar"))
+	require.Error(t, err)
+}
+
+func TestValidateLocalRegistry(t *testing.T) {
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+
+	other, err := random.Image(1024, 1)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name        string
+		localImage  v1.Image
+		shouldError bool
+	}{
+		{name: "matching local image", localImage: img},
+		{name: "different local image", localImage: other, shouldError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			host := pushLocalRegistryImage(t, img)
+			buildPath := t.TempDir()
+			writeImageTarball(t, buildPath, tc.localImage)
+
+			sut := NewImages()
+			require.NoError(t, sut.SetVerificationPolicy(
+				&ImageVerificationPolicy{Skip: true},
+			))
+
+			err := sut.Validate(host, localRegistryVersion, buildPath)
+			if tc.shouldError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
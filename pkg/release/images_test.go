@@ -23,17 +23,18 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
-	"time"
 
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"sigs.k8s.io/release-utils/http"
-
 	"k8s.io/release/pkg/release"
 	"k8s.io/release/pkg/release/releasefakes"
 )
 
+const testImageVersion = "v1.20.0"
+
 //nolint:maintidx // complex but acceptable
 func TestPublish(t *testing.T) {
 	t.Parallel()
@@ -300,108 +301,437 @@ func TestPublish(t *testing.T) {
 }
 
 func TestValidate(t *testing.T) {
+	t.Parallel()
+
 	for _, tc := range []struct {
-		prepare     func(*releasefakes.FakeImageImpl) (buildPath string, cleanup func())
+		name        string
+		prepare     func(*releasefakes.FakeImageImpl) (buildPath string)
 		shouldError bool
 	}{
-		{ // success
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+		{
+			name: "success",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
 				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
 
-				mock.ExecuteOutputReturns("digest", nil)
+				return tempDir
+			},
+			shouldError: false,
+		},
+		{
+			name: "success with OCI image index",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
+				tempDir := newImagesPath(t)
+				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
+				mock.RemoteIndexManifestCalls(func(string) (*v1.IndexManifest, error) {
+					index := testIndexManifest()
+					index.MediaType = ""
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+					for i := range index.Manifests {
+						index.Manifests[i].MediaType = types.OCIManifestSchema1
+					}
+
+					return index, nil
+				})
+
+				return tempDir
 			},
 			shouldError: false,
 		},
-		{ // failure on crane call
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+		{
+			name: "failure on remote manifest retrieval",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
 				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
+				mock.RemoteIndexManifestReturnsOnCall(1, nil, errors.New(""))
 
-				mock.ExecuteOutputReturnsOnCall(1, "", errors.New(""))
+				return tempDir
+			},
+			shouldError: true,
+		},
+		{
+			name: "failure no digest",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
+				tempDir := newImagesPath(t)
+				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
+				mock.RemoteIndexManifestCalls(func(string) (*v1.IndexManifest, error) {
+					index := testIndexManifest()
+					index.Manifests = index.Manifests[1:]
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+					return index, nil
+				})
+
+				return tempDir
 			},
 			shouldError: true,
 		},
-		{ // failure no digest
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+		{
+			name: "failure wrong OS",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
 				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
+				mock.RemoteIndexManifestCalls(func(string) (*v1.IndexManifest, error) {
+					index := testIndexManifest()
+					index.Manifests[0].Platform.OS = "windows"
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+					return index, nil
+				})
+
+				return tempDir
 			},
 			shouldError: true,
 		},
-		{ // failure on remote digest retrieval
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+		{
+			name: "failure wrong variant",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
 				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
+				mock.RemoteIndexManifestCalls(func(string) (*v1.IndexManifest, error) {
+					index := testIndexManifest()
+					index.Manifests[0].Platform.Variant = "v2"
 
-				mock.ExecuteOutputReturns("", errors.New(""))
+					return index, nil
+				})
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
 			},
 			shouldError: true,
 		},
-		{ // failure no images-path
-			prepare: func(*releasefakes.FakeImageImpl) (string, func()) {
-				return t.TempDir(), func() {}
+		{
+			name: "failure inconsistent media types",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
+				tempDir := newImagesPath(t)
+				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
+				mock.RemoteIndexManifestCalls(func(string) (*v1.IndexManifest, error) {
+					index := testIndexManifest()
+					index.Manifests[2].MediaType = types.OCIManifestSchema1
+
+					return index, nil
+				})
+
+				return tempDir
 			},
 			shouldError: true,
 		},
-		{ // failure on signature verify of image
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+		{
+			name: "failure unsupported manifest list media type",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
+				tempDir := newImagesPath(t)
+				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
+				mock.RemoteIndexManifestCalls(func(string) (*v1.IndexManifest, error) {
+					index := testIndexManifest()
+					index.MediaType = types.DockerManifestSchema2
+
+					return index, nil
+				})
+
+				return tempDir
+			},
+			shouldError: true,
+		},
+		{
+			name: "failure config digest mismatch",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
+				tempDir := newImagesPath(t)
+				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
+				mock.RemoteConfigNameReturnsOnCall(3, testHash("other"), nil)
+
+				return tempDir
+			},
+			shouldError: true,
+		},
+		{
+			name: "failure on local config digest retrieval",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
+				tempDir := newImagesPath(t)
+				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
+				mock.TarballConfigNameReturns(v1.Hash{}, errors.New(""))
+
+				return tempDir
+			},
+			shouldError: true,
+		},
+		{
+			name: "failure on remote config digest retrieval",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
 				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
+				mock.RemoteConfigNameReturns(v1.Hash{}, errors.New(""))
 
+				return tempDir
+			},
+			shouldError: true,
+		},
+		{
+			name: "failure no images-path",
+			prepare: func(*releasefakes.FakeImageImpl) string {
+				return t.TempDir()
+			},
+			shouldError: true,
+		},
+		{
+			name: "failure on signature verify of image",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
+				tempDir := newImagesPath(t)
+				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
 				mock.VerifyImageReturns(errors.New(""))
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
 			},
 			shouldError: true,
 		},
-		{ // failure on signature verify of manifest
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+		{
+			name: "failure on signature verify of manifest",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
 				prepareImages(t, tempDir, mock)
+				prepareRemoteImages(mock)
+				mock.VerifyImageReturnsOnCall(13, errors.New(""))
+
+				return tempDir
+			},
+			shouldError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
 
-				mock.ExecuteOutputReturns("digest", nil)
-				mock.VerifyImageReturnsOnCall(10, errors.New(""))
+			sut := release.NewImages()
+			clientMock := &releasefakes.FakeImageImpl{}
+			sut.SetImpl(clientMock)
+			buildPath := tc.prepare(clientMock)
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+			err := sut.Validate(release.GCRIOPathStaging, testImageVersion, buildPath)
+			if tc.shouldError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExists(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		registry    string
+		prepare     func(*releasefakes.FakeImageImpl)
+		fast        bool
+		exists      bool
+		shouldError bool
+	}{
+		{
+			name:     "success",
+			registry: release.GCRIOPathStaging,
+			prepare:  prepareRemoteImages,
+			exists:   true,
+		},
+		{
+			name:     "success fast",
+			registry: release.GCRIOPathStaging,
+			prepare: func(mock *releasefakes.FakeImageImpl) {
+				mock.RemoteIndexManifestCalls(func(string) (*v1.IndexManifest, error) {
+					index := testIndexManifest()
+					index.Manifests = index.Manifests[:1]
+
+					return index, nil
+				})
+			},
+			fast:   true,
+			exists: true,
+		},
+		{
+			name:     "success no registry",
+			registry: "",
+			prepare:  func(*releasefakes.FakeImageImpl) {},
+			exists:   true,
+		},
+		{
+			name:     "failure on remote manifest retrieval",
+			registry: release.GCRIOPathStaging,
+			prepare: func(mock *releasefakes.FakeImageImpl) {
+				mock.RemoteIndexManifestReturns(nil, errors.New(""))
+			},
+			shouldError: true,
+		},
+		{
+			name:     "failure missing architecture",
+			registry: release.GCRIOPathStaging,
+			prepare: func(mock *releasefakes.FakeImageImpl) {
+				mock.RemoteIndexManifestCalls(func(string) (*v1.IndexManifest, error) {
+					index := testIndexManifest()
+					index.Manifests = index.Manifests[:1]
+
+					return index, nil
+				})
 			},
 			shouldError: true,
 		},
 	} {
-		sut := release.NewImages()
-		clientMock := &releasefakes.FakeImageImpl{}
-		sut.SetImpl(clientMock)
-		buildPath, cleanup := tc.prepare(clientMock)
-		version, _ := fetchStableMarker()
-		// The staging registry has a 90d retention policy, so fetch a recent version of k/k
-		err := sut.Validate(release.GCRIOPathStaging, version, buildPath)
-		if tc.shouldError {
-			require.Error(t, err)
-		} else {
-			require.NoError(t, err)
-		}
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
 
-		cleanup()
+			sut := release.NewImages()
+			clientMock := &releasefakes.FakeImageImpl{}
+			tc.prepare(clientMock)
+			sut.SetImpl(clientMock)
+
+			exists, err := sut.Exists(tc.registry, testImageVersion, tc.fast)
+			if tc.shouldError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.exists, exists)
+		})
+	}
+}
+
+func TestVerifySignatures(t *testing.T) {
+	t.Parallel()
+
+	refs := []string{
+		"registry.k8s.io/kube-apiserver-amd64:v1.20.0",
+		"registry.k8s.io/kube-proxy-amd64:v1.20.0",
+		"registry.k8s.io/kube-apiserver:v1.20.0",
+	}
+
+	for _, tc := range []struct {
+		name         string
+		prepare      func(*releasefakes.FakeImageImpl)
+		skip         bool
+		expectedCall int
+		failed       []string
+	}{
+		{
+			name:         "success",
+			prepare:      func(*releasefakes.FakeImageImpl) {},
+			expectedCall: 3,
+		},
+		{
+			name: "failures are aggregated",
+			prepare: func(mock *releasefakes.FakeImageImpl) {
+				mock.VerifyImageReturnsOnCall(0, errors.New("not signed"))
+				mock.VerifyImageReturnsOnCall(2, errors.New("wrong identity"))
+			},
+			expectedCall: 3,
+			failed:       []string{refs[0], refs[2]},
+		},
+		{
+			name: "skipped by policy",
+			prepare: func(mock *releasefakes.FakeImageImpl) {
+				mock.VerifyImageReturns(errors.New("not signed"))
+			},
+			skip:         true,
+			expectedCall: 0,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sut := release.NewImages()
+			clientMock := &releasefakes.FakeImageImpl{}
+			tc.prepare(clientMock)
+			sut.SetImpl(clientMock)
+
+			policy := release.DefaultImageVerificationPolicy()
+			policy.Skip = tc.skip
+			require.NoError(t, sut.SetVerificationPolicy(policy))
+
+			report := sut.VerifySignatures(refs...)
+			require.Equal(t, tc.expectedCall, clientMock.VerifyImageCallCount())
+			require.Equal(t, tc.skip, report.Skipped)
+
+			failed := []string{}
+			for _, res := range report.Failed() {
+				failed = append(failed, res.Reference)
+			}
+
+			if len(tc.failed) == 0 {
+				require.Empty(t, failed)
+				require.NoError(t, report.Err())
+
+				return
+			}
+
+			require.Equal(t, tc.failed, failed)
+			require.ErrorContains(t, report.Err(), "2 of 3 images failed")
+			require.Contains(t, report.String(), refs[1]+": OK")
+		})
+	}
+}
+
+func TestImageVerificationPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		policy      *release.ImageVerificationPolicy
+		shouldError bool
+	}{
+		{
+			name:   "default",
+			policy: release.DefaultImageVerificationPolicy(),
+		},
+		{
+			name:   "skip without identities",
+			policy: &release.ImageVerificationPolicy{Skip: true},
+		},
+		{
+			name: "identity and issuer",
+			policy: &release.ImageVerificationPolicy{
+				CertIdentity:   "krel-staging@k8s-releng-prod.iam.gserviceaccount.com",
+				CertOidcIssuer: "https://accounts.google.com",
+			},
+		},
+		{
+			name: "missing identity",
+			policy: &release.ImageVerificationPolicy{
+				CertOidcIssuer: "https://accounts.google.com",
+			},
+			shouldError: true,
+		},
+		{
+			name: "missing issuer",
+			policy: &release.ImageVerificationPolicy{
+				CertIdentityRegexp: ".*",
+			},
+			shouldError: true,
+		},
+		{
+			name: "invalid regexp",
+			policy: &release.ImageVerificationPolicy{
+				CertIdentityRegexp: "(",
+				CertOidcIssuer:     "https://accounts.google.com",
+			},
+			shouldError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.policy.Validate()
+			if tc.shouldError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
 	}
 }
 
@@ -431,13 +761,12 @@ func prepareImages(t *testing.T, tempDir string, mock *releasefakes.FakeImageImp
 				[]byte{}, os.FileMode(0o644),
 			))
 
-			version, _ := fetchStableMarker()
 			mock.RepoTagFromTarballReturnsOnCall(
 				c,
 				fmt.Sprintf(
 					"registry.k8s.io/%s:%s",
 					strings.TrimSuffix(image, ".tar"),
-					version,
+					testImageVersion,
 				),
 				nil,
 			)
@@ -457,13 +786,36 @@ func failOnManifestSubcommand(mock *releasefakes.FakeImageImpl, subcmd string) {
 	})
 }
 
-func fetchStableMarker() (string, error) {
-	content, err := http.NewAgent().WithTimeout(30 * time.Second).Get("https://dl.k8s.io/release/stable.txt")
-	if err != nil {
-		fmt.Println("Error:", err)
+// prepareRemoteImages sets up the fake to return a manifest list for all
+// test architectures, where every entry matches the local image tarballs.
+func prepareRemoteImages(mock *releasefakes.FakeImageImpl) {
+	mock.RemoteIndexManifestReturns(testIndexManifest(), nil)
+	mock.TarballConfigNameReturns(testHash("config"), nil)
+	mock.RemoteConfigNameReturns(testHash("config"), nil)
+}
+
+func testIndexManifest() *v1.IndexManifest {
+	index := &v1.IndexManifest{
+		SchemaVersion: 2,
+		MediaType:     types.DockerManifestList,
+	}
 
-		return "", err
+	for _, arch := range []string{"amd64", "s390x", "ppc64le", "arm64"} {
+		index.Manifests = append(index.Manifests, v1.Descriptor{
+			MediaType: types.DockerManifestSchema2,
+			Digest:    testHash(arch),
+			Platform:  &v1.Platform{OS: "linux", Architecture: arch},
+		})
+	}
+
+	return index
+}
+
+func testHash(content string) v1.Hash {
+	hash, _, err := v1.SHA256(strings.NewReader(content))
+	if err != nil {
+		panic(err)
 	}
 
-	return string(content), nil
+	return hash
 }
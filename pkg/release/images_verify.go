@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package release
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-sdk/sign"
+)
+
+// ImageVerificationPolicy defines which container image signatures are
+// accepted when validating images.
+type ImageVerificationPolicy struct {
+	// Skip disables the signature verification completely.
+	Skip bool
+
+	// CertIdentity is the identity expected in a valid Fulcio certificate.
+	CertIdentity string
+
+	// CertIdentityRegexp is a regular expression alternative to
+	// CertIdentity.
+	CertIdentityRegexp string
+
+	// CertOidcIssuer is the OIDC issuer expected in a valid Fulcio
+	// certificate.
+	CertOidcIssuer string
+
+	// CertOidcIssuerRegexp is a regular expression alternative to
+	// CertOidcIssuer.
+	CertOidcIssuerRegexp string
+}
+
+// DefaultImageVerificationPolicy returns a new policy which accepts the
+// identities used for signing the Kubernetes release images.
+func DefaultImageVerificationPolicy() *ImageVerificationPolicy {
+	opts := sign.Default()
+
+	return &ImageVerificationPolicy{
+		CertIdentity:         opts.CertIdentity,
+		CertIdentityRegexp:   opts.CertIdentityRegexp,
+		CertOidcIssuer:       opts.CertOidcIssuer,
+		CertOidcIssuerRegexp: opts.CertOidcIssuerRegexp,
+	}
+}
+
+// Validate checks if the policy is complete.
+func (p *ImageVerificationPolicy) Validate() error {
+	if p.Skip {
+		return nil
+	}
+
+	if p.CertIdentity == "" && p.CertIdentityRegexp == "" {
+		return errors.New("either a certificate identity or identity regexp is required")
+	}
+
+	if p.CertOidcIssuer == "" && p.CertOidcIssuerRegexp == "" {
+		return errors.New("either a certificate OIDC issuer or issuer regexp is required")
+	}
+
+	for _, expr := range []string{p.CertIdentityRegexp, p.CertOidcIssuerRegexp} {
+		if expr == "" {
+			continue
+		}
+
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("compile regexp %q: %w", expr, err)
+		}
+	}
+
+	return nil
+}
+
+// signerOptions returns the signer options for the policy.
+func (p *ImageVerificationPolicy) signerOptions() *sign.Options {
+	opts := sign.Default()
+	opts.CertIdentity = p.CertIdentity
+	opts.CertIdentityRegexp = p.CertIdentityRegexp
+	opts.CertOidcIssuer = p.CertOidcIssuer
+	opts.CertOidcIssuerRegexp = p.CertOidcIssuerRegexp
+
+	return opts
+}
+
+// ImageVerificationResult is the signature verification result of a single
+// container image reference.
+type ImageVerificationResult struct {
+	// Reference is the verified container image reference.
+	Reference string
+
+	// Err is the verification error or nil if the signature is valid.
+	Err error
+}
+
+// ImageVerificationReport contains the aggregated signature verification
+// results of a set of container images.
+type ImageVerificationReport struct {
+	// Skipped indicates that the verification was disabled by the policy.
+	Skipped bool
+
+	// Results are the per image results in the order of verification.
+	Results []ImageVerificationResult
+}
+
+// Failed returns all results which did not pass the verification.
+func (r *ImageVerificationReport) Failed() []ImageVerificationResult {
+	failed := []ImageVerificationResult{}
+
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+
+	return failed
+}
+
+// Err returns an error containing all failed verifications or nil if every
+// image has a valid signature.
+func (r *ImageVerificationReport) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(failed))
+	for _, res := range failed {
+		errs = append(errs, fmt.Errorf("%s: %w", res.Reference, res.Err))
+	}
+
+	return fmt.Errorf(
+		"%d of %d images failed signature verification: %w",
+		len(failed), len(r.Results), errors.Join(errs...),
+	)
+}
+
+// String returns a human readable summary of the report.
+func (r *ImageVerificationReport) String() string {
+	if r.Skipped {
+		return "image signature verification skipped"
+	}
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "verified %d images, %d failed",
+		len(r.Results), len(r.Failed()),
+	)
+
+	for _, res := range r.Results {
+		status := "OK"
+		if res.Err != nil {
+			status = "FAILED: " + res.Err.Error()
+		}
+
+		fmt.Fprintf(sb, "\n  %s: %s", res.Reference, status)
+	}
+
+	return sb.String()
+}
+
+// SetVerificationPolicy validates and sets the policy used for verifying
+// image signatures.
+func (i *Images) SetVerificationPolicy(policy *ImageVerificationPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return fmt.Errorf("validate image verification policy: %w", err)
+	}
+
+	i.policy = policy
+	i.signer = sign.New(policy.signerOptions())
+
+	return nil
+}
+
+// VerifySignatures verifies the signatures of all provided image references
+// against the verification policy. It does not stop on the first failure,
+// but collects all results into the returned report.
+func (i *Images) VerifySignatures(refs ...string) *ImageVerificationReport {
+	if i.policy.Skip {
+		logrus.Warnf(
+			"Skipping signature verification of %d images as requested",
+			len(refs),
+		)
+
+		return &ImageVerificationReport{Skipped: true}
+	}
+
+	report := &ImageVerificationReport{
+		Results: make([]ImageVerificationResult, 0, len(refs)),
+	}
+
+	for _, ref := range refs {
+		logrus.Infof("Verifying that image is signed: %s", ref)
+
+		err := i.VerifyImage(i.signer, ref)
+		if err != nil {
+			logrus.Errorf("Signature verification failed for %s: %v", ref, err)
+		}
+
+		report.Results = append(report.Results, ImageVerificationResult{
+			Reference: ref,
+			Err:       err,
+		})
+	}
+
+	return report
+}
@@ -29,6 +29,8 @@ import (
 	"sigs.k8s.io/release-sdk/github"
 	"sigs.k8s.io/release-utils/command"
 	"sigs.k8s.io/release-utils/env"
+
+	"k8s.io/release/pkg/objectstore"
 )
 
 // PrerequisitesChecker is the main type for checking the prerequisites for a
@@ -41,6 +43,10 @@ type PrerequisitesChecker struct {
 // Type prerequisites checker.
 type PrerequisitesCheckerOptions struct {
 	CheckGitHubToken bool
+
+	// Bucket is the bucket used for the release. The Google Cloud tooling is
+	// only required if it's stored in Google Cloud Storage.
+	Bucket string
 }
 
 var DefaultPrerequisitesCheckerOptions = &PrerequisitesCheckerOptions{
@@ -60,6 +66,11 @@ func (p *PrerequisitesChecker) Options() *PrerequisitesCheckerOptions {
 	return p.opts
 }
 
+// SetOptions can be used to set the options of the PrerequisitesChecker.
+func (p *PrerequisitesChecker) SetOptions(opts *PrerequisitesCheckerOptions) {
+	p.opts = opts
+}
+
 // SetImpl can be used to set the internal PrerequisitesChecker implementation.
 func (p *PrerequisitesChecker) SetImpl(impl prerequisitesCheckerImpl) {
 	p.impl = impl
@@ -114,9 +125,13 @@ func (*defaultPrerequisitesChecker) Usage(dir string) (*disk.UsageStat, error) {
 
 func (p *PrerequisitesChecker) Run(workdir string) error {
 	// Command checks
-	commands := []string{
-		"docker", "jq", "gsutil", "gcloud", "ssh",
+	checkGCS := objectstore.IsGCS(p.opts.Bucket)
+
+	commands := []string{"docker", "jq", "ssh"}
+	if checkGCS {
+		commands = append(commands, "gsutil", "gcloud")
 	}
+
 	logrus.Infof(
 		"Verifying that the commands %s exist in $PATH.",
 		strings.Join(commands, ", "),
@@ -127,12 +142,14 @@ func (p *PrerequisitesChecker) Run(workdir string) error {
 	}
 
 	// Google Cloud checks
-	logrus.Info("Verifying Google Cloud access")
+	if checkGCS {
+		logrus.Info("Verifying Google Cloud access")
 
-	if _, err := p.impl.GCloudOutput(
-		"config", "get-value", "project",
-	); err != nil {
-		return fmt.Errorf("no account authorized through gcloud: %w", err)
+		if _, err := p.impl.GCloudOutput(
+			"config", "get-value", "project",
+		); err != nil {
+			return fmt.Errorf("no account authorized through gcloud: %w", err)
+		}
 	}
 
 	// GitHub checks
@@ -124,3 +124,46 @@ func TestCheckPrerequisites(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckPrerequisitesBucket(t *testing.T) {
+	for _, tc := range []struct {
+		bucket        string
+		checksGCloud  bool
+		expectedTools []string
+	}{
+		{
+			bucket:        "kubernetes-release-gcb",
+			checksGCloud:  true,
+			expectedTools: []string{"docker", "jq", "ssh", "gsutil", "gcloud"},
+		},
+		{
+			bucket:        "s3://kubernetes-release",
+			expectedTools: []string{"docker", "jq", "ssh"},
+		},
+		{
+			bucket:        "file:///tmp/kubernetes-release",
+			expectedTools: []string{"docker", "jq", "ssh"},
+		},
+	} {
+		t.Run(tc.bucket, func(t *testing.T) {
+			mock := &releasefakes.FakePrerequisitesCheckerImpl{}
+			mock.CommandAvailableReturns(true)
+			mock.IsEnvSetReturns(true)
+			mock.UsageReturns(&disk.UsageStat{Free: 101 * 1024 * 1024 * 1024}, nil)
+			mock.GCloudOutputReturns("", errors.New("no gcloud"))
+
+			sut := release.NewPrerequisitesChecker()
+			sut.SetOptions(&release.PrerequisitesCheckerOptions{Bucket: tc.bucket})
+			sut.SetImpl(mock)
+
+			err := sut.Run("")
+			if tc.checksGCloud {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expectedTools, mock.CommandAvailableArgsForCall(0))
+		})
+	}
+}
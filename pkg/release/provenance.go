@@ -29,24 +29,23 @@ import (
 
 	"sigs.k8s.io/bom/pkg/provenance"
 	"sigs.k8s.io/bom/pkg/spdx"
-	"sigs.k8s.io/release-sdk/object"
 	"sigs.k8s.io/release-utils/helpers"
+
+	"k8s.io/release/pkg/objectstore"
 )
 
 // ProvenanceChecker is the main structure to check the provenance.
 type ProvenanceChecker struct {
-	objStore *object.GCS
+	objStore objectstore.Store
 	options  *ProvenanceCheckerOptions
 	impl     provenanceCheckerImplementation
 }
 
 func NewProvenanceChecker(opts *ProvenanceCheckerOptions) *ProvenanceChecker {
 	p := &ProvenanceChecker{
-		objStore: object.NewGCS(),
+		objStore: objectstore.New(opts.StageBucket),
 		options:  opts,
 	}
-	p.objStore.WithConcurrent(true)
-	p.objStore.WithRecursive(true)
 	p.impl = &defaultProvenanceCheckerImpl{}
 
 	return p
@@ -64,13 +63,13 @@ func (pc *ProvenanceChecker) CheckStageProvenance(buildVersion string) error {
 	pc.options.StageDirectory = filepath.Join(pc.options.ScratchDirectory, hex.EncodeToString(h.Sum(nil)))
 
 	gcsPath, err := pc.objStore.NormalizePath(
-		object.GcsPrefix + filepath.Join(
-			pc.options.StageBucket, StagePath, buildVersion,
-		) + string(filepath.Separator),
+		pc.options.StageBucket, StagePath, buildVersion,
 	)
 	if err != nil {
 		return fmt.Errorf("normalizing GCS stage path: %w", err)
 	}
+
+	gcsPath += string(filepath.Separator)
 	// Download all the artifacts from the bucket
 	if err := pc.impl.downloadStagedArtifacts(pc.options, pc.objStore, gcsPath); err != nil {
 		return fmt.Errorf("downloading staged artifacts: %w", err)
@@ -122,7 +121,7 @@ type ProvenanceCheckerOptions struct {
 }
 
 type provenanceCheckerImplementation interface {
-	downloadStagedArtifacts(*ProvenanceCheckerOptions, *object.GCS, string) error
+	downloadStagedArtifacts(*ProvenanceCheckerOptions, objectstore.Store, string) error
 	processAttestation(*ProvenanceCheckerOptions, string) (*provenance.Statement, error)
 	checkProvenance(*ProvenanceCheckerOptions, *provenance.Statement) error
 	generateFinalAttestation(opts *ProvenanceCheckerOptions, sbom, stageProvenance, version string) error
@@ -132,7 +131,7 @@ type defaultProvenanceCheckerImpl struct{}
 
 // downloadReleaseArtifacts sybc.
 func (di *defaultProvenanceCheckerImpl) downloadStagedArtifacts(
-	opts *ProvenanceCheckerOptions, objStore *object.GCS, path string,
+	opts *ProvenanceCheckerOptions, objStore objectstore.Store, path string,
 ) error {
 	logrus.Infof("Synching stage from %s to %s", path, opts.StageDirectory)
 
@@ -160,8 +159,11 @@ func (di *defaultProvenanceCheckerImpl) processAttestation(
 	}
 
 	// We've downloaded all artifacts, so to check we need to strip
-	// the gcs bucket prefix from the subjects to read from the local copy
-	gcsPath := object.GcsPrefix + filepath.Join(opts.StageBucket, StagePath)
+	// the bucket prefix from the subjects to read from the local copy
+	gcsPath, err := bucketPath(opts.StageBucket, StagePath)
+	if err != nil {
+		return nil, err
+	}
 
 	newSubjects := []intoto.Subject{}
 
@@ -200,9 +202,12 @@ func (di *defaultProvenanceCheckerImpl) generateFinalAttestation(
 
 	// Rewrite the provenance sublects to list their full paths in the bucket
 	for i, sub := range slsaStatement.Subject {
-		slsaStatement.Subject[i].Name = object.GcsPrefix + filepath.Join(
-			opts.StageBucket, "release", version, sub.Name,
-		)
+		name, err := bucketPath(opts.StageBucket, "release", version, sub.Name)
+		if err != nil {
+			return err
+		}
+
+		slsaStatement.Subject[i].Name = name
 	}
 
 	if err := slsaStatement.ClonePredicate(stageProvenance); err != nil {
@@ -262,9 +267,6 @@ func (di *defaultProvenanceReaderImpl) GetStagingSubjects(
 	// Create the dummy statement to read artifacts
 	dummy := provenance.NewSLSAStatement()
 
-	// The path in the bucket were built artifacts will be staged
-	gcsPath := filepath.Join(opts.Bucket, StagePath, opts.BuildVersion)
-
 	info, err := os.Stat(path)
 	if err != nil {
 		return nil, fmt.Errorf("checking artifact path to generate provenance subjects: %w", err)
@@ -285,8 +287,15 @@ func (di *defaultProvenanceReaderImpl) GetStagingSubjects(
 		dummy.Subject[0].Name = SourcesTar
 	}
 
+	// Rewrite the subjects to the path in the bucket were built artifacts
+	// will be staged
 	for i, s := range dummy.Subject {
-		dummy.Subject[i].Name = object.GcsPrefix + filepath.Join(gcsPath, s.Name)
+		name, err := bucketPath(opts.Bucket, StagePath, opts.BuildVersion, s.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		dummy.Subject[i].Name = name
 	}
 
 	return dummy.Subject, nil
@@ -295,9 +304,6 @@ func (di *defaultProvenanceReaderImpl) GetStagingSubjects(
 func (di *defaultProvenanceReaderImpl) GetBuildSubjects(
 	opts *ProvenanceReaderOptions, path, version string,
 ) ([]intoto.Subject, error) {
-	// The path in the bucket were built artifacts will be staged
-	gcsPath := filepath.Join(opts.Bucket, StagePath, opts.BuildVersion)
-
 	// When adding the output directory for a specific version, we need
 	// to modiy the paths in the attestation to match the bucket names.
 	// In order to do that, we create a dummy statement. Use that to read
@@ -322,10 +328,27 @@ func (di *defaultProvenanceReaderImpl) GetBuildSubjects(
 		// Now the tricky part. We need to re-append the version tag. Eg
 		// gcs-stage/v1.23.0-alpha.4/file.txt should be
 		// v1.23.0-alpha.4/gcs-stage/v1.23.0-alpha.4/file.txt should be
-		subject.Name = object.GcsPrefix + filepath.Join(gcsPath, version, subject.Name)
+		name, err := bucketPath(opts.Bucket, StagePath, opts.BuildVersion, version, subject.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		subject.Name = name
 
 		newSubjects = append(newSubjects, subject)
 	}
 
 	return newSubjects, nil
 }
+
+// bucketPath returns the URI of the path in the bucket, using the URL scheme
+// of the object store responsible for the bucket, like `gs://`, `s3://` or
+// `file://`.
+func bucketPath(bucket string, pathParts ...string) (string, error) {
+	p, err := objectstore.New(bucket).NormalizePath(append([]string{bucket}, pathParts...)...)
+	if err != nil {
+		return "", fmt.Errorf("normalizing path in bucket %s: %w", bucket, err)
+	}
+
+	return p, nil
+}
@@ -128,3 +128,21 @@ func TestGetStagingSubjects(t *testing.T) {
 		}
 	}
 }
+
+func TestBucketPath(t *testing.T) {
+	for _, tc := range []struct {
+		bucket   string
+		expected string
+	}{
+		{bucket: "test-bucket", expected: "gs://test-bucket/stage/v1.0/LICENSE"},
+		{bucket: "gs://test-bucket", expected: "gs://test-bucket/stage/v1.0/LICENSE"},
+		{bucket: "s3://test-bucket", expected: "s3://test-bucket/stage/v1.0/LICENSE"},
+		{bucket: "file:///tmp/test-bucket", expected: "file:///tmp/test-bucket/stage/v1.0/LICENSE"},
+	} {
+		t.Run(tc.bucket, func(t *testing.T) {
+			p, err := bucketPath(tc.bucket, StagePath, "v1.0", "LICENSE")
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, p)
+		})
+	}
+}
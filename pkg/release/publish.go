@@ -31,11 +31,10 @@ import (
 	"github.com/fastly/go-fastly/v13/fastly"
 	"github.com/sirupsen/logrus"
 
-	"sigs.k8s.io/release-sdk/gcli"
-	"sigs.k8s.io/release-sdk/object"
 	"sigs.k8s.io/release-utils/helpers"
 
 	"k8s.io/release/pkg/consts"
+	"k8s.io/release/pkg/objectstore"
 )
 
 // Publisher is the structure for publishing anything release related.
@@ -45,13 +44,8 @@ type Publisher struct {
 
 // NewPublisher creates a new Publisher instance.
 func NewPublisher() *Publisher {
-	objStore := *object.NewGCS()
-	objStore.SetOptions(
-		objStore.WithNoClobber(false),
-	)
-
 	return &Publisher{
-		client: &defaultPublisher{&objStore},
+		client: &defaultPublisher{},
 	}
 }
 
@@ -60,14 +54,14 @@ func (p *Publisher) SetClient(client publisherClient) {
 	p.client = client
 }
 
-// publisherClient is a client for working with GCS
+// publisherClient is a client for working with the object store.
 //
 //counterfeiter:generate . publisherClient
 //nolint:interfacebloat // large interface is by design
 type publisherClient interface {
-	GSUtil(args ...string) error
-	GSUtilOutput(args ...string) (string, error)
-	GSUtilStatus(args ...string) (bool, error)
+	PathExists(path string) (bool, error)
+	ReadObject(path string) (string, error)
+	CopyFileToRemote(local, remote string, attrs *objectstore.ObjectAttrs) error
 	GetURLResponse(url string) (string, error)
 	GetReleasePath(bucket, gcsRoot, version string, fast bool) (string, error)
 	GetMarkerPath(bucket, gcsRoot string, fast bool) (string, error)
@@ -81,25 +75,34 @@ type publisherClient interface {
 	CopyToRemote(local, remote string) error
 }
 
-type defaultPublisher struct {
-	objStore object.Store
-}
+// defaultPublisher selects the object store for each operation by the URL
+// scheme of the provided path.
+type defaultPublisher struct{}
 
-func (*defaultPublisher) GSUtil(args ...string) error {
-	return gcli.GSUtil(args...)
+func (*defaultPublisher) store(path string) objectstore.Store {
+	store := objectstore.New(path)
+	store.SetOptions(store.WithNoClobber(false))
+
+	return store
 }
 
-func (*defaultPublisher) GSUtilOutput(args ...string) (string, error) {
-	return gcli.GSUtilOutput(args...)
+func (d *defaultPublisher) PathExists(path string) (bool, error) {
+	return d.store(path).PathExists(path)
 }
 
-func (*defaultPublisher) GSUtilStatus(args ...string) (bool, error) {
-	status, err := gcli.GSUtilStatus(args...)
+func (d *defaultPublisher) ReadObject(path string) (string, error) {
+	content, err := d.store(path).ReadObject(path)
 	if err != nil {
-		return false, err
+		return "", err
 	}
 
-	return status.Success(), nil
+	return string(bytes.TrimSpace(content)), nil
+}
+
+func (d *defaultPublisher) CopyFileToRemote(
+	local, remote string, attrs *objectstore.ObjectAttrs,
+) error {
+	return d.store(remote).CopyFileToRemote(local, remote, attrs)
 }
 
 func (*defaultPublisher) GetURLResponse(url string) (string, error) {
@@ -114,17 +117,21 @@ func (*defaultPublisher) GetURLResponse(url string) (string, error) {
 func (d *defaultPublisher) GetReleasePath(
 	bucket, gcsRoot, version string, fast bool,
 ) (string, error) {
-	return d.objStore.GetReleasePath(bucket, gcsRoot, version, fast)
+	return d.store(bucket).GetReleasePath(bucket, gcsRoot, version, fast)
 }
 
 func (d *defaultPublisher) GetMarkerPath(
 	bucket, gcsRoot string, fast bool,
 ) (string, error) {
-	return d.objStore.GetMarkerPath(bucket, gcsRoot, fast)
+	return d.store(bucket).GetMarkerPath(bucket, gcsRoot, fast)
 }
 
 func (d *defaultPublisher) NormalizePath(pathParts ...string) (string, error) {
-	return d.objStore.NormalizePath(pathParts...)
+	if len(pathParts) == 0 {
+		return "", errors.New("must contain at least one path part")
+	}
+
+	return d.store(pathParts[0]).NormalizePath(pathParts...)
 }
 
 func (*defaultPublisher) TempDir(dir, pattern string) (name string, err error) {
@@ -132,7 +139,7 @@ func (*defaultPublisher) TempDir(dir, pattern string) (name string, err error) {
 }
 
 func (d *defaultPublisher) CopyToLocal(remote, local string) error {
-	return d.objStore.CopyToLocal(remote, local)
+	return d.store(remote).CopyToLocal(remote, local)
 }
 
 func (*defaultPublisher) ReadFile(filename string) ([]byte, error) {
@@ -152,7 +159,7 @@ func (*defaultPublisher) TempFile(dir, pattern string) (f *os.File, err error) {
 }
 
 func (d *defaultPublisher) CopyToRemote(local, remote string) error {
-	return d.objStore.CopyToRemote(local, remote)
+	return d.store(remote).CopyToRemote(local, remote)
 }
 
 // Publish a new version, (latest or stable) but only if the files actually
@@ -174,18 +181,11 @@ func (p *Publisher) PublishVersion(
 ) error {
 	logrus.Info("Publishing version")
 
-	releaseType := "latest"
-
-	if buildType == "release" {
-		// For release/ targets, type should be 'stable'
-		if !strings.Contains(version, ReleaseTypeAlpha) && !strings.Contains(version, ReleaseTypeBeta) && !strings.Contains(version, ReleaseTypeRC) {
-			releaseType = "stable"
-		}
-	}
-
-	sv, err := helpers.TagStringToSemver(version)
+	versionMarkers, err := VersionMarkers(
+		buildType, version, extraVersionMarkers, fast,
+	)
 	if err != nil {
-		return fmt.Errorf("invalid version %s", version)
+		return err
 	}
 
 	markerPath, markerPathErr := p.client.GetMarkerPath(
@@ -208,28 +208,13 @@ func (p *Publisher) PublishVersion(
 	}
 
 	// TODO: This should probably be a more thorough check of explicit files
-	// TODO: This should explicitly do a `gsutil ls` via gcs.PathExists
-	if err := p.client.GSUtil("ls", releasePath); err != nil {
-		return fmt.Errorf("release files don't exist at %s: %w", releasePath, err)
+	exists, err := p.client.PathExists(releasePath)
+	if err != nil {
+		return fmt.Errorf("check if release files exist at %s: %w", releasePath, err)
 	}
 
-	var versionMarkers []string
-	if fast {
-		versionMarkers = append(
-			versionMarkers,
-			releaseType+"-fast",
-		)
-	} else {
-		versionMarkers = append(
-			versionMarkers,
-			releaseType,
-			fmt.Sprintf("%s-%d", releaseType, sv.Major),
-			fmt.Sprintf("%s-%d.%d", releaseType, sv.Major, sv.Minor),
-		)
-	}
-
-	if len(extraVersionMarkers) > 0 {
-		versionMarkers = append(versionMarkers, extraVersionMarkers...)
+	if !exists {
+		return fmt.Errorf("release files don't exist at %s", releasePath)
 	}
 
 	logrus.Infof("Publish version markers: %v", versionMarkers)
@@ -266,6 +251,48 @@ func (p *Publisher) PublishVersion(
 	return nil
 }
 
+// VersionMarkers returns the names of the version markers which are
+// considered for an update when publishing the version, for example
+// `stable`, `stable-1` and `stable-1.20` for `v1.20.0`.
+func VersionMarkers(
+	buildType, version string, extraVersionMarkers []string, fast bool,
+) ([]string, error) {
+	releaseType := "latest"
+
+	if buildType == "release" {
+		// For release/ targets, type should be 'stable'
+		if !strings.Contains(version, ReleaseTypeAlpha) && !strings.Contains(version, ReleaseTypeBeta) && !strings.Contains(version, ReleaseTypeRC) {
+			releaseType = "stable"
+		}
+	}
+
+	sv, err := helpers.TagStringToSemver(version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %s", version)
+	}
+
+	var versionMarkers []string
+	if fast {
+		versionMarkers = append(
+			versionMarkers,
+			releaseType+"-fast",
+		)
+	} else {
+		versionMarkers = append(
+			versionMarkers,
+			releaseType,
+			fmt.Sprintf("%s-%d", releaseType, sv.Major),
+			fmt.Sprintf("%s-%d.%d", releaseType, sv.Major, sv.Minor),
+		)
+	}
+
+	if len(extraVersionMarkers) > 0 {
+		versionMarkers = append(versionMarkers, extraVersionMarkers...)
+	}
+
+	return versionMarkers, nil
+}
+
 // VerifyLatestUpdate checks if the new version is greater than the version
 // currently published on GCS. It returns `true` for `needsUpdate` if the remote
 // version does not exist or needs to be updated.
@@ -282,8 +309,7 @@ func (p *Publisher) VerifyLatestUpdate(
 		return false, fmt.Errorf("get marker file destination: %w", publishFileDstErr)
 	}
 
-	// TODO: Should we add a object.`GCS` method for `gsutil cat`?
-	gcsVersion, err := p.client.GSUtilOutput("cat", publishFileDst)
+	gcsVersion, err := p.client.ReadObject(publishFileDst)
 	if err != nil {
 		logrus.Infof("%s does not exist but will be created", publishFileDst)
 		//nolint:nilerr // returning nil is intentional
@@ -376,7 +402,7 @@ func (p *Publisher) PublishToGcs(
 		return fmt.Errorf("write latest version file: %w", err)
 	}
 
-	logrus.Infof("Running `gsutil cp` from %s to: %s", latestFile, publishFileDst)
+	logrus.Infof("Copying %s to: %s", latestFile, publishFileDst)
 
 	// https://www.fastly.com/documentation/guides/full-site-delivery/purging/working-with-surrogate-keys/
 	var surrogateKey string
@@ -386,14 +412,14 @@ func (p *Publisher) PublishToGcs(
 		surrogateKey = "ci-markers"
 	}
 
-	if err := p.client.GSUtil(
-		"-m",
-		"-h", "Content-Type:text/plain",
-		"-h", "Cache-Control:private, max-age=0, no-transform",
-		"-h", "x-goog-meta-surrogate-key: "+surrogateKey,
-		"cp",
+	if err := p.client.CopyFileToRemote(
 		latestFile,
 		publishFileDst,
+		&objectstore.ObjectAttrs{
+			ContentType:  "text/plain",
+			CacheControl: "private, max-age=0, no-transform",
+			Metadata:     map[string]string{"surrogate-key": surrogateKey},
+		},
 	); err != nil {
 		return fmt.Errorf("copy %s to %s: %w", latestFile, publishFileDst, err)
 	}
@@ -438,10 +464,13 @@ func (p *Publisher) PublishToGcs(
 		logrus.Infof("Successfully purged our cache: %v", purgeResponse.Status)
 	}
 
+	// Only Google Cloud Storage buckets are served by a public URL, all
+	// other stores get validated by reading the object back.
+	validatePublicLink := !privateBucket && objectstore.IsGCS(markerPath)
 	validationDeadline := time.Now().Add(3 * time.Minute)
 
 	for {
-		if !privateBucket {
+		if validatePublicLink {
 			// If public, validate public link
 			logrus.Infof("Validating uploaded version file using HTTP at %s", publicLink)
 
@@ -459,9 +488,9 @@ func (p *Publisher) PublishToGcs(
 			}
 		} else {
 			// Use the private location
-			logrus.Infof("Validating uploaded version file using `gsutil cat` at %s", publishFileDst)
+			logrus.Infof("Validating uploaded version file by reading it from %s", publishFileDst)
 
-			response, err := p.client.GSUtilOutput("cat", publishFileDst)
+			response, err := p.client.ReadObject(publishFileDst)
 			if err == nil && version == response {
 				logrus.Info("Version equals response")
 
@@ -476,7 +505,7 @@ func (p *Publisher) PublishToGcs(
 		}
 
 		if time.Now().After(validationDeadline) {
-			if !privateBucket {
+			if validatePublicLink {
 				return fmt.Errorf("timed out validating marker content at %s after 3 minutes", publicLink)
 			}
 
@@ -515,9 +544,9 @@ func (p *Publisher) PublishReleaseNotesIndex(
 
 	logrus.Infof("Publishing release notes index %s", indexFilePath)
 
-	success, err := p.client.GSUtilStatus("-q", "stat", indexFilePath)
+	success, err := p.client.PathExists(indexFilePath)
 	if err != nil {
-		return fmt.Errorf("run gcsutil stat: %w", err)
+		return fmt.Errorf("check if index file exists: %w", err)
 	}
 
 	logrus.Info("Building release notes index")
@@ -35,11 +35,12 @@ func TestPublishVersion(t *testing.T) {
 	)
 
 	mockVersionMarkers := func(mock *releasefakes.FakePublisherClient) {
-		mock.GSUtilOutputReturnsOnCall(0, olderTestVersion, nil)
-		mock.GSUtilOutputReturnsOnCall(1, testVersion, nil)
-		mock.GSUtilOutputReturnsOnCall(2, olderTestVersion, nil)
-		mock.GSUtilOutputReturnsOnCall(3, testVersion, nil)
-		mock.GSUtilOutputReturnsOnCall(4, olderTestVersion, nil)
+		mock.PathExistsReturns(true, nil)
+		mock.ReadObjectReturnsOnCall(0, olderTestVersion, nil)
+		mock.ReadObjectReturnsOnCall(1, testVersion, nil)
+		mock.ReadObjectReturnsOnCall(2, olderTestVersion, nil)
+		mock.ReadObjectReturnsOnCall(3, testVersion, nil)
+		mock.ReadObjectReturnsOnCall(4, olderTestVersion, nil)
 	}
 
 	for _, tc := range []struct {
@@ -57,8 +58,9 @@ func TestPublishVersion(t *testing.T) {
 			version: testVersion,
 			fast:    true,
 			prepare: func(mock *releasefakes.FakePublisherClient) {
-				mock.GSUtilOutputReturnsOnCall(0, olderTestVersion, nil)
-				mock.GSUtilOutputReturnsOnCall(1, testVersion, nil)
+				mock.PathExistsReturns(true, nil)
+				mock.ReadObjectReturnsOnCall(0, olderTestVersion, nil)
+				mock.ReadObjectReturnsOnCall(1, testVersion, nil)
 				mock.GetURLResponseReturns(testVersion, nil)
 			},
 			shouldError: false,
@@ -76,7 +78,7 @@ func TestPublishVersion(t *testing.T) {
 			privateBucket: true,
 			prepare: func(mock *releasefakes.FakePublisherClient) {
 				mockVersionMarkers(mock)
-				mock.GSUtilOutputReturnsOnCall(5, testVersion, nil)
+				mock.ReadObjectReturnsOnCall(5, testVersion, nil)
 			},
 			shouldError: false,
 		},
@@ -87,7 +89,7 @@ func TestPublishVersion(t *testing.T) {
 			privateBucket: true,
 			prepare: func(mock *releasefakes.FakePublisherClient) {
 				mockVersionMarkers(mock)
-				mock.GSUtilOutputReturnsOnCall(5, "", errors.New(""))
+				mock.ReadObjectReturnsOnCall(5, "", errors.New(""))
 			},
 			shouldError: true,
 		},
@@ -98,7 +100,7 @@ func TestPublishVersion(t *testing.T) {
 			privateBucket: true,
 			prepare: func(mock *releasefakes.FakePublisherClient) {
 				mockVersionMarkers(mock)
-				mock.GSUtilOutputReturnsOnCall(5, "wrong", nil)
+				mock.ReadObjectReturnsOnCall(5, "wrong", nil)
 			},
 			shouldError: true,
 		},
@@ -130,7 +132,17 @@ func TestPublishVersion(t *testing.T) {
 			version:       testVersion,
 			privateBucket: false,
 			prepare: func(mock *releasefakes.FakePublisherClient) {
-				mock.GSUtilReturnsOnCall(0, errors.New(""))
+				mock.PathExistsReturns(false, nil)
+			},
+			shouldError: true,
+		},
+		{ // failure release files existence check
+			bucket:        release.ProductionBucket,
+			gcsRoot:       "release",
+			version:       testVersion,
+			privateBucket: false,
+			prepare: func(mock *releasefakes.FakePublisherClient) {
+				mock.PathExistsReturns(false, errors.New(""))
 			},
 			shouldError: true,
 		},
@@ -177,7 +189,7 @@ func TestPublishReleaseNotesIndex(t *testing.T) {
 		{ // success existing
 			prepare: func(mock *releasefakes.FakePublisherClient) {
 				mock.TempFileCalls(os.CreateTemp)
-				mock.GSUtilStatusReturns(true, nil)
+				mock.PathExistsReturns(true, nil)
 			},
 			shouldError: false,
 		},
@@ -202,35 +214,35 @@ func TestPublishReleaseNotesIndex(t *testing.T) {
 		},
 		{ // failure Unmarshal
 			prepare: func(mock *releasefakes.FakePublisherClient) {
-				mock.GSUtilStatusReturns(true, nil)
+				mock.PathExistsReturns(true, nil)
 				mock.UnmarshalReturns(err)
 			},
 			shouldError: true,
 		},
 		{ // failure ReadFile
 			prepare: func(mock *releasefakes.FakePublisherClient) {
-				mock.GSUtilStatusReturns(true, nil)
+				mock.PathExistsReturns(true, nil)
 				mock.ReadFileReturns(nil, err)
 			},
 			shouldError: true,
 		},
 		{ // failure CopyToLocal
 			prepare: func(mock *releasefakes.FakePublisherClient) {
-				mock.GSUtilStatusReturns(true, nil)
+				mock.PathExistsReturns(true, nil)
 				mock.CopyToLocalReturns(err)
 			},
 			shouldError: true,
 		},
 		{ // failure TempDir
 			prepare: func(mock *releasefakes.FakePublisherClient) {
-				mock.GSUtilStatusReturns(true, nil)
+				mock.PathExistsReturns(true, nil)
 				mock.TempDirReturns("", err)
 			},
 			shouldError: true,
 		},
-		{ // failure GSUtilStatus
+		{ // failure PathExists
 			prepare: func(mock *releasefakes.FakePublisherClient) {
-				mock.GSUtilStatusReturns(false, err)
+				mock.PathExistsReturns(false, err)
 			},
 			shouldError: true,
 		},
@@ -20,6 +20,7 @@ package releasefakes
 import (
 	"sync"
 
+	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"sigs.k8s.io/release-sdk/sign"
 )
 
@@ -36,18 +37,30 @@ type FakeImageImpl struct {
 	executeReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ExecuteOutputStub        func(string, ...string) (string, error)
-	executeOutputMutex       sync.RWMutex
-	executeOutputArgsForCall []struct {
+	RemoteConfigNameStub        func(string) (v1.Hash, error)
+	remoteConfigNameMutex       sync.RWMutex
+	remoteConfigNameArgsForCall []struct {
 		arg1 string
-		arg2 []string
 	}
-	executeOutputReturns struct {
-		result1 string
+	remoteConfigNameReturns struct {
+		result1 v1.Hash
 		result2 error
 	}
-	executeOutputReturnsOnCall map[int]struct {
-		result1 string
+	remoteConfigNameReturnsOnCall map[int]struct {
+		result1 v1.Hash
+		result2 error
+	}
+	RemoteIndexManifestStub        func(string) (*v1.IndexManifest, error)
+	remoteIndexManifestMutex       sync.RWMutex
+	remoteIndexManifestArgsForCall []struct {
+		arg1 string
+	}
+	remoteIndexManifestReturns struct {
+		result1 *v1.IndexManifest
+		result2 error
+	}
+	remoteIndexManifestReturnsOnCall map[int]struct {
+		result1 *v1.IndexManifest
 		result2 error
 	}
 	RepoTagFromTarballStub        func(string) (string, error)
@@ -75,6 +88,19 @@ type FakeImageImpl struct {
 	signImageReturnsOnCall map[int]struct {
 		result1 error
 	}
+	TarballConfigNameStub        func(string) (v1.Hash, error)
+	tarballConfigNameMutex       sync.RWMutex
+	tarballConfigNameArgsForCall []struct {
+		arg1 string
+	}
+	tarballConfigNameReturns struct {
+		result1 v1.Hash
+		result2 error
+	}
+	tarballConfigNameReturnsOnCall map[int]struct {
+		result1 v1.Hash
+		result2 error
+	}
 	VerifyImageStub        func(*sign.Signer, string) error
 	verifyImageMutex       sync.RWMutex
 	verifyImageArgsForCall []struct {
@@ -153,19 +179,18 @@ func (fake *FakeImageImpl) ExecuteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeImageImpl) ExecuteOutput(arg1 string, arg2 ...string) (string, error) {
-	fake.executeOutputMutex.Lock()
-	ret, specificReturn := fake.executeOutputReturnsOnCall[len(fake.executeOutputArgsForCall)]
-	fake.executeOutputArgsForCall = append(fake.executeOutputArgsForCall, struct {
+func (fake *FakeImageImpl) RemoteConfigName(arg1 string) (v1.Hash, error) {
+	fake.remoteConfigNameMutex.Lock()
+	ret, specificReturn := fake.remoteConfigNameReturnsOnCall[len(fake.remoteConfigNameArgsForCall)]
+	fake.remoteConfigNameArgsForCall = append(fake.remoteConfigNameArgsForCall, struct {
 		arg1 string
-		arg2 []string
-	}{arg1, arg2})
-	stub := fake.ExecuteOutputStub
-	fakeReturns := fake.executeOutputReturns
-	fake.recordInvocation("ExecuteOutput", []interface{}{arg1, arg2})
-	fake.executeOutputMutex.Unlock()
+	}{arg1})
+	stub := fake.RemoteConfigNameStub
+	fakeReturns := fake.remoteConfigNameReturns
+	fake.recordInvocation("RemoteConfigName", []interface{}{arg1})
+	fake.remoteConfigNameMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2...)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -173,47 +198,111 @@ func (fake *FakeImageImpl) ExecuteOutput(arg1 string, arg2 ...string) (string, e
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeImageImpl) ExecuteOutputCallCount() int {
-	fake.executeOutputMutex.RLock()
-	defer fake.executeOutputMutex.RUnlock()
-	return len(fake.executeOutputArgsForCall)
+func (fake *FakeImageImpl) RemoteConfigNameCallCount() int {
+	fake.remoteConfigNameMutex.RLock()
+	defer fake.remoteConfigNameMutex.RUnlock()
+	return len(fake.remoteConfigNameArgsForCall)
 }
 
-func (fake *FakeImageImpl) ExecuteOutputCalls(stub func(string, ...string) (string, error)) {
-	fake.executeOutputMutex.Lock()
-	defer fake.executeOutputMutex.Unlock()
-	fake.ExecuteOutputStub = stub
+func (fake *FakeImageImpl) RemoteConfigNameCalls(stub func(string) (v1.Hash, error)) {
+	fake.remoteConfigNameMutex.Lock()
+	defer fake.remoteConfigNameMutex.Unlock()
+	fake.RemoteConfigNameStub = stub
 }
 
-func (fake *FakeImageImpl) ExecuteOutputArgsForCall(i int) (string, []string) {
-	fake.executeOutputMutex.RLock()
-	defer fake.executeOutputMutex.RUnlock()
-	argsForCall := fake.executeOutputArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeImageImpl) RemoteConfigNameArgsForCall(i int) string {
+	fake.remoteConfigNameMutex.RLock()
+	defer fake.remoteConfigNameMutex.RUnlock()
+	argsForCall := fake.remoteConfigNameArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeImageImpl) ExecuteOutputReturns(result1 string, result2 error) {
-	fake.executeOutputMutex.Lock()
-	defer fake.executeOutputMutex.Unlock()
-	fake.ExecuteOutputStub = nil
-	fake.executeOutputReturns = struct {
-		result1 string
+func (fake *FakeImageImpl) RemoteConfigNameReturns(result1 v1.Hash, result2 error) {
+	fake.remoteConfigNameMutex.Lock()
+	defer fake.remoteConfigNameMutex.Unlock()
+	fake.RemoteConfigNameStub = nil
+	fake.remoteConfigNameReturns = struct {
+		result1 v1.Hash
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeImageImpl) ExecuteOutputReturnsOnCall(i int, result1 string, result2 error) {
-	fake.executeOutputMutex.Lock()
-	defer fake.executeOutputMutex.Unlock()
-	fake.ExecuteOutputStub = nil
-	if fake.executeOutputReturnsOnCall == nil {
-		fake.executeOutputReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeImageImpl) RemoteConfigNameReturnsOnCall(i int, result1 v1.Hash, result2 error) {
+	fake.remoteConfigNameMutex.Lock()
+	defer fake.remoteConfigNameMutex.Unlock()
+	fake.RemoteConfigNameStub = nil
+	if fake.remoteConfigNameReturnsOnCall == nil {
+		fake.remoteConfigNameReturnsOnCall = make(map[int]struct {
+			result1 v1.Hash
 			result2 error
 		})
 	}
-	fake.executeOutputReturnsOnCall[i] = struct {
-		result1 string
+	fake.remoteConfigNameReturnsOnCall[i] = struct {
+		result1 v1.Hash
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageImpl) RemoteIndexManifest(arg1 string) (*v1.IndexManifest, error) {
+	fake.remoteIndexManifestMutex.Lock()
+	ret, specificReturn := fake.remoteIndexManifestReturnsOnCall[len(fake.remoteIndexManifestArgsForCall)]
+	fake.remoteIndexManifestArgsForCall = append(fake.remoteIndexManifestArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RemoteIndexManifestStub
+	fakeReturns := fake.remoteIndexManifestReturns
+	fake.recordInvocation("RemoteIndexManifest", []interface{}{arg1})
+	fake.remoteIndexManifestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImageImpl) RemoteIndexManifestCallCount() int {
+	fake.remoteIndexManifestMutex.RLock()
+	defer fake.remoteIndexManifestMutex.RUnlock()
+	return len(fake.remoteIndexManifestArgsForCall)
+}
+
+func (fake *FakeImageImpl) RemoteIndexManifestCalls(stub func(string) (*v1.IndexManifest, error)) {
+	fake.remoteIndexManifestMutex.Lock()
+	defer fake.remoteIndexManifestMutex.Unlock()
+	fake.RemoteIndexManifestStub = stub
+}
+
+func (fake *FakeImageImpl) RemoteIndexManifestArgsForCall(i int) string {
+	fake.remoteIndexManifestMutex.RLock()
+	defer fake.remoteIndexManifestMutex.RUnlock()
+	argsForCall := fake.remoteIndexManifestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImageImpl) RemoteIndexManifestReturns(result1 *v1.IndexManifest, result2 error) {
+	fake.remoteIndexManifestMutex.Lock()
+	defer fake.remoteIndexManifestMutex.Unlock()
+	fake.RemoteIndexManifestStub = nil
+	fake.remoteIndexManifestReturns = struct {
+		result1 *v1.IndexManifest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageImpl) RemoteIndexManifestReturnsOnCall(i int, result1 *v1.IndexManifest, result2 error) {
+	fake.remoteIndexManifestMutex.Lock()
+	defer fake.remoteIndexManifestMutex.Unlock()
+	fake.RemoteIndexManifestStub = nil
+	if fake.remoteIndexManifestReturnsOnCall == nil {
+		fake.remoteIndexManifestReturnsOnCall = make(map[int]struct {
+			result1 *v1.IndexManifest
+			result2 error
+		})
+	}
+	fake.remoteIndexManifestReturnsOnCall[i] = struct {
+		result1 *v1.IndexManifest
 		result2 error
 	}{result1, result2}
 }
@@ -344,6 +433,70 @@ func (fake *FakeImageImpl) SignImageReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImageImpl) TarballConfigName(arg1 string) (v1.Hash, error) {
+	fake.tarballConfigNameMutex.Lock()
+	ret, specificReturn := fake.tarballConfigNameReturnsOnCall[len(fake.tarballConfigNameArgsForCall)]
+	fake.tarballConfigNameArgsForCall = append(fake.tarballConfigNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.TarballConfigNameStub
+	fakeReturns := fake.tarballConfigNameReturns
+	fake.recordInvocation("TarballConfigName", []interface{}{arg1})
+	fake.tarballConfigNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImageImpl) TarballConfigNameCallCount() int {
+	fake.tarballConfigNameMutex.RLock()
+	defer fake.tarballConfigNameMutex.RUnlock()
+	return len(fake.tarballConfigNameArgsForCall)
+}
+
+func (fake *FakeImageImpl) TarballConfigNameCalls(stub func(string) (v1.Hash, error)) {
+	fake.tarballConfigNameMutex.Lock()
+	defer fake.tarballConfigNameMutex.Unlock()
+	fake.TarballConfigNameStub = stub
+}
+
+func (fake *FakeImageImpl) TarballConfigNameArgsForCall(i int) string {
+	fake.tarballConfigNameMutex.RLock()
+	defer fake.tarballConfigNameMutex.RUnlock()
+	argsForCall := fake.tarballConfigNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImageImpl) TarballConfigNameReturns(result1 v1.Hash, result2 error) {
+	fake.tarballConfigNameMutex.Lock()
+	defer fake.tarballConfigNameMutex.Unlock()
+	fake.TarballConfigNameStub = nil
+	fake.tarballConfigNameReturns = struct {
+		result1 v1.Hash
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageImpl) TarballConfigNameReturnsOnCall(i int, result1 v1.Hash, result2 error) {
+	fake.tarballConfigNameMutex.Lock()
+	defer fake.tarballConfigNameMutex.Unlock()
+	fake.TarballConfigNameStub = nil
+	if fake.tarballConfigNameReturnsOnCall == nil {
+		fake.tarballConfigNameReturnsOnCall = make(map[int]struct {
+			result1 v1.Hash
+			result2 error
+		})
+	}
+	fake.tarballConfigNameReturnsOnCall[i] = struct {
+		result1 v1.Hash
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImageImpl) VerifyImage(arg1 *sign.Signer, arg2 string) error {
 	fake.verifyImageMutex.Lock()
 	ret, specificReturn := fake.verifyImageReturnsOnCall[len(fake.verifyImageArgsForCall)]
@@ -20,9 +20,24 @@ package releasefakes
 import (
 	"os"
 	"sync"
+
+	"k8s.io/release/pkg/objectstore"
 )
 
 type FakePublisherClient struct {
+	CopyFileToRemoteStub        func(string, string, *objectstore.ObjectAttrs) error
+	copyFileToRemoteMutex       sync.RWMutex
+	copyFileToRemoteArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *objectstore.ObjectAttrs
+	}
+	copyFileToRemoteReturns struct {
+		result1 error
+	}
+	copyFileToRemoteReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CopyToLocalStub        func(string, string) error
 	copyToLocalMutex       sync.RWMutex
 	copyToLocalArgsForCall []struct {
@@ -47,43 +62,6 @@ type FakePublisherClient struct {
 	copyToRemoteReturnsOnCall map[int]struct {
 		result1 error
 	}
-	GSUtilStub        func(...string) error
-	gSUtilMutex       sync.RWMutex
-	gSUtilArgsForCall []struct {
-		arg1 []string
-	}
-	gSUtilReturns struct {
-		result1 error
-	}
-	gSUtilReturnsOnCall map[int]struct {
-		result1 error
-	}
-	GSUtilOutputStub        func(...string) (string, error)
-	gSUtilOutputMutex       sync.RWMutex
-	gSUtilOutputArgsForCall []struct {
-		arg1 []string
-	}
-	gSUtilOutputReturns struct {
-		result1 string
-		result2 error
-	}
-	gSUtilOutputReturnsOnCall map[int]struct {
-		result1 string
-		result2 error
-	}
-	GSUtilStatusStub        func(...string) (bool, error)
-	gSUtilStatusMutex       sync.RWMutex
-	gSUtilStatusArgsForCall []struct {
-		arg1 []string
-	}
-	gSUtilStatusReturns struct {
-		result1 bool
-		result2 error
-	}
-	gSUtilStatusReturnsOnCall map[int]struct {
-		result1 bool
-		result2 error
-	}
 	GetMarkerPathStub        func(string, string, bool) (string, error)
 	getMarkerPathMutex       sync.RWMutex
 	getMarkerPathArgsForCall []struct {
@@ -154,6 +132,19 @@ type FakePublisherClient struct {
 		result1 string
 		result2 error
 	}
+	PathExistsStub        func(string) (bool, error)
+	pathExistsMutex       sync.RWMutex
+	pathExistsArgsForCall []struct {
+		arg1 string
+	}
+	pathExistsReturns struct {
+		result1 bool
+		result2 error
+	}
+	pathExistsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ReadFileStub        func(string) ([]byte, error)
 	readFileMutex       sync.RWMutex
 	readFileArgsForCall []struct {
@@ -167,6 +158,19 @@ type FakePublisherClient struct {
 		result1 []byte
 		result2 error
 	}
+	ReadObjectStub        func(string) (string, error)
+	readObjectMutex       sync.RWMutex
+	readObjectArgsForCall []struct {
+		arg1 string
+	}
+	readObjectReturns struct {
+		result1 string
+		result2 error
+	}
+	readObjectReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	TempDirStub        func(string, string) (string, error)
 	tempDirMutex       sync.RWMutex
 	tempDirArgsForCall []struct {
@@ -211,6 +215,69 @@ type FakePublisherClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePublisherClient) CopyFileToRemote(arg1 string, arg2 string, arg3 *objectstore.ObjectAttrs) error {
+	fake.copyFileToRemoteMutex.Lock()
+	ret, specificReturn := fake.copyFileToRemoteReturnsOnCall[len(fake.copyFileToRemoteArgsForCall)]
+	fake.copyFileToRemoteArgsForCall = append(fake.copyFileToRemoteArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *objectstore.ObjectAttrs
+	}{arg1, arg2, arg3})
+	stub := fake.CopyFileToRemoteStub
+	fakeReturns := fake.copyFileToRemoteReturns
+	fake.recordInvocation("CopyFileToRemote", []interface{}{arg1, arg2, arg3})
+	fake.copyFileToRemoteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePublisherClient) CopyFileToRemoteCallCount() int {
+	fake.copyFileToRemoteMutex.RLock()
+	defer fake.copyFileToRemoteMutex.RUnlock()
+	return len(fake.copyFileToRemoteArgsForCall)
+}
+
+func (fake *FakePublisherClient) CopyFileToRemoteCalls(stub func(string, string, *objectstore.ObjectAttrs) error) {
+	fake.copyFileToRemoteMutex.Lock()
+	defer fake.copyFileToRemoteMutex.Unlock()
+	fake.CopyFileToRemoteStub = stub
+}
+
+func (fake *FakePublisherClient) CopyFileToRemoteArgsForCall(i int) (string, string, *objectstore.ObjectAttrs) {
+	fake.copyFileToRemoteMutex.RLock()
+	defer fake.copyFileToRemoteMutex.RUnlock()
+	argsForCall := fake.copyFileToRemoteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePublisherClient) CopyFileToRemoteReturns(result1 error) {
+	fake.copyFileToRemoteMutex.Lock()
+	defer fake.copyFileToRemoteMutex.Unlock()
+	fake.CopyFileToRemoteStub = nil
+	fake.copyFileToRemoteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePublisherClient) CopyFileToRemoteReturnsOnCall(i int, result1 error) {
+	fake.copyFileToRemoteMutex.Lock()
+	defer fake.copyFileToRemoteMutex.Unlock()
+	fake.CopyFileToRemoteStub = nil
+	if fake.copyFileToRemoteReturnsOnCall == nil {
+		fake.copyFileToRemoteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.copyFileToRemoteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePublisherClient) CopyToLocal(arg1 string, arg2 string) error {
 	fake.copyToLocalMutex.Lock()
 	ret, specificReturn := fake.copyToLocalReturnsOnCall[len(fake.copyToLocalArgsForCall)]
@@ -335,195 +402,6 @@ func (fake *FakePublisherClient) CopyToRemoteReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
-func (fake *FakePublisherClient) GSUtil(arg1 ...string) error {
-	fake.gSUtilMutex.Lock()
-	ret, specificReturn := fake.gSUtilReturnsOnCall[len(fake.gSUtilArgsForCall)]
-	fake.gSUtilArgsForCall = append(fake.gSUtilArgsForCall, struct {
-		arg1 []string
-	}{arg1})
-	stub := fake.GSUtilStub
-	fakeReturns := fake.gSUtilReturns
-	fake.recordInvocation("GSUtil", []interface{}{arg1})
-	fake.gSUtilMutex.Unlock()
-	if stub != nil {
-		return stub(arg1...)
-	}
-	if specificReturn {
-		return ret.result1
-	}
-	return fakeReturns.result1
-}
-
-func (fake *FakePublisherClient) GSUtilCallCount() int {
-	fake.gSUtilMutex.RLock()
-	defer fake.gSUtilMutex.RUnlock()
-	return len(fake.gSUtilArgsForCall)
-}
-
-func (fake *FakePublisherClient) GSUtilCalls(stub func(...string) error) {
-	fake.gSUtilMutex.Lock()
-	defer fake.gSUtilMutex.Unlock()
-	fake.GSUtilStub = stub
-}
-
-func (fake *FakePublisherClient) GSUtilArgsForCall(i int) []string {
-	fake.gSUtilMutex.RLock()
-	defer fake.gSUtilMutex.RUnlock()
-	argsForCall := fake.gSUtilArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *FakePublisherClient) GSUtilReturns(result1 error) {
-	fake.gSUtilMutex.Lock()
-	defer fake.gSUtilMutex.Unlock()
-	fake.GSUtilStub = nil
-	fake.gSUtilReturns = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakePublisherClient) GSUtilReturnsOnCall(i int, result1 error) {
-	fake.gSUtilMutex.Lock()
-	defer fake.gSUtilMutex.Unlock()
-	fake.GSUtilStub = nil
-	if fake.gSUtilReturnsOnCall == nil {
-		fake.gSUtilReturnsOnCall = make(map[int]struct {
-			result1 error
-		})
-	}
-	fake.gSUtilReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakePublisherClient) GSUtilOutput(arg1 ...string) (string, error) {
-	fake.gSUtilOutputMutex.Lock()
-	ret, specificReturn := fake.gSUtilOutputReturnsOnCall[len(fake.gSUtilOutputArgsForCall)]
-	fake.gSUtilOutputArgsForCall = append(fake.gSUtilOutputArgsForCall, struct {
-		arg1 []string
-	}{arg1})
-	stub := fake.GSUtilOutputStub
-	fakeReturns := fake.gSUtilOutputReturns
-	fake.recordInvocation("GSUtilOutput", []interface{}{arg1})
-	fake.gSUtilOutputMutex.Unlock()
-	if stub != nil {
-		return stub(arg1...)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2
-	}
-	return fakeReturns.result1, fakeReturns.result2
-}
-
-func (fake *FakePublisherClient) GSUtilOutputCallCount() int {
-	fake.gSUtilOutputMutex.RLock()
-	defer fake.gSUtilOutputMutex.RUnlock()
-	return len(fake.gSUtilOutputArgsForCall)
-}
-
-func (fake *FakePublisherClient) GSUtilOutputCalls(stub func(...string) (string, error)) {
-	fake.gSUtilOutputMutex.Lock()
-	defer fake.gSUtilOutputMutex.Unlock()
-	fake.GSUtilOutputStub = stub
-}
-
-func (fake *FakePublisherClient) GSUtilOutputArgsForCall(i int) []string {
-	fake.gSUtilOutputMutex.RLock()
-	defer fake.gSUtilOutputMutex.RUnlock()
-	argsForCall := fake.gSUtilOutputArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *FakePublisherClient) GSUtilOutputReturns(result1 string, result2 error) {
-	fake.gSUtilOutputMutex.Lock()
-	defer fake.gSUtilOutputMutex.Unlock()
-	fake.GSUtilOutputStub = nil
-	fake.gSUtilOutputReturns = struct {
-		result1 string
-		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakePublisherClient) GSUtilOutputReturnsOnCall(i int, result1 string, result2 error) {
-	fake.gSUtilOutputMutex.Lock()
-	defer fake.gSUtilOutputMutex.Unlock()
-	fake.GSUtilOutputStub = nil
-	if fake.gSUtilOutputReturnsOnCall == nil {
-		fake.gSUtilOutputReturnsOnCall = make(map[int]struct {
-			result1 string
-			result2 error
-		})
-	}
-	fake.gSUtilOutputReturnsOnCall[i] = struct {
-		result1 string
-		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakePublisherClient) GSUtilStatus(arg1 ...string) (bool, error) {
-	fake.gSUtilStatusMutex.Lock()
-	ret, specificReturn := fake.gSUtilStatusReturnsOnCall[len(fake.gSUtilStatusArgsForCall)]
-	fake.gSUtilStatusArgsForCall = append(fake.gSUtilStatusArgsForCall, struct {
-		arg1 []string
-	}{arg1})
-	stub := fake.GSUtilStatusStub
-	fakeReturns := fake.gSUtilStatusReturns
-	fake.recordInvocation("GSUtilStatus", []interface{}{arg1})
-	fake.gSUtilStatusMutex.Unlock()
-	if stub != nil {
-		return stub(arg1...)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2
-	}
-	return fakeReturns.result1, fakeReturns.result2
-}
-
-func (fake *FakePublisherClient) GSUtilStatusCallCount() int {
-	fake.gSUtilStatusMutex.RLock()
-	defer fake.gSUtilStatusMutex.RUnlock()
-	return len(fake.gSUtilStatusArgsForCall)
-}
-
-func (fake *FakePublisherClient) GSUtilStatusCalls(stub func(...string) (bool, error)) {
-	fake.gSUtilStatusMutex.Lock()
-	defer fake.gSUtilStatusMutex.Unlock()
-	fake.GSUtilStatusStub = stub
-}
-
-func (fake *FakePublisherClient) GSUtilStatusArgsForCall(i int) []string {
-	fake.gSUtilStatusMutex.RLock()
-	defer fake.gSUtilStatusMutex.RUnlock()
-	argsForCall := fake.gSUtilStatusArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *FakePublisherClient) GSUtilStatusReturns(result1 bool, result2 error) {
-	fake.gSUtilStatusMutex.Lock()
-	defer fake.gSUtilStatusMutex.Unlock()
-	fake.GSUtilStatusStub = nil
-	fake.gSUtilStatusReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakePublisherClient) GSUtilStatusReturnsOnCall(i int, result1 bool, result2 error) {
-	fake.gSUtilStatusMutex.Lock()
-	defer fake.gSUtilStatusMutex.Unlock()
-	fake.GSUtilStatusStub = nil
-	if fake.gSUtilStatusReturnsOnCall == nil {
-		fake.gSUtilStatusReturnsOnCall = make(map[int]struct {
-			result1 bool
-			result2 error
-		})
-	}
-	fake.gSUtilStatusReturnsOnCall[i] = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
-}
-
 func (fake *FakePublisherClient) GetMarkerPath(arg1 string, arg2 string, arg3 bool) (string, error) {
 	fake.getMarkerPathMutex.Lock()
 	ret, specificReturn := fake.getMarkerPathReturnsOnCall[len(fake.getMarkerPathArgsForCall)]
@@ -849,6 +727,70 @@ func (fake *FakePublisherClient) NormalizePathReturnsOnCall(i int, result1 strin
 	}{result1, result2}
 }
 
+func (fake *FakePublisherClient) PathExists(arg1 string) (bool, error) {
+	fake.pathExistsMutex.Lock()
+	ret, specificReturn := fake.pathExistsReturnsOnCall[len(fake.pathExistsArgsForCall)]
+	fake.pathExistsArgsForCall = append(fake.pathExistsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.PathExistsStub
+	fakeReturns := fake.pathExistsReturns
+	fake.recordInvocation("PathExists", []interface{}{arg1})
+	fake.pathExistsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePublisherClient) PathExistsCallCount() int {
+	fake.pathExistsMutex.RLock()
+	defer fake.pathExistsMutex.RUnlock()
+	return len(fake.pathExistsArgsForCall)
+}
+
+func (fake *FakePublisherClient) PathExistsCalls(stub func(string) (bool, error)) {
+	fake.pathExistsMutex.Lock()
+	defer fake.pathExistsMutex.Unlock()
+	fake.PathExistsStub = stub
+}
+
+func (fake *FakePublisherClient) PathExistsArgsForCall(i int) string {
+	fake.pathExistsMutex.RLock()
+	defer fake.pathExistsMutex.RUnlock()
+	argsForCall := fake.pathExistsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePublisherClient) PathExistsReturns(result1 bool, result2 error) {
+	fake.pathExistsMutex.Lock()
+	defer fake.pathExistsMutex.Unlock()
+	fake.PathExistsStub = nil
+	fake.pathExistsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePublisherClient) PathExistsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.pathExistsMutex.Lock()
+	defer fake.pathExistsMutex.Unlock()
+	fake.PathExistsStub = nil
+	if fake.pathExistsReturnsOnCall == nil {
+		fake.pathExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pathExistsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePublisherClient) ReadFile(arg1 string) ([]byte, error) {
 	fake.readFileMutex.Lock()
 	ret, specificReturn := fake.readFileReturnsOnCall[len(fake.readFileArgsForCall)]
@@ -913,6 +855,70 @@ func (fake *FakePublisherClient) ReadFileReturnsOnCall(i int, result1 []byte, re
 	}{result1, result2}
 }
 
+func (fake *FakePublisherClient) ReadObject(arg1 string) (string, error) {
+	fake.readObjectMutex.Lock()
+	ret, specificReturn := fake.readObjectReturnsOnCall[len(fake.readObjectArgsForCall)]
+	fake.readObjectArgsForCall = append(fake.readObjectArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadObjectStub
+	fakeReturns := fake.readObjectReturns
+	fake.recordInvocation("ReadObject", []interface{}{arg1})
+	fake.readObjectMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePublisherClient) ReadObjectCallCount() int {
+	fake.readObjectMutex.RLock()
+	defer fake.readObjectMutex.RUnlock()
+	return len(fake.readObjectArgsForCall)
+}
+
+func (fake *FakePublisherClient) ReadObjectCalls(stub func(string) (string, error)) {
+	fake.readObjectMutex.Lock()
+	defer fake.readObjectMutex.Unlock()
+	fake.ReadObjectStub = stub
+}
+
+func (fake *FakePublisherClient) ReadObjectArgsForCall(i int) string {
+	fake.readObjectMutex.RLock()
+	defer fake.readObjectMutex.RUnlock()
+	argsForCall := fake.readObjectArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePublisherClient) ReadObjectReturns(result1 string, result2 error) {
+	fake.readObjectMutex.Lock()
+	defer fake.readObjectMutex.Unlock()
+	fake.ReadObjectStub = nil
+	fake.readObjectReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePublisherClient) ReadObjectReturnsOnCall(i int, result1 string, result2 error) {
+	fake.readObjectMutex.Lock()
+	defer fake.readObjectMutex.Unlock()
+	fake.ReadObjectStub = nil
+	if fake.readObjectReturnsOnCall == nil {
+		fake.readObjectReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.readObjectReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePublisherClient) TempDir(arg1 string, arg2 string) (string, error) {
 	fake.tempDirMutex.Lock()
 	ret, specificReturn := fake.tempDirReturnsOnCall[len(fake.tempDirArgsForCall)]
@@ -30,9 +30,10 @@ import (
 	"sigs.k8s.io/bom/pkg/spdx"
 	"sigs.k8s.io/release-sdk/git"
 	"sigs.k8s.io/release-sdk/github"
-	"sigs.k8s.io/release-sdk/object"
 	"sigs.k8s.io/release-utils/helpers"
 	"sigs.k8s.io/release-utils/tar"
+
+	"k8s.io/release/pkg/objectstore"
 )
 
 // PrepareWorkspaceStage sets up the workspace by cloning a new copy of k/k.
@@ -119,11 +120,11 @@ func PrepareWorkspaceRelease(directory, buildVersion, bucket string) error {
 	src := filepath.Join(bucket, StagePath, buildVersion, SourcesTar)
 	dst := filepath.Join(tempDir, SourcesTar)
 
-	gcs := object.NewGCS()
-	gcs.WithAllowMissing(false)
+	store := objectstore.New(bucket)
+	store.SetOptions(store.WithAllowMissing(false))
 
-	if err := gcs.CopyToLocal(src, dst); err != nil {
-		return fmt.Errorf("copying staged sources from GCS: %w", err)
+	if err := store.CopyToLocal(src, dst); err != nil {
+		return fmt.Errorf("copying staged sources from bucket: %w", err)
 	}
 
 	logrus.Info("Got staged sources, extracting archive")